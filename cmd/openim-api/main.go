@@ -16,6 +16,7 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
@@ -37,6 +38,7 @@ import (
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
 	ginProm "github.com/openimsdk/open-im-server/v3/pkg/common/ginprometheus"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	tlsutil "github.com/openimsdk/open-im-server/v3/pkg/common/tls"
 )
 
 func main() {
@@ -102,9 +104,25 @@ func run(port int, proPort int) error {
 	}
 	log.ZInfo(context.Background(), "start api server", "address", address, "OpenIM version", config.Version)
 
-	server := http.Server{Addr: address, Handler: router}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var tlsConfig *tls.Config
+	if tlsConf := config.Config.Api.TLS; tlsConf.Enable {
+		tlsConfig, err = tlsutil.NewServerTLSConfig(ctx, tlsConf.Cert, tlsConf.Key, []byte(tlsConf.KeyPwd),
+			time.Duration(tlsConf.ReloadInterval)*time.Second)
+		if err != nil {
+			log.ZError(context.Background(), "Failed to load api tls certificate", err)
+			return err
+		}
+	}
+
+	server := http.Server{Addr: address, Handler: router, TLSConfig: tlsConfig}
 	go func() {
-		err = server.ListenAndServe()
+		if tlsConfig != nil {
+			err = server.ListenAndServeTLS("", "")
+		} else {
+			err = server.ListenAndServe()
+		}
 		if err != nil && err != http.ErrServerClosed {
 			log.ZError(context.Background(), "api run failed", err, "address", address)
 			os.Exit(1)
@@ -115,11 +133,11 @@ func run(port int, proPort int) error {
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	<-sigs
 
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
-	defer cancel()
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer shutdownCancel()
 
 	// graceful shutdown operation.
-	if err := server.Shutdown(ctx); err != nil {
+	if err := server.Shutdown(shutdownCtx); err != nil {
 		log.ZError(context.Background(), "failed to api-server shutdown", err)
 		return err
 	}
@@ -96,7 +96,9 @@ func (m MessageApi) newUserSendMsgReq(_ *gin.Context, params *apistruct.SendMsg)
 			SendTime:         params.SendTime,
 			Options:          options,
 			OfflinePushInfo:  params.OfflinePushInfo,
+			ThreadRootSeq:    params.ThreadRootSeq,
 		},
+		ScheduleTime: params.ScheduleTime,
 	}
 	return &pbData
 }
@@ -113,6 +115,62 @@ func (m *MessageApi) RevokeMsg(c *gin.Context) {
 	a2r.Call(msg.MsgClient.RevokeMsg, m.Client, c)
 }
 
+func (m *MessageApi) ModifyMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.ModifyMsg, m.Client, c)
+}
+
+func (m *MessageApi) AddReaction(c *gin.Context) {
+	a2r.Call(msg.MsgClient.AddReaction, m.Client, c)
+}
+
+func (m *MessageApi) RemoveReaction(c *gin.Context) {
+	a2r.Call(msg.MsgClient.RemoveReaction, m.Client, c)
+}
+
+func (m *MessageApi) GetReactions(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetReactions, m.Client, c)
+}
+
+func (m *MessageApi) GetThreadMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetThreadMsgs, m.Client, c)
+}
+
+func (m *MessageApi) MarkThreadAsRead(c *gin.Context) {
+	a2r.Call(msg.MsgClient.MarkThreadAsRead, m.Client, c)
+}
+
+func (m *MessageApi) GetScheduledMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetScheduledMsgs, m.Client, c)
+}
+
+func (m *MessageApi) CancelScheduledMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.CancelScheduledMsg, m.Client, c)
+}
+
+func (m *MessageApi) RescheduleMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.RescheduleMsg, m.Client, c)
+}
+
+func (m *MessageApi) PinMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.PinMsg, m.Client, c)
+}
+
+func (m *MessageApi) UnpinMsg(c *gin.Context) {
+	a2r.Call(msg.MsgClient.UnpinMsg, m.Client, c)
+}
+
+func (m *MessageApi) GetPinnedMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetPinnedMsgs, m.Client, c)
+}
+
+func (m *MessageApi) SearchMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.SearchMsgs, m.Client, c)
+}
+
+func (m *MessageApi) ForwardMsgs(c *gin.Context) {
+	a2r.Call(msg.MsgClient.ForwardMsgs, m.Client, c)
+}
+
 func (m *MessageApi) MarkMsgsAsRead(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MarkMsgsAsRead, m.Client, c)
 }
@@ -391,6 +449,10 @@ func (m *MessageApi) GetServerTime(c *gin.Context) {
 	a2r.Call(msg.MsgClient.GetServerTime, m.Client, c)
 }
 
+func (m *MessageApi) GetMsgDeliveryStates(c *gin.Context) {
+	a2r.Call(msg.MsgClient.GetMsgDeliveryStates, m.Client, c)
+}
+
 // GetConversation 根据消息ID获取会话ID
 func (m *MessageApi) GetConversation(c *gin.Context) {
 	a2r.Call(msg.MsgClient.MsgIdGetConversations, m.Client, c)
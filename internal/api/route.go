@@ -79,6 +79,9 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		userRouterGroup.POST("/get_users", ParseToken, u.GetUsers)
 		userRouterGroup.POST("/get_users_online_status", ParseToken, u.GetUsersOnlineStatus)
 		userRouterGroup.POST("/get_users_online_token_detail", ParseToken, u.GetUsersOnlineTokenDetail)
+		userRouterGroup.POST("/get_conns", ParseToken, u.GetConns)
+		userRouterGroup.POST("/get_conn", ParseToken, u.GetConn)
+		userRouterGroup.POST("/kick_conn", ParseToken, u.KickConn)
 		userRouterGroup.POST("/subscribe_users_status", ParseToken, u.SubscriberStatus)
 		userRouterGroup.POST("/get_users_status", ParseToken, u.GetUserStatus)
 		userRouterGroup.POST("/get_subscribe_users_status", ParseToken, u.GetSubscribeUsersStatus)
@@ -194,6 +197,20 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		msgGroup.POST("/send_business_notification", m.SendBusinessNotification)
 		msgGroup.POST("/pull_msg_by_seq", m.PullMsgBySeqs)
 		msgGroup.POST("/revoke_msg", m.RevokeMsg)
+		msgGroup.POST("/modify_msg", m.ModifyMsg)
+		msgGroup.POST("/add_reaction", m.AddReaction)
+		msgGroup.POST("/remove_reaction", m.RemoveReaction)
+		msgGroup.POST("/get_reactions", m.GetReactions)
+		msgGroup.POST("/get_thread_msgs", m.GetThreadMsgs)
+		msgGroup.POST("/mark_thread_as_read", m.MarkThreadAsRead)
+		msgGroup.POST("/get_scheduled_msgs", m.GetScheduledMsgs)
+		msgGroup.POST("/cancel_scheduled_msg", m.CancelScheduledMsg)
+		msgGroup.POST("/reschedule_msg", m.RescheduleMsg)
+		msgGroup.POST("/pin_msg", m.PinMsg)
+		msgGroup.POST("/unpin_msg", m.UnpinMsg)
+		msgGroup.POST("/get_pinned_msgs", m.GetPinnedMsgs)
+		msgGroup.POST("/search_msgs", m.SearchMsgs)
+		msgGroup.POST("/forward_msgs", m.ForwardMsgs)
 		msgGroup.POST("/mark_msgs_as_read", m.MarkMsgsAsRead)
 		msgGroup.POST("/mark_conversation_as_read", m.MarkConversationAsRead)
 		msgGroup.POST("/get_conversations_has_read_and_max_seq", m.GetConversationsHasReadAndMaxSeq)
@@ -208,6 +225,7 @@ func NewGinRouter(discov discoveryregistry.SvcDiscoveryRegistry, rdb redis.Unive
 		msgGroup.POST("/batch_send_msg", m.BatchSendMsg)
 		msgGroup.POST("/check_msg_is_send_success", m.CheckMsgIsSendSuccess)
 		msgGroup.POST("/get_server_time", m.GetServerTime)
+		msgGroup.POST("/get_msg_delivery_states", m.GetMsgDeliveryStates)
 		//根据消息ID获取会话ID
 		msgGroup.POST("/get_conversation", m.GetConversation)
 	}
@@ -15,6 +15,9 @@
 package api
 
 import (
+	"context"
+	"sort"
+
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msggateway"
 	"github.com/OpenIMSDK/protocol/user"
@@ -22,6 +25,7 @@ import (
 	"github.com/OpenIMSDK/tools/apiresp"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
 	"github.com/gin-gonic/gin"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
@@ -186,6 +190,144 @@ func (u *UserApi) GetUsersOnlineTokenDetail(c *gin.Context) {
 	apiresp.GinSuccess(c, respResult)
 }
 
+// GetConns List the connections of all gateway nodes.
+func (u *UserApi) GetConns(c *gin.Context) {
+	var req msggateway.GetConnsReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
+		return
+	}
+	clients, err := u.gatewayClients(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	pagination := req.Pagination
+	req.Pagination = nil
+	result, err := getGatewayConns(c, clients, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ConnectTime > result[j].ConnectTime
+	})
+	resp := &msggateway.GetConnsResp{Total: int64(len(result)), Conns: result}
+	if pagination != nil {
+		resp.Conns = utils.Paginate(result, int(pagination.PageNumber), int(pagination.ShowNumber))
+	}
+	apiresp.GinSuccess(c, resp)
+}
+
+// GetConn Inspect a connection by connID.
+func (u *UserApi) GetConn(c *gin.Context) {
+	var req msggateway.GetConnReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
+		return
+	}
+	clients, err := u.gatewayClients(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	conn, err := findGatewayConn(c, clients, func(client msggateway.MsgGatewayClient) (*msggateway.ConnInfo, error) {
+		reply, err := client.GetConn(c, &req)
+		if err != nil {
+			return nil, err
+		}
+		return reply.Conn, nil
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &msggateway.GetConnResp{Conn: conn})
+}
+
+// KickConn Kick a single connection, the other devices of the user stay online.
+func (u *UserApi) KickConn(c *gin.Context) {
+	var req msggateway.KickConnReq
+	if err := c.BindJSON(&req); err != nil {
+		apiresp.GinError(c, errs.ErrArgs.WithDetail(err.Error()).Wrap())
+		return
+	}
+	clients, err := u.gatewayClients(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	conn, err := findGatewayConn(c, clients, func(client msggateway.MsgGatewayClient) (*msggateway.ConnInfo, error) {
+		reply, err := client.KickConn(c, &req)
+		if err != nil {
+			return nil, err
+		}
+		return reply.Conn, nil
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, &msggateway.KickConnResp{Conn: conn})
+}
+
+func (u *UserApi) gatewayClients(ctx context.Context) ([]msggateway.MsgGatewayClient, error) {
+	conns, err := u.Discov.GetConns(ctx, config.Config.RpcRegisterName.OpenImMessageGatewayName)
+	if err != nil {
+		return nil, err
+	}
+	clients := make([]msggateway.MsgGatewayClient, 0, len(conns))
+	for _, v := range conns {
+		clients = append(clients, msggateway.NewMsgGatewayClient(v))
+	}
+	return clients, nil
+}
+
+// getGatewayConns merges the connections of all gateway nodes. A node that fails fails the listing,
+// a partial list would be reported as the complete one.
+func getGatewayConns(ctx context.Context, clients []msggateway.MsgGatewayClient, req *msggateway.GetConnsReq) ([]*msggateway.ConnInfo, error) {
+	var result []*msggateway.ConnInfo
+	for i, client := range clients {
+		reply, err := client.GetConns(ctx, req)
+		if err != nil {
+			log.ZWarn(ctx, "GetConns rpc err", err, "node", i)
+			return nil, err
+		}
+		result = append(result, reply.Conns...)
+	}
+	return result, nil
+}
+
+// findGatewayConn asks the gateway nodes in turn until one of them holds the connection.
+// A node error is returned when no other node has it, instead of reporting the connection as not found.
+func findGatewayConn(
+	ctx context.Context,
+	clients []msggateway.MsgGatewayClient,
+	find func(client msggateway.MsgGatewayClient) (*msggateway.ConnInfo, error),
+) (*msggateway.ConnInfo, error) {
+	var nodeErr error
+	for i, client := range clients {
+		conn, err := find(client)
+		if err != nil {
+			log.ZWarn(ctx, "gateway conn rpc err", err, "node", i)
+			if apiresp.ParseError(err).ErrCode == errs.NoPermissionError {
+				return nil, err
+			}
+			if nodeErr == nil {
+				nodeErr = err
+			}
+			continue
+		}
+		if conn != nil {
+			return conn, nil
+		}
+	}
+	if nodeErr != nil {
+		return nil, nodeErr
+	}
+	return nil, errs.ErrRecordNotFound.Wrap("conn not found")
+}
+
 // SubscriberStatus Presence status of subscribed users.
 func (u *UserApi) SubscriberStatus(c *gin.Context) {
 	a2r.Call(user.UserClient.SubscribeOrCancelUsersStatus, u.Client, c)
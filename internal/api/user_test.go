@@ -0,0 +1,111 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type testGatewayClient struct {
+	msggateway.MsgGatewayClient
+	conns []*msggateway.ConnInfo
+	err   error
+}
+
+func (c *testGatewayClient) GetConns(context.Context, *msggateway.GetConnsReq, ...grpc.CallOption) (*msggateway.GetConnsResp, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &msggateway.GetConnsResp{Total: int64(len(c.conns)), Conns: c.conns}, nil
+}
+
+func (c *testGatewayClient) GetConn(_ context.Context, req *msggateway.GetConnReq, _ ...grpc.CallOption) (*msggateway.GetConnResp, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	for _, conn := range c.conns {
+		if conn.ConnID == req.ConnID {
+			return &msggateway.GetConnResp{Conn: conn}, nil
+		}
+	}
+	return &msggateway.GetConnResp{}, nil
+}
+
+func getConnFinder(connID string) func(client msggateway.MsgGatewayClient) (*msggateway.ConnInfo, error) {
+	return func(client msggateway.MsgGatewayClient) (*msggateway.ConnInfo, error) {
+		reply, err := client.GetConn(context.Background(), &msggateway.GetConnReq{ConnID: connID})
+		if err != nil {
+			return nil, err
+		}
+		return reply.Conn, nil
+	}
+}
+
+func TestGetGatewayConns(t *testing.T) {
+	ctx := context.Background()
+	a := &msggateway.ConnInfo{ConnID: "a"}
+	b := &msggateway.ConnInfo{ConnID: "b"}
+	conns, err := getGatewayConns(ctx, []msggateway.MsgGatewayClient{
+		&testGatewayClient{conns: []*msggateway.ConnInfo{a}},
+		&testGatewayClient{conns: []*msggateway.ConnInfo{b}},
+	}, &msggateway.GetConnsReq{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*msggateway.ConnInfo{a, b}, conns)
+
+	// one node down fails the listing instead of returning it partially
+	nodeErr := errors.New("node unavailable")
+	_, err = getGatewayConns(ctx, []msggateway.MsgGatewayClient{
+		&testGatewayClient{conns: []*msggateway.ConnInfo{a}},
+		&testGatewayClient{err: nodeErr},
+	}, &msggateway.GetConnsReq{})
+	assert.ErrorIs(t, err, nodeErr)
+}
+
+func TestFindGatewayConn(t *testing.T) {
+	ctx := context.Background()
+	a := &msggateway.ConnInfo{ConnID: "a"}
+	nodeErr := errors.New("node unavailable")
+	clients := []msggateway.MsgGatewayClient{
+		&testGatewayClient{err: nodeErr},
+		&testGatewayClient{conns: []*msggateway.ConnInfo{a}},
+	}
+
+	// found on a healthy node
+	conn, err := findGatewayConn(ctx, clients, getConnFinder("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, a, conn)
+
+	// not found while a node failed: the node error is reported, not "not found"
+	_, err = findGatewayConn(ctx, clients, getConnFinder("b"))
+	assert.ErrorIs(t, err, nodeErr)
+
+	// not found on healthy nodes
+	_, err = findGatewayConn(ctx, clients[1:], getConnFinder("b"))
+	assert.True(t, errs.ErrRecordNotFound.Is(err))
+
+	// permission errors fail immediately
+	_, err = findGatewayConn(ctx, []msggateway.MsgGatewayClient{
+		&testGatewayClient{err: errs.ErrNoPermission.Wrap()},
+		&testGatewayClient{conns: []*msggateway.ConnInfo{a}},
+	}, getConnFinder("a"))
+	assert.True(t, errs.ErrNoPermission.Is(err))
+}
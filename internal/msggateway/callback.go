@@ -102,6 +102,33 @@ func CallbackUserKickOff(ctx context.Context, userID string, platformID int) err
 	return nil
 }
 
+func CallbackUserDeviceEvicted(ctx context.Context, userID string, platformID int, loginTime int64, newPlatformID int, newConnID, reason string) error {
+	if !config.Config.Callback.CallbackUserDeviceEvicted.Enable {
+		return nil
+	}
+	req := &cbapi.CallbackUserDeviceEvictedReq{
+		UserStatusCallbackReq: cbapi.UserStatusCallbackReq{
+			UserStatusBaseCallback: cbapi.UserStatusBaseCallback{
+				CallbackCommand: cbapi.CallbackUserDeviceEvictedCommand,
+				OperationID:     mcontext.GetOperationID(ctx),
+				PlatformID:      platformID,
+				Platform:        constant.PlatformIDToName(platformID),
+			},
+			UserID: userID,
+		},
+		Seq:           time.Now().UnixMilli(),
+		LoginTime:     loginTime,
+		NewPlatformID: newPlatformID,
+		NewConnID:     newConnID,
+		Reason:        reason,
+	}
+	resp := &cbapi.CallbackUserDeviceEvictedResp{}
+	if err := http.CallBackPostReturn(ctx, callBackURL(), req, resp, config.Config.Callback.CallbackUserDeviceEvicted); err != nil {
+		return err
+	}
+	return nil
+}
+
 // func callbackUserOnline(operationID, userID string, platformID int, token string, isAppBackground bool, connID
 // string) cbApi.CommonCallbackResp {
 //	callbackResp := cbApi.CommonCallbackResp{OperationID: operationID}
@@ -22,7 +22,9 @@ import (
 	"runtime/debug"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 
 	"google.golang.org/protobuf/proto"
@@ -30,6 +32,7 @@ import (
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/apiresp"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
 	"github.com/OpenIMSDK/tools/mcontext"
 	"github.com/OpenIMSDK/tools/utils"
@@ -64,26 +67,36 @@ const (
 type PingPongHandler func(string) error
 
 type Client struct {
-	w              *sync.Mutex
+	w              sync.Mutex // 不随 ResetClient 替换，旧连接的写协程可能仍持有
 	conn           LongConn
 	PlatformID     int    `json:"platformID"`
 	IsCompress     bool   `json:"isCompress"`
 	UserID         string `json:"userID"`
 	IsBackground   bool   `json:"isBackground"`
+	encoder        Encoder
+	queue          *sendQueue
+	limiter        *connLimiter
+	resumeToken    string
+	resumeFrom     string
+	encoding       string
+	acks           *pushAckTracker
+	connectTime    int64
+	sdkVersion     string
 	ctx            *UserConnContext
 	longConnServer LongConnServer
 	closed         atomic.Bool
+	closedErrMu    sync.Mutex
 	closedErr      error
 	token          string
 }
 
 func newClient(ctx *UserConnContext, conn LongConn, isCompress bool) *Client {
 	return &Client{
-		w:          new(sync.Mutex),
 		conn:       conn,
 		PlatformID: utils.StringToInt(ctx.GetPlatformID()),
 		IsCompress: isCompress,
 		UserID:     ctx.GetUserID(),
+		encoder:    NewGobEncoder(),
 		ctx:        ctx,
 	}
 }
@@ -95,8 +108,8 @@ func (c *Client) ResetClient(
 	isBackground, isCompress bool,
 	longConnServer LongConnServer,
 	token string,
+	encoder Encoder,
 ) {
-	c.w = new(sync.Mutex)
 	c.conn = conn
 	c.PlatformID = utils.StringToInt(ctx.GetPlatformID())
 	c.IsCompress = isCompress
@@ -106,8 +119,30 @@ func (c *Client) ResetClient(
 	c.longConnServer = longConnServer
 	c.IsBackground = false
 	c.closed.Store(false)
+	c.closedErrMu.Lock()
 	c.closedErr = nil
+	c.closedErrMu.Unlock()
 	c.token = token
+	c.encoder = encoder
+	c.resumeToken = ""
+	c.resumeFrom = ""
+	c.acks = nil
+}
+
+// setClosedErr records why the client is closed, the first reason is kept.
+// It is called by the reader, the writer and the enqueue path.
+func (c *Client) setClosedErr(err error) {
+	c.closedErrMu.Lock()
+	defer c.closedErrMu.Unlock()
+	if c.closedErr == nil {
+		c.closedErr = err
+	}
+}
+
+func (c *Client) getClosedErr() error {
+	c.closedErrMu.Lock()
+	defer c.closedErrMu.Unlock()
+	return c.closedErr
 }
 
 // pingHandler handles ping messages and sends pong responses.
@@ -120,7 +155,7 @@ func (c *Client) pingHandler(_ string) error {
 func (c *Client) readMessage() {
 	defer func() {
 		if r := recover(); r != nil {
-			c.closedErr = ErrPanic
+			c.setClosedErr(ErrPanic)
 			fmt.Println("socket have panic err:", r, string(debug.Stack()))
 		}
 		c.close()
@@ -134,13 +169,13 @@ func (c *Client) readMessage() {
 		messageType, message, returnErr := c.conn.ReadMessage()
 		if returnErr != nil {
 			log.ZWarn(c.ctx, "readMessage", returnErr, "messageType", messageType)
-			c.closedErr = returnErr
+			c.setClosedErr(returnErr)
 			return
 		}
 
 		log.ZDebug(c.ctx, "readMessage", "messageType", messageType)
 		if c.closed.Load() { // 连接刚置位已经关闭，但是协程还没退出的场景
-			c.closedErr = ErrConnClosed
+			c.setClosedErr(ErrConnClosed)
 			return
 		}
 
@@ -149,19 +184,28 @@ func (c *Client) readMessage() {
 			_ = c.conn.SetReadDeadline(pongWait)
 			parseDataErr := c.handleMessage(message)
 			if parseDataErr != nil {
-				c.closedErr = parseDataErr
+				c.setClosedErr(parseDataErr)
 				return
 			}
 		case MessageText:
-			c.closedErr = ErrNotSupportMessageProtocol
-			return
+			// text frames are only meaningful to clients that negotiated json
+			if _, ok := c.encoder.(*JsonEncoder); !ok {
+				c.setClosedErr(ErrNotSupportMessageProtocol)
+				return
+			}
+			_ = c.conn.SetReadDeadline(pongWait)
+			parseDataErr := c.handleMessage(message)
+			if parseDataErr != nil {
+				c.setClosedErr(parseDataErr)
+				return
+			}
 
 		case PingMessage:
 			err := c.writePongMsg()
 			log.ZError(c.ctx, "writePongMsg", err)
 
 		case CloseMessage:
-			c.closedErr = ErrClientClosed
+			c.setClosedErr(ErrClientClosed)
 			return
 		default:
 		}
@@ -181,7 +225,7 @@ func (c *Client) handleMessage(message []byte) error {
 	var binaryReq = getReq()
 	defer freeReq(binaryReq)
 
-	err := c.longConnServer.Decode(message, binaryReq)
+	err := c.encoder.Decode(message, binaryReq)
 	if err != nil {
 		return utils.Wrap(err, "")
 	}
@@ -204,6 +248,13 @@ func (c *Client) handleMessage(message []byte) error {
 		messageErr error
 	)
 
+	switch binaryReq.ReqIdentifier {
+	case WSGetNewestSeq, WSSendMsg, WSSendSignalMsg, WSPullMsgBySeqList:
+		if scope, retryAfter, ok := c.limiter.allow(binaryReq.ReqIdentifier); !ok {
+			return c.replyRateLimited(ctx, binaryReq, scope, retryAfter)
+		}
+	}
+
 	switch binaryReq.ReqIdentifier {
 	case WSGetNewestSeq:
 		resp, messageErr = c.longConnServer.GetSeq(ctx, binaryReq)
@@ -217,6 +268,8 @@ func (c *Client) handleMessage(message []byte) error {
 		resp, messageErr = c.longConnServer.UserLogout(ctx, binaryReq)
 	case WsSetBackgroundStatus:
 		resp, messageErr = c.setAppBackgroundStatus(ctx, binaryReq)
+	case WSPushAck:
+		resp, messageErr = c.pushAck(ctx, binaryReq)
 	default:
 		return fmt.Errorf(
 			"ReqIdentifier failed,sendID:%s,msgIncr:%s,reqIdentifier:%d",
@@ -249,6 +302,9 @@ func (c *Client) close() {
 	defer c.w.Unlock()
 
 	c.closed.Store(true)
+	if c.queue != nil {
+		c.queue.close()
+	}
 	c.conn.Close()
 	c.longConnServer.UnRegister(c)
 }
@@ -263,25 +319,68 @@ func (c *Client) replyMessage(ctx context.Context, binaryReq *Req, err error, re
 		ErrMsg:        errResp.ErrMsg,
 		Data:          resp,
 	}
+	if binaryReq.ReqIdentifier == WsLogoutMsg {
+		// the queue is discarded when the client closes, so the ack is written synchronously
+		if err := c.writeBinaryMsgSync(mReply); err != nil {
+			log.ZWarn(ctx, "writeBinaryMsgSync replyMessage", err, "resp", mReply.String())
+		}
+		return errors.New("user logout")
+	}
 	err = c.writeBinaryMsg(mReply)
 	if err != nil {
 		log.ZWarn(ctx, "wireBinaryMsg replyMessage", err, "resp", mReply.String())
 	}
+	return nil
+}
 
-	if binaryReq.ReqIdentifier == WsLogoutMsg {
-		return errors.New("user logout")
+// replyRateLimited answers a rejected request with a WSDataError frame carrying a retry-after hint.
+func (c *Client) replyRateLimited(ctx context.Context, binaryReq *Req, scope string, retryAfter time.Duration) error {
+	log.ZWarn(ctx, "request rate limited", errs.ErrConnRateLimit, "reqIdentifier", binaryReq.ReqIdentifier,
+		"scope", scope, "retryAfter", retryAfter)
+	prommetrics.WsRequestRateLimitedCounter.WithLabelValues(scope).Inc()
+	data, err := proto.Marshal(&sdkws.RateLimitedTips{
+		ReqIdentifier: binaryReq.ReqIdentifier,
+		Scope:         scope,
+		RetryAfter:    retryAfter.Milliseconds(),
+	})
+	if err != nil {
+		return err
+	}
+	errResp := apiresp.ParseError(errs.ErrConnRateLimit.Wrap(scope))
+	mReply := Resp{
+		ReqIdentifier: WSDataError,
+		MsgIncr:       binaryReq.MsgIncr,
+		OperationID:   binaryReq.OperationID,
+		ErrCode:       errResp.ErrCode,
+		ErrMsg:        errResp.ErrMsg,
+		Data:          data,
+	}
+	if err := c.writeBinaryMsg(mReply); err != nil {
+		log.ZWarn(ctx, "wireBinaryMsg replyRateLimited", err, "resp", mReply.String())
 	}
 	return nil
 }
 
 func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error {
-	var msg sdkws.PushMessages
-	conversationID := msgprocessor.GetConversationIDByMsg(msgData)
-	m := map[string]*sdkws.PullMsgs{conversationID: {Msgs: []*sdkws.MsgData{msgData}}}
-	if msgprocessor.IsNotification(conversationID) {
-		msg.NotificationMsgs = m
-	} else {
-		msg.Msgs = m
+	return c.PushMessages(ctx, []*sdkws.MsgData{msgData})
+}
+
+// PushMessages pushes msgs in a single frame, grouped by conversation.
+func (c *Client) PushMessages(ctx context.Context, msgs []*sdkws.MsgData) error {
+	msg := sdkws.PushMessages{
+		Msgs:             make(map[string]*sdkws.PullMsgs),
+		NotificationMsgs: make(map[string]*sdkws.PullMsgs),
+	}
+	for _, msgData := range msgs {
+		conversationID := msgprocessor.GetConversationIDByMsg(msgData)
+		m := msg.Msgs
+		if msgprocessor.IsNotification(conversationID) {
+			m = msg.NotificationMsgs
+		}
+		if _, ok := m[conversationID]; !ok {
+			m[conversationID] = &sdkws.PullMsgs{}
+		}
+		m[conversationID].Msgs = append(m[conversationID].Msgs, msgData)
 	}
 	log.ZDebug(ctx, "PushMessage", "msg", &msg)
 	data, err := proto.Marshal(&msg)
@@ -293,41 +392,121 @@ func (c *Client) PushMessage(ctx context.Context, msgData *sdkws.MsgData) error
 		OperationID:   mcontext.GetOperationID(ctx),
 		Data:          data,
 	}
-	return c.writeBinaryMsg(resp)
+	if c.closed.Load() {
+		return nil
+	}
+	frame, err := c.encodeFrame(resp)
+	if err != nil {
+		return err
+	}
+	// tracked before the frame is queued, the ack may arrive before enqueue returns
+	keys := c.acks.track(c.UserID, msgs)
+	// pushes are the first to go when the client can not keep up, the caller reports the push as failed
+	// and the push service falls back to the offline push
+	if err := c.enqueue(queuedFrame{data: frame, msgs: msgs}, true); err != nil {
+		c.acks.untrack(keys)
+		return err
+	}
+	return nil
+}
+
+// PushEphemeralSignal pushes a signal that is dropped rather than queued for a slow client.
+func (c *Client) PushEphemeralSignal(ctx context.Context, signal *sdkws.EphemeralSignal) error {
+	data, err := proto.Marshal(signal)
+	if err != nil {
+		return err
+	}
+	resp := Resp{
+		ReqIdentifier: WSPushEphemeralSignal,
+		OperationID:   mcontext.GetOperationID(ctx),
+		Data:          data,
+	}
+	if c.closed.Load() {
+		return nil
+	}
+	frame, err := c.encodeFrame(resp)
+	if err != nil {
+		return err
+	}
+	return c.enqueue(queuedFrame{data: frame}, true)
+}
+
+// pushDropped pushes offline the persisted msgs of userID whose frame was accepted but never written
+// to the connection. It takes the client fields as arguments, the client may already be reused.
+func pushDropped(userID string, acks *pushAckTracker, server LongConnServer, msgs []*sdkws.MsgData) {
+	msgs = utils.Filter(msgs, func(msg *sdkws.MsgData) (*sdkws.MsgData, bool) {
+		return msg, msg.Seq > 0
+	})
+	if len(msgs) == 0 {
+		return
+	}
+	if acks != nil {
+		// already tracked, the tracker pushes them at its next check
+		acks.dropped(userID, msgs)
+		return
+	}
+	server.OfflinePushDropped(userID, msgs)
 }
 
 func (c *Client) KickOnlineMessage() error {
 	resp := Resp{
 		ReqIdentifier: WSKickOnlineMsg,
 	}
-	err := c.writeBinaryMsg(resp)
+	// written synchronously, the queue is discarded by the close below
+	err := c.writeBinaryMsgSync(resp)
 	c.close()
 	return err
 }
 
+// writeBinaryMsgSync writes resp bypassing the send queue, for the last frame before the client is closed.
+func (c *Client) writeBinaryMsgSync(resp Resp) error {
+	if c.closed.Load() {
+		return nil
+	}
+	frame, err := c.encodeFrame(resp)
+	if err != nil {
+		return err
+	}
+	return c.writeFrame(c.conn, frame)
+}
+
 func (c *Client) writeBinaryMsg(resp Resp) error {
 	if c.closed.Load() {
 		return nil
 	}
 
-	encodedBuf, err := c.longConnServer.Encode(resp)
+	frame, err := c.encodeFrame(resp)
 	if err != nil {
-		return utils.Wrap(err, "")
+		return err
 	}
+	return c.enqueue(queuedFrame{data: frame}, false)
+}
 
-	c.w.Lock()
-	defer c.w.Unlock()
-
-	err = c.conn.SetWriteDeadline(writeWait)
+// encodeFrame encodes and, if negotiated, compresses resp into a websocket frame.
+func (c *Client) encodeFrame(resp Resp) ([]byte, error) {
+	encodedBuf, err := c.encoder.Encode(resp)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
 	if c.IsCompress {
 		resultBuf, compressErr := c.longConnServer.CompressWithPool(encodedBuf)
 		if compressErr != nil {
-			return utils.Wrap(compressErr, "")
+			return nil, utils.Wrap(compressErr, "")
 		}
-		return c.conn.WriteMessage(MessageBinary, resultBuf)
+		return resultBuf, nil
 	}
+	return encodedBuf, nil
+}
 
-	return c.conn.WriteMessage(MessageBinary, encodedBuf)
+func (c *Client) writeFrame(conn LongConn, frame []byte) error {
+	c.w.Lock()
+	defer c.w.Unlock()
+
+	err := conn.SetWriteDeadline(writeWait)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	return conn.WriteMessage(MessageBinary, frame)
 }
 
 func (c *Client) writePongMsg() error {
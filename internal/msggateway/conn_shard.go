@@ -0,0 +1,181 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"runtime/debug"
+	"sync"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+)
+
+const (
+	// Default number of user map shards, each shard has its own event loop and side effect workers.
+	defaultUserMapShards = 32
+	// The side effects of one user always run on the same worker of its shard, in order.
+	sideEffectWorkers = 4
+
+	shardFlushTimeout = 5 * time.Second
+)
+
+// connEvent is a register, unregister, kick or eviction of one user, exactly one of the fields is set.
+type connEvent struct {
+	register   *Client
+	unregister *Client
+	kick       *kickHandler
+	evict      *tokenEviction
+	// flush is closed once the events queued before it are handled
+	flush chan struct{}
+}
+
+// taskQueue is an unbounded FIFO drained by a single goroutine. Pushing never blocks, the event loop
+// pushes to its own queue when it closes a kicked client.
+type taskQueue[T any] struct {
+	lock   sync.Mutex
+	items  []T
+	signal chan struct{}
+}
+
+func newTaskQueue[T any]() *taskQueue[T] {
+	return &taskQueue[T]{signal: make(chan struct{}, 1)}
+}
+
+func (q *taskQueue[T]) push(item T) {
+	q.lock.Lock()
+	q.items = append(q.items, item)
+	q.lock.Unlock()
+	select {
+	case q.signal <- struct{}{}:
+	default:
+	}
+}
+
+// run handles the queued items in order until done is closed.
+func (q *taskQueue[T]) run(done <-chan struct{}, handle func(item T)) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-q.signal:
+		}
+		q.lock.Lock()
+		items := q.items
+		q.items = nil
+		q.lock.Unlock()
+		for _, item := range items {
+			handle(item)
+		}
+	}
+}
+
+// connShard serializes the events of the users hashed onto it. The RPCs and callbacks an event
+// triggers run on the side effect workers, so they never block the next event.
+type connShard struct {
+	events  *taskQueue[connEvent]
+	effects []*taskQueue[func()]
+}
+
+func newConnShards(n int) []*connShard {
+	shards := make([]*connShard, n)
+	for i := range shards {
+		shards[i] = &connShard{
+			events:  newTaskQueue[connEvent](),
+			effects: make([]*taskQueue[func()], sideEffectWorkers),
+		}
+		for j := range shards[i].effects {
+			shards[i].effects[j] = newTaskQueue[func()]()
+		}
+	}
+	return shards
+}
+
+func (s *connShard) run(ws *WsServer, done <-chan struct{}) {
+	for _, effects := range s.effects {
+		go effects.run(done, runSideEffect)
+	}
+	s.runEvents(ws, done)
+}
+
+func (s *connShard) runEvents(ws *WsServer, done <-chan struct{}) {
+	s.events.run(done, func(event connEvent) {
+		switch {
+		case event.register != nil:
+			ws.registerClient(event.register)
+		case event.unregister != nil:
+			ws.unregisterClient(event.unregister)
+		case event.kick != nil:
+			ws.multiTerminalLoginChecker(event.kick.clientOK, event.kick.oldClients, event.kick.newClient)
+		case event.evict != nil:
+			ws.evictConns(event.evict)
+		case event.flush != nil:
+			close(event.flush)
+		}
+	})
+}
+
+func runSideEffect(effect func()) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.ZError(context.Background(), "side effect panic", fmt.Errorf("%v", r), "stack", string(debug.Stack()))
+		}
+	}()
+	effect()
+}
+
+func (ws *WsServer) connShard(userID string) *connShard {
+	return ws.shards[ws.clients.shardIndex(userID)]
+}
+
+func (ws *WsServer) dispatch(userID string, event connEvent) {
+	ws.connShard(userID).events.push(event)
+}
+
+// sideEffect queues f behind the earlier side effects of userID.
+func (ws *WsServer) sideEffect(userID string, f func()) {
+	shard := ws.connShard(userID)
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(userID))
+	// the users of a shard share its index modulo the shard count, the quotient spreads them over the workers
+	i := h.Sum32() / uint32(len(ws.shards)) % uint32(len(shard.effects))
+	shard.effects[i].push(f)
+}
+
+// runShards starts the event loops and side effect workers, they run until stopShards.
+func (ws *WsServer) runShards() {
+	for _, shard := range ws.shards {
+		go shard.run(ws, ws.shardsDone)
+	}
+}
+
+// stopShards waits for the events already queued, then stops the event loops and side effect workers.
+func (ws *WsServer) stopShards() {
+	flushes := make([]chan struct{}, len(ws.shards))
+	for i, shard := range ws.shards {
+		flushes[i] = make(chan struct{})
+		shard.events.push(connEvent{flush: flushes[i]})
+	}
+	timeout := time.After(shardFlushTimeout)
+	for _, flush := range flushes {
+		select {
+		case <-flush:
+		case <-timeout:
+		}
+	}
+	close(ws.shardsDone)
+}
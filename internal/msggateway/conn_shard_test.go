@@ -0,0 +1,131 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskQueue(t *testing.T) {
+	const n = 10000
+	q := newTaskQueue[int]()
+	// pushing never blocks, even with nobody draining the queue
+	for i := 0; i < n; i++ {
+		q.push(i)
+	}
+	done := make(chan struct{})
+	var handled []int
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		q.run(done, func(i int) {
+			handled = append(handled, i)
+			if len(handled) == n {
+				close(done)
+			}
+		})
+	}()
+	wg.Wait()
+	for i, v := range handled {
+		if v != i {
+			t.Fatalf("item %d handled at %d", v, i)
+		}
+	}
+}
+
+func TestStopShards(t *testing.T) {
+	ws, err := NewWsServer(WithUserMapShards(4))
+	assert.NoError(t, err)
+	for _, shard := range ws.shards {
+		go shard.runEvents(ws, ws.shardsDone)
+	}
+	for i := 0; i < 100; i++ {
+		ws.dispatch(strconv.Itoa(i), connEvent{register: newTestClient(strconv.Itoa(i), 1, strconv.Itoa(i))})
+	}
+	// the events queued before the stop are handled
+	ws.stopShards()
+	assert.EqualValues(t, 100, ws.onlineUserConnNum.Load())
+	select {
+	case <-ws.shardsDone:
+	default:
+		t.Fatal("shards not stopped")
+	}
+}
+
+func TestSideEffectOrder(t *testing.T) {
+	ws, err := NewWsServer(WithUserMapShards(2))
+	assert.NoError(t, err)
+	ws.runShards()
+	defer ws.stopShards()
+	var (
+		lock    sync.Mutex
+		effects = make(map[string][]int)
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < 100; i++ {
+		for _, userID := range []string{"a", "b", "c", "d"} {
+			i, userID := i, userID
+			wg.Add(1)
+			ws.sideEffect(userID, func() {
+				defer wg.Done()
+				lock.Lock()
+				defer lock.Unlock()
+				effects[userID] = append(effects[userID], i)
+			})
+		}
+	}
+	wg.Wait()
+	for userID, seq := range effects {
+		for i, v := range seq {
+			if v != i {
+				t.Fatalf("side effect %d of %s ran at %d", v, userID, i)
+			}
+		}
+	}
+}
+
+// BenchmarkRegisterClient measures registrations going through the shard event loops, the side effects
+// are queued but not run.
+func BenchmarkRegisterClient(b *testing.B) {
+	for _, shards := range []int{1, defaultUserMapShards} {
+		b.Run(fmt.Sprintf("shards-%d", shards), func(b *testing.B) {
+			ws, err := NewWsServer(WithUserMapShards(shards))
+			if err != nil {
+				b.Fatal(err)
+			}
+			for _, shard := range ws.shards {
+				go shard.runEvents(ws, ws.shardsDone)
+			}
+			var seq atomic.Int64
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := seq.Add(1)
+					userID := strconv.FormatInt(i%10000, 10)
+					client := newTestClient(userID, 1, strconv.FormatInt(i, 10))
+					ws.dispatch(userID, connEvent{register: client})
+					ws.dispatch(userID, connEvent{unregister: client})
+				}
+			})
+			ws.stopShards()
+		})
+	}
+}
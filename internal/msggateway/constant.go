@@ -27,22 +27,43 @@ const (
 	GzipCompressionProtocol = "gzip"
 	BackgroundStatus        = "isBackground"
 	MsgResp                 = "isMsgResp"
+	Encoding                = "encoding"
+	ResumeToken             = "resumeToken"
+	PushAck                 = "pushAck"
+	SdkVersion              = "sdkVersion"
+)
+
+const (
+	// Frame encodings a client may negotiate at handshake.
+	GobEncoding      = "gob"
+	JsonEncoding     = "json"
+	ProtobufEncoding = "protobuf"
 )
 
 const (
 	WebSocket = iota + 1
 )
 
+const (
+	// Ephemeral signal types, they are only delivered to online connections.
+	SignalTyping = iota + 1
+	SignalRecording
+	SignalViewing
+)
+
 const (
 	// Websocket Protocol.
 	WSGetNewestSeq        = 1001
 	WSPullMsgBySeqList    = 1002
 	WSSendMsg             = 1003
 	WSSendSignalMsg       = 1004
+	WSPushAck             = 1005
 	WSPushMsg             = 2001
 	WSKickOnlineMsg       = 2002
 	WsLogoutMsg           = 2003
 	WsSetBackgroundStatus = 2004
+	WSSessionResume       = 2005
+	WSPushEphemeralSignal = 2006
 	WSDataError           = 3001
 )
 
@@ -55,4 +76,7 @@ const (
 
 	// Maximum message size allowed from peer.
 	maxMessageSize = 51200
+
+	// Default length of the per connection send queue.
+	defaultSendQueueSize = 256
 )
@@ -0,0 +1,194 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"sort"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/tokenverify"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// evictReasonTotal is reported when the total cap is exceeded, otherwise the terminal class is.
+const evictReasonTotal = "total"
+
+type deviceSession struct {
+	platformID int
+	token      string
+	loginTime  time.Time
+}
+
+type deviceLimit struct {
+	total    int
+	terminal map[string]int
+}
+
+type evictedSession struct {
+	deviceSession
+	reason string
+}
+
+// selectEvictions picks the oldest sessions to drop so that, together with the session of newToken
+// which is never evicted, no terminal class cap and then the total cap is exceeded.
+func selectEvictions(sessions []deviceSession, newToken string, limit deviceLimit) []evictedSession {
+	sort.SliceStable(sessions, func(i, j int) bool {
+		return sessions[i].loginTime.Before(sessions[j].loginTime)
+	})
+	evicted := make(map[string]bool)
+	var res []evictedSession
+	evict := func(s deviceSession, reason string) {
+		evicted[s.token] = true
+		res = append(res, evictedSession{deviceSession: s, reason: reason})
+	}
+	count := func(match func(s deviceSession) bool) int {
+		var n int
+		for _, s := range sessions {
+			if !evicted[s.token] && match(s) {
+				n++
+			}
+		}
+		return n
+	}
+	trim := func(max int, reason string, match func(s deviceSession) bool) {
+		if max <= 0 {
+			return
+		}
+		over := count(match) - max
+		for _, s := range sessions {
+			if over <= 0 {
+				return
+			}
+			if s.token != newToken && !evicted[s.token] && match(s) {
+				evict(s, reason)
+				over--
+			}
+		}
+	}
+	for class, max := range limit.terminal {
+		class := class
+		trim(max, class, func(s deviceSession) bool {
+			return constant.PlatformIDToClass(s.platformID) == class
+		})
+	}
+	trim(limit.total, evictReasonTotal, func(s deviceSession) bool { return true })
+	return res
+}
+
+// deviceCountLimitChecker enforces the DeviceCountLimit policy for the login of newClient. The sessions
+// of the user are its valid tokens across all platforms, the evicted tokens are marked kicked and every
+// node holding their connections kicks them in multiTerminalLoginChecker.
+// It runs on the side effect worker of the user, ahead of the login check requests to the other nodes.
+func (ws *WsServer) deviceCountLimitChecker(newClient *Client) {
+	ctx := newClient.ctx
+	tokenMaps, err := ws.cache.GetAllPlatformTokens(ctx, newClient.UserID)
+	if err != nil {
+		log.ZWarn(ctx, "get tokens from redis err", err, "userID", newClient.UserID)
+		return
+	}
+	var sessions []deviceSession
+	for platformID, m := range tokenMaps {
+		for token, flag := range m {
+			if flag != constant.NormalToken {
+				continue
+			}
+			claims, err := tokenverify.GetClaimFromToken(token, authverify.Secret())
+			if err != nil || claims.IssuedAt == nil {
+				continue
+			}
+			sessions = append(sessions, deviceSession{platformID: platformID, token: token, loginTime: claims.IssuedAt.Time})
+		}
+	}
+	limit := deviceLimit{
+		total:    config.Config.MultiLoginDeviceLimit.Total,
+		terminal: config.Config.MultiLoginDeviceLimit.Terminal,
+	}
+	evicted := selectEvictions(sessions, newClient.token, limit)
+	if len(evicted) == 0 {
+		return
+	}
+	platforms := make(map[int]struct{})
+	for _, e := range evicted {
+		tokenMaps[e.platformID][e.token] = constant.KickedToken
+		platforms[e.platformID] = struct{}{}
+	}
+	for platformID := range platforms {
+		if err := ws.cache.SetTokenMapByUidPid(ctx, newClient.UserID, platformID, tokenMaps[platformID]); err != nil {
+			log.ZWarn(ctx, "SetTokenMapByUidPid err", err, "userID", newClient.UserID, "platformID", platformID)
+			return
+		}
+	}
+	log.ZInfo(ctx, "device count limit exceeded", "userID", newClient.UserID, "evicted", len(evicted))
+	for _, e := range evicted {
+		err := CallbackUserDeviceEvicted(ctx, newClient.UserID, e.platformID, e.loginTime.UnixMilli(), newClient.PlatformID, newClient.ctx.GetConnID(), e.reason)
+		if err != nil {
+			log.ZWarn(ctx, "CallbackUserDeviceEvicted err", err, "userID", newClient.UserID, "platformID", e.platformID)
+		}
+	}
+}
+
+// tokenEviction closes the connections of userID logged in with one of the kicked tokens.
+type tokenEviction struct {
+	userID string
+	tokens map[string]struct{}
+}
+
+// kickEvictedConns looks up the kicked tokens of the user off the event loop, then kicks the
+// connections of the user on this node using them.
+func (ws *WsServer) kickEvictedConns(newClient *Client) {
+	tokenMaps, err := ws.cache.GetAllPlatformTokens(newClient.ctx, newClient.UserID)
+	if err != nil {
+		log.ZWarn(newClient.ctx, "get tokens from redis err", err, "userID", newClient.UserID)
+		return
+	}
+	tokens := make(map[string]struct{})
+	for _, m := range tokenMaps {
+		for token, flag := range m {
+			if flag == constant.KickedToken {
+				tokens[token] = struct{}{}
+			}
+		}
+	}
+	if len(tokens) == 0 {
+		return
+	}
+	ws.dispatch(newClient.UserID, connEvent{evict: &tokenEviction{userID: newClient.UserID, tokens: tokens}})
+}
+
+func (ws *WsServer) evictConns(e *tokenEviction) {
+	clients, ok := ws.clients.GetAll(e.userID)
+	if !ok {
+		return
+	}
+	var kicked []*Client
+	for _, c := range clients {
+		if _, ok := e.tokens[c.token]; ok {
+			kicked = append(kicked, c)
+		}
+	}
+	if len(kicked) == 0 {
+		return
+	}
+	ws.clients.deleteClients(e.userID, kicked)
+	for _, c := range kicked {
+		if err := c.KickOnlineMessage(); err != nil {
+			log.ZWarn(c.ctx, "KickOnlineMessage", err)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/OpenIMSDK/protocol/constant"
+)
+
+func TestSelectEvictions(t *testing.T) {
+	now := time.Now()
+	sessions := []deviceSession{
+		{platformID: constant.IOSPlatformID, token: "ios", loginTime: now.Add(-4 * time.Hour)},
+		{platformID: constant.WindowsPlatformID, token: "win", loginTime: now.Add(-3 * time.Hour)},
+		{platformID: constant.AndroidPlatformID, token: "android", loginTime: now.Add(-2 * time.Hour)},
+		{platformID: constant.OSXPlatformID, token: "mac", loginTime: now.Add(-time.Hour)},
+		{platformID: constant.WebPlatformID, token: "web", loginTime: now},
+	}
+	limit := deviceLimit{total: 2, terminal: map[string]int{constant.TerminalMobile: 1}}
+	evicted := selectEvictions(sessions, "android", limit)
+	var tokens, reasons []string
+	for _, e := range evicted {
+		tokens = append(tokens, e.token)
+		reasons = append(reasons, e.reason)
+	}
+	assert.Equal(t, []string{"ios", "win", "mac"}, tokens)
+	assert.Equal(t, []string{constant.TerminalMobile, evictReasonTotal, evictReasonTotal}, reasons)
+
+	assert.Empty(t, selectEvictions(sessions, "web", deviceLimit{}))
+}
+
+func TestEvictConns(t *testing.T) {
+	ws, err := NewWsServer(WithUserMapShards(2))
+	assert.NoError(t, err)
+	newClient := func(token string) (*Client, *testConn) {
+		c, conn, _ := newQueueTestClient(SlowConsumerDrop)
+		c.ctx = &UserConnContext{RemoteAddr: token}
+		c.encoder = NewGobEncoder()
+		c.token = token
+		return c, conn
+	}
+	kicked, kickedConn := newClient("old")
+	kept, keptConn := newClient("new")
+	ws.clients.Set(kicked.UserID, kicked)
+	ws.clients.Set(kept.UserID, kept)
+
+	ws.evictConns(&tokenEviction{userID: kicked.UserID, tokens: map[string]struct{}{"old": {}}})
+	clients, ok := ws.clients.GetAll(kicked.UserID)
+	assert.True(t, ok)
+	assert.Equal(t, []*Client{kept}, clients)
+	// the kicked connection got the kick frame before it was closed
+	assert.Len(t, kickedConn.frames, 1)
+	assert.True(t, kickedConn.isClosed())
+	assert.False(t, keptConn.isClosed())
+}
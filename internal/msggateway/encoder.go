@@ -17,10 +17,17 @@ package msggateway
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
+	"errors"
 
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/utils"
 )
 
+var ErrNotSupportEncodeType = errors.New("not support encode type")
+
 type Encoder interface {
 	Encode(data any) ([]byte, error)
 	Decode(encodeData []byte, decodeData any) error
@@ -51,3 +58,126 @@ func (g *GobEncoder) Decode(encodeData []byte, decodeData any) error {
 	}
 	return nil
 }
+
+// NewEncoder returns the encoder matching the frame encoding negotiated at handshake,
+// an empty encoding falls back to gob for backward compatibility.
+func NewEncoder(encoding string) (Encoder, error) {
+	switch encoding {
+	case "", GobEncoding:
+		return NewGobEncoder(), nil
+	case JsonEncoding:
+		return NewJsonEncoder(), nil
+	case ProtobufEncoding:
+		return NewProtobufEncoder(), nil
+	default:
+		return nil, ErrNotSupportEncodeType
+	}
+}
+
+type JsonEncoder struct{}
+
+func NewJsonEncoder() *JsonEncoder {
+	return &JsonEncoder{}
+}
+
+func (j *JsonEncoder) Encode(data any) ([]byte, error) {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return b, nil
+}
+
+func (j *JsonEncoder) Decode(encodeData []byte, decodeData any) error {
+	err := json.Unmarshal(encodeData, decodeData)
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	return nil
+}
+
+// ProtobufEncoder maps Req and Resp onto sdkws.WsReq and sdkws.WsResp.
+type ProtobufEncoder struct{}
+
+func NewProtobufEncoder() *ProtobufEncoder {
+	return &ProtobufEncoder{}
+}
+
+func (p *ProtobufEncoder) Encode(data any) ([]byte, error) {
+	var m proto.Message
+	switch v := data.(type) {
+	case Resp:
+		m = respToPb(&v)
+	case *Resp:
+		m = respToPb(v)
+	case Req:
+		m = reqToPb(&v)
+	case *Req:
+		m = reqToPb(v)
+	case proto.Message:
+		m = v
+	default:
+		return nil, utils.Wrap(ErrNotSupportEncodeType, "")
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		return nil, utils.Wrap(err, "")
+	}
+	return b, nil
+}
+
+func (p *ProtobufEncoder) Decode(encodeData []byte, decodeData any) error {
+	switch v := decodeData.(type) {
+	case *Req:
+		var pb sdkws.WsReq
+		if err := proto.Unmarshal(encodeData, &pb); err != nil {
+			return utils.Wrap(err, "")
+		}
+		v.ReqIdentifier = pb.ReqIdentifier
+		v.Token = pb.Token
+		v.SendID = pb.SendID
+		v.OperationID = pb.OperationID
+		v.MsgIncr = pb.MsgIncr
+		v.Data = pb.Data
+	case *Resp:
+		var pb sdkws.WsResp
+		if err := proto.Unmarshal(encodeData, &pb); err != nil {
+			return utils.Wrap(err, "")
+		}
+		v.ReqIdentifier = pb.ReqIdentifier
+		v.MsgIncr = pb.MsgIncr
+		v.OperationID = pb.OperationID
+		v.ErrCode = int(pb.ErrCode)
+		v.ErrMsg = pb.ErrMsg
+		v.Data = pb.Data
+	case proto.Message:
+		if err := proto.Unmarshal(encodeData, v); err != nil {
+			return utils.Wrap(err, "")
+		}
+	default:
+		return utils.Wrap(ErrNotSupportEncodeType, "")
+	}
+	return nil
+}
+
+func reqToPb(r *Req) *sdkws.WsReq {
+	return &sdkws.WsReq{
+		ReqIdentifier: r.ReqIdentifier,
+		Token:         r.Token,
+		SendID:        r.SendID,
+		OperationID:   r.OperationID,
+		MsgIncr:       r.MsgIncr,
+		Data:          r.Data,
+	}
+}
+
+func respToPb(r *Resp) *sdkws.WsResp {
+	return &sdkws.WsResp{
+		ReqIdentifier: r.ReqIdentifier,
+		MsgIncr:       r.MsgIncr,
+		OperationID:   r.OperationID,
+		ErrCode:       int32(r.ErrCode),
+		ErrMsg:        r.ErrMsg,
+		Data:          r.Data,
+	}
+}
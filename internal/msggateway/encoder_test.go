@@ -0,0 +1,61 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncoderRoundTrip(t *testing.T) {
+	for _, encoding := range []string{"", GobEncoding, JsonEncoding, ProtobufEncoding} {
+		encoder, err := NewEncoder(encoding)
+		assert.Equal(t, nil, err)
+
+		req := Req{
+			ReqIdentifier: WSSendMsg,
+			Token:         "token",
+			SendID:        "sendID",
+			OperationID:   "operationID",
+			MsgIncr:       "1",
+			Data:          mockRandom(),
+		}
+		buf, err := encoder.Encode(req)
+		assert.Equal(t, nil, err)
+		var decodedReq Req
+		assert.Equal(t, nil, encoder.Decode(buf, &decodedReq))
+		assert.EqualValues(t, req, decodedReq)
+
+		resp := Resp{
+			ReqIdentifier: WSPushMsg,
+			MsgIncr:       "1",
+			OperationID:   "operationID",
+			ErrCode:       1001,
+			ErrMsg:        "errMsg",
+			Data:          mockRandom(),
+		}
+		buf, err = encoder.Encode(resp)
+		assert.Equal(t, nil, err)
+		var decodedResp Resp
+		assert.Equal(t, nil, encoder.Decode(buf, &decodedResp))
+		assert.EqualValues(t, resp, decodedResp)
+	}
+}
+
+func TestNewEncoderNotSupport(t *testing.T) {
+	_, err := NewEncoder("xml")
+	assert.Equal(t, ErrNotSupportEncodeType, err)
+}
@@ -16,6 +16,9 @@ package msggateway
 
 import (
 	"context"
+	"net"
+	"sort"
+	"strconv"
 
 	"google.golang.org/grpc"
 
@@ -25,6 +28,7 @@ import (
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
 	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/network"
 	"github.com/OpenIMSDK/tools/utils"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
@@ -39,7 +43,13 @@ func (s *Server) InitServer(disCov discoveryregistry.SvcDiscoveryRegistry, serve
 		return err
 	}
 
+	registerIP, err := network.GetRpcRegisterIP(config.Config.Rpc.RegisterIP)
+	if err != nil {
+		return err
+	}
+	s.node = net.JoinHostPort(registerIP, strconv.Itoa(s.rpcPort))
 	msgModel := cache.NewMsgCacheModel(rdb)
+	s.cache = msgModel
 	s.LongConnServer.SetDiscoveryRegistry(disCov)
 	s.LongConnServer.SetCacheHandler(msgModel)
 	msggateway.RegisterMsgGatewayServer(server, s)
@@ -60,6 +70,8 @@ type Server struct {
 	prometheusPort int
 	LongConnServer LongConnServer
 	pushTerminal   []int
+	node           string
+	cache          cache.MsgModel
 }
 
 func (s *Server) SetLongConnServer(LongConnServer LongConnServer) {
@@ -138,6 +150,7 @@ func (s *Server) SuperGroupOnlineBatchPushOneMsg(
 		results := &msggateway.SingleMsgToUserResults{
 			UserID: v,
 		}
+		s.LongConnServer.BufferResumePush(ctx, v, req.MsgData)
 		clients, ok := s.LongConnServer.GetUserAllCons(v)
 		if !ok {
 			log.ZDebug(ctx, "push user not online", "userID", v)
@@ -226,3 +239,119 @@ func (s *Server) MultiTerminalLoginCheck(
 	}
 	return &msggateway.MultiTerminalLoginCheckResp{}, nil
 }
+
+func (s *Server) connInfo(client *Client) *msggateway.ConnInfo {
+	return &msggateway.ConnInfo{
+		ConnID:       client.ctx.GetConnID(),
+		UserID:       client.UserID,
+		PlatformID:   int32(client.PlatformID),
+		Platform:     constant.PlatformIDToName(client.PlatformID),
+		RemoteAddr:   client.ctx.GetRemoteAddr(),
+		IsBackground: client.IsBackground,
+		IsCompress:   client.IsCompress,
+		Encoding:     client.encoding,
+		ConnectTime:  client.connectTime,
+		Node:         s.node,
+	}
+}
+
+func (s *Server) findConn(connID string) *Client {
+	var found *Client
+	s.LongConnServer.RangeCons(func(client *Client) bool {
+		if client.ctx.GetConnID() == connID {
+			found = client
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+// GetConns lists the connections held by this node, newest first.
+func (s *Server) GetConns(ctx context.Context, req *msggateway.GetConnsReq) (*msggateway.GetConnsResp, error) {
+	if !authverify.IsAppManagerUid(ctx) {
+		return nil, errs.ErrNoPermission.Wrap("only app manager")
+	}
+	userIDs := utils.SliceSetAny(req.UserIDs, func(e string) string { return e })
+	platformIDs := utils.SliceSetAny(req.PlatformIDs, func(e int32) int32 { return e })
+	var conns []*msggateway.ConnInfo
+	s.LongConnServer.RangeCons(func(client *Client) bool {
+		if len(userIDs) > 0 {
+			if _, ok := userIDs[client.UserID]; !ok {
+				return true
+			}
+		}
+		if len(platformIDs) > 0 {
+			if _, ok := platformIDs[int32(client.PlatformID)]; !ok {
+				return true
+			}
+		}
+		conns = append(conns, s.connInfo(client))
+		return true
+	})
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].ConnectTime > conns[j].ConnectTime
+	})
+	resp := &msggateway.GetConnsResp{Total: int64(len(conns)), Conns: conns}
+	if req.Pagination != nil {
+		resp.Conns = utils.Paginate(conns, int(req.Pagination.PageNumber), int(req.Pagination.ShowNumber))
+	}
+	return resp, nil
+}
+
+// GetConn returns the connection with req.ConnID, conn is nil if it is not held by this node.
+func (s *Server) GetConn(ctx context.Context, req *msggateway.GetConnReq) (*msggateway.GetConnResp, error) {
+	if !authverify.IsAppManagerUid(ctx) {
+		return nil, errs.ErrNoPermission.Wrap("only app manager")
+	}
+	var resp msggateway.GetConnResp
+	if client := s.findConn(req.ConnID); client != nil {
+		resp.Conn = s.connInfo(client)
+	}
+	return &resp, nil
+}
+
+// KickConn closes a single connection, the other connections of the user stay online.
+func (s *Server) KickConn(ctx context.Context, req *msggateway.KickConnReq) (*msggateway.KickConnResp, error) {
+	if !authverify.IsAppManagerUid(ctx) {
+		return nil, errs.ErrNoPermission.Wrap("only app manager")
+	}
+	client := s.findConn(req.ConnID)
+	if client == nil {
+		log.ZInfo(ctx, "conn not exist", "connID", req.ConnID)
+		return &msggateway.KickConnResp{}, nil
+	}
+	conn := s.connInfo(client)
+	if req.InvalidateToken {
+		if err := s.cache.AddTokenFlag(ctx, client.UserID, client.PlatformID, client.token, constant.KickedToken); err != nil {
+			return nil, err
+		}
+	}
+	log.ZInfo(ctx, "kick conn", "connID", req.ConnID, "userID", client.UserID, "platformID", client.PlatformID)
+	if err := s.LongConnServer.KickUserConn(client); err != nil {
+		log.ZWarn(ctx, "kick conn failed", err, "connID", req.ConnID)
+	}
+	return &msggateway.KickConnResp{Conn: conn}, nil
+}
+
+// OnlinePushEphemeralSignal pushes the signal to the foreground connections of the users on this node.
+func (s *Server) OnlinePushEphemeralSignal(
+	ctx context.Context,
+	req *msggateway.OnlinePushEphemeralSignalReq,
+) (*msggateway.OnlinePushEphemeralSignalResp, error) {
+	for _, userID := range req.PushToUserIDs {
+		clients, ok := s.LongConnServer.GetUserAllCons(userID)
+		if !ok {
+			continue
+		}
+		for _, client := range clients {
+			if client == nil || client.IsBackground {
+				continue
+			}
+			if err := client.PushEphemeralSignal(ctx, req.Signal); err != nil {
+				log.ZDebug(ctx, "push ephemeral signal failed", "userID", userID, "platformID", client.PlatformID, "err", err)
+			}
+		}
+	}
+	return &msggateway.OnlinePushEphemeralSignalResp{}, nil
+}
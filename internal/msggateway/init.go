@@ -15,6 +15,8 @@
 package msggateway
 
 import (
+	"context"
+	"crypto/tls"
 	"fmt"
 	"time"
 
@@ -22,6 +24,7 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	tlsutil "github.com/openimsdk/open-im-server/v3/pkg/common/tls"
 )
 
 // RunWsAndServer run ws server.
@@ -34,12 +37,40 @@ func RunWsAndServer(rpcPort, wsPort, prometheusPort int) error {
 		", OpenIM version: ",
 		config.Version,
 	)
+	var resumeWindow time.Duration
+	if config.Config.LongConnSvr.SessionResume.Enable {
+		resumeWindow = time.Duration(config.Config.LongConnSvr.SessionResume.Window) * time.Second
+	}
+	var pushAckTimeout time.Duration
+	if config.Config.LongConnSvr.PushAck.Enable {
+		pushAckTimeout = time.Duration(config.Config.LongConnSvr.PushAck.Timeout) * time.Second
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var tlsConfig *tls.Config
+	if tlsConf := config.Config.LongConnSvr.TLS; tlsConf.Enable {
+		var err error
+		tlsConfig, err = tlsutil.NewServerTLSConfig(ctx, tlsConf.Cert, tlsConf.Key, []byte(tlsConf.KeyPwd),
+			time.Duration(tlsConf.ReloadInterval)*time.Second)
+		if err != nil {
+			return err
+		}
+	}
 	longServer, err := NewWsServer(
 		WithPort(wsPort),
 		WithMaxConnNum(int64(config.Config.LongConnSvr.WebsocketMaxConnNum)),
 		WithHandshakeTimeout(time.Duration(config.Config.LongConnSvr.WebsocketTimeout)*time.Second),
 		WithMessageMaxMsgLength(config.Config.LongConnSvr.WebsocketMaxMsgLen),
 		WithWriteBufferSize(config.Config.LongConnSvr.WebsocketWriteBufferSize),
+		WithSendQueueSize(config.Config.LongConnSvr.WebsocketSendQueueSize),
+		WithSendQueueHighWater(config.Config.LongConnSvr.WebsocketSendQueueHighWater),
+		WithSlowConsumerPolicy(config.Config.LongConnSvr.WebsocketSlowConsumerPolicy),
+		WithUserMapShards(config.Config.LongConnSvr.WebsocketUserMapShards),
+		WithRateLimit(config.Config.LongConnSvr.RateLimit),
+		WithSessionResume(resumeWindow, config.Config.LongConnSvr.SessionResume.MaxBufferedMsgs),
+		WithPushAck(pushAckTimeout, time.Duration(config.Config.LongConnSvr.PushAck.DeliveryStateExpire)*time.Second),
+		WithSdkVersion(config.Config.LongConnSvr.SdkVersion),
+		WithTLSConfig(tlsConfig),
 	)
 	if err != nil {
 		return err
@@ -16,6 +16,7 @@ package msggateway
 
 import (
 	"context"
+	"fmt"
 	"sync"
 
 	"github.com/OpenIMSDK/protocol/push"
@@ -24,10 +25,14 @@ import (
 	"github.com/go-playground/validator/v10"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msg"
 	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mcontext"
 	"github.com/OpenIMSDK/tools/utils"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -108,14 +113,16 @@ type GrpcHandler struct {
 	msgRpcClient *rpcclient.MessageRpcClient
 	pushClient   *rpcclient.PushRpcClient
 	validate     *validator.Validate
+	limiter      *rateLimiter
 }
 
-func NewGrpcHandler(validate *validator.Validate, client discoveryregistry.SvcDiscoveryRegistry) *GrpcHandler {
+func NewGrpcHandler(validate *validator.Validate, client discoveryregistry.SvcDiscoveryRegistry, limiter *rateLimiter) *GrpcHandler {
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
 	pushRpcClient := rpcclient.NewPushRpcClient(client)
 	return &GrpcHandler{
 		msgRpcClient: &msgRpcClient,
 		pushClient:   &pushRpcClient, validate: validate,
+		limiter: limiter,
 	}
 }
 
@@ -159,16 +166,29 @@ func (g GrpcHandler) SendMessage(context context.Context, data *Req) ([]byte, er
 	return c, nil
 }
 
+// SendSignalMessage forwards an ephemeral signal to the push service, it is never persisted.
 func (g GrpcHandler) SendSignalMessage(context context.Context, data *Req) ([]byte, error) {
-	resp, err := g.msgRpcClient.SendMsg(context, nil)
-	if err != nil {
+	signal := sdkws.EphemeralSignal{}
+	if err := proto.Unmarshal(data.Data, &signal); err != nil {
 		return nil, err
 	}
-	c, err := proto.Marshal(resp)
-	if err != nil {
+	if signal.SignalType < SignalTyping || signal.SignalType > SignalViewing {
+		return nil, errs.ErrArgs.Wrap("unknown signalType")
+	}
+	signal.SendID = data.SendID
+	signal.SenderPlatformID = int32(constant.PlatformNameToID(mcontext.GetOpUserPlatform(context)))
+	signal.SendTime = utils.GetCurrentTimestampByMill()
+	conversationID := msgprocessor.GetConversationIDBySessionType(int(signal.SessionType), signal.SendID, signal.RecvID)
+	if signal.SessionType != constant.SingleChatType {
+		conversationID = msgprocessor.GetConversationIDBySessionType(int(signal.SessionType), signal.GroupID)
+	}
+	if retryAfter, ok := g.limiter.allowSignal(conversationID); !ok {
+		return nil, errs.ErrConnRateLimit.Wrap(fmt.Sprintf("conversation %s retry after %s", conversationID, retryAfter))
+	}
+	if err := g.pushClient.PushEphemeralSignal(context, &signal); err != nil {
 		return nil, err
 	}
-	return c, nil
+	return nil, nil
 }
 
 func (g GrpcHandler) PullMessageBySeqList(context context.Context, data *Req) ([]byte, error) {
@@ -16,6 +16,7 @@ package msggateway
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -30,11 +31,13 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
 	"github.com/redis/go-redis/v9"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
@@ -52,10 +55,13 @@ type LongConnServer interface {
 	wsHandler(w http.ResponseWriter, r *http.Request)
 	GetUserAllCons(userID string) ([]*Client, bool)
 	GetUserPlatformCons(userID string, platform int) ([]*Client, bool, bool)
+	RangeCons(f func(client *Client) bool)
 	Validate(s any) error
 	SetCacheHandler(cache cache.MsgModel)
 	SetDiscoveryRegistry(client discoveryregistry.SvcDiscoveryRegistry)
 	KickUserConn(client *Client) error
+	BufferResumePush(ctx context.Context, userID string, msgData *sdkws.MsgData)
+	OfflinePushDropped(userID string, msgs []*sdkws.MsgData)
 	UnRegister(c *Client)
 	SetKickHandlerInfo(i *kickHandler)
 	Compressor
@@ -72,15 +78,25 @@ var bufferPool = sync.Pool{
 type WsServer struct {
 	port              int
 	wsMaxConnNum      int64
-	registerChan      chan *Client
-	unregisterChan    chan *Client
-	kickHandlerChan   chan *kickHandler
+	shards            []*connShard
+	shardsDone        chan struct{}
 	clients           *UserMap
 	clientPool        sync.Pool
 	onlineUserNum     atomic.Int64
 	onlineUserConnNum atomic.Int64
 	handshakeTimeout  time.Duration
 	writeBufferSize   int
+	sendQueueSize     int
+	sendQueueHigh     int
+	slowConsumer      string
+	rateLimiter       *rateLimiter
+	resumeWindow      time.Duration
+	resumeMaxBuffered int64
+	resumeSessions    *resumeSessions
+	pushAcks          *pushAckTracker
+	sdkVersion        config.WsSdkVersion
+	tlsConfig         *tls.Config
+	pushClient        *rpcclient.PushRpcClient
 	validate          *validator.Validate
 	cache             cache.MsgModel
 	userClient        *rpcclient.UserRpcClient
@@ -96,9 +112,11 @@ type kickHandler struct {
 }
 
 func (ws *WsServer) SetDiscoveryRegistry(disCov discoveryregistry.SvcDiscoveryRegistry) {
-	ws.MessageHandler = NewGrpcHandler(ws.validate, disCov)
+	ws.MessageHandler = NewGrpcHandler(ws.validate, disCov, ws.rateLimiter)
 	u := rpcclient.NewUserRpcClient(disCov)
 	ws.userClient = &u
+	p := rpcclient.NewPushRpcClient(disCov)
+	ws.pushClient = &p
 	ws.disCov = disCov
 }
 
@@ -126,7 +144,7 @@ func (ws *WsServer) SetCacheHandler(cache cache.MsgModel) {
 }
 
 func (ws *WsServer) UnRegister(c *Client) {
-	ws.unregisterChan <- c
+	ws.dispatch(c.UserID, connEvent{unregister: c})
 }
 
 func (ws *WsServer) Validate(s any) error {
@@ -147,56 +165,72 @@ func NewWsServer(opts ...Option) (*WsServer, error) {
 	for _, o := range opts {
 		o(&configWs)
 	}
+	if configWs.sendQueueSize <= 0 {
+		configWs.sendQueueSize = defaultSendQueueSize
+	}
+	if configWs.sendQueueHighWater <= 0 || configWs.sendQueueHighWater > configWs.sendQueueSize {
+		configWs.sendQueueHighWater = configWs.sendQueueSize
+	}
+	if configWs.userMapShards <= 0 {
+		configWs.userMapShards = defaultUserMapShards
+	}
+	switch configWs.slowConsumerPolicy {
+	case SlowConsumerDrop, SlowConsumerClose:
+	case "":
+		configWs.slowConsumerPolicy = SlowConsumerDrop
+	default:
+		return nil, errs.ErrArgs.Wrap("unknown slow consumer policy " + configWs.slowConsumerPolicy)
+	}
 	v := validator.New()
-	return &WsServer{
-		port:             configWs.port,
-		wsMaxConnNum:     configWs.maxConnNum,
-		writeBufferSize:  configWs.writeBufferSize,
-		handshakeTimeout: configWs.handshakeTimeout,
+	ws := &WsServer{
+		port:              configWs.port,
+		wsMaxConnNum:      configWs.maxConnNum,
+		writeBufferSize:   configWs.writeBufferSize,
+		handshakeTimeout:  configWs.handshakeTimeout,
+		sendQueueSize:     configWs.sendQueueSize,
+		sendQueueHigh:     configWs.sendQueueHighWater,
+		slowConsumer:      configWs.slowConsumerPolicy,
+		rateLimiter:       newRateLimiter(configWs.rateLimit),
+		resumeWindow:      configWs.resumeWindow,
+		resumeMaxBuffered: configWs.resumeMaxBufferedMsgs,
+		resumeSessions:    newResumeSessions(),
+		sdkVersion:        configWs.sdkVersion,
+		tlsConfig:         configWs.tlsConfig,
 		clientPool: sync.Pool{
 			New: func() any {
 				return new(Client)
 			},
 		},
-		registerChan:    make(chan *Client, 1000),
-		unregisterChan:  make(chan *Client, 1000),
-		kickHandlerChan: make(chan *kickHandler, 1000),
-		validate:        v,
-		clients:         newUserMap(),
-		Compressor:      NewGzipCompressor(),
-		Encoder:         NewGobEncoder(),
-	}, nil
+		shards:     newConnShards(configWs.userMapShards),
+		shardsDone: make(chan struct{}),
+		validate:   v,
+		clients:    newUserMap(configWs.userMapShards),
+		Compressor: NewGzipCompressor(),
+		Encoder:    NewGobEncoder(),
+	}
+	ws.pushAcks = newPushAckTracker(configWs.pushAckTimeout, configWs.deliveryStateExpire, ws.setDeliveryStates, ws.offlinePushFallback)
+	return ws, nil
 }
 
 func (ws *WsServer) Run() error {
 	var (
-		client *Client
-		wg     errgroup.Group
+		wg errgroup.Group
 
 		sigs = make(chan os.Signal, 1)
 		done = make(chan struct{}, 1)
 	)
 
-	server := http.Server{Addr: ":" + utils.IntToString(ws.port), Handler: nil}
+	server := http.Server{Addr: ":" + utils.IntToString(ws.port), Handler: nil, TLSConfig: ws.tlsConfig}
 
-	wg.Go(func() error {
-		for {
-			select {
-			case <-done:
-				return nil
-
-			case client = <-ws.registerChan:
-				ws.registerClient(client)
-			case client = <-ws.unregisterChan:
-				ws.unregisterClient(client)
-			case onlineInfo := <-ws.kickHandlerChan:
-				ws.multiTerminalLoginChecker(onlineInfo.clientOK, onlineInfo.oldClients, onlineInfo.newClient)
-			}
-		}
-	})
+	ws.runShards()
+	go ws.pushAcks.run(ws.shardsDone)
 
 	wg.Go(func() error {
 		http.HandleFunc("/", ws.wsHandler)
+		if ws.tlsConfig != nil {
+			// the certificate is served by tlsConfig.GetCertificate
+			return server.ListenAndServeTLS("", "")
+		}
 		return server.ListenAndServe()
 	})
 
@@ -210,6 +244,7 @@ func (ws *WsServer) Run() error {
 		// graceful exit operation for server
 		_ = server.Shutdown(ctx)
 		_ = wg.Wait()
+		ws.stopShards()
 		close(done)
 	}()
 
@@ -259,7 +294,7 @@ func (ws *WsServer) sendUserOnlineInfoToOtherNode(ctx context.Context, client *C
 }
 
 func (ws *WsServer) SetKickHandlerInfo(i *kickHandler) {
-	ws.kickHandlerChan <- i
+	ws.dispatch(i.newClient.UserID, connEvent{kick: i})
 }
 
 func (ws *WsServer) registerClient(client *Client) {
@@ -269,6 +304,11 @@ func (ws *WsServer) registerClient(client *Client) {
 		oldClients []*Client
 	)
 	oldClients, userOK, clientOK = ws.clients.Get(client.UserID, client.PlatformID)
+	if config.Config.MultiLoginPolicy == constant.DeviceCountLimit {
+		ws.sideEffect(client.UserID, func() {
+			ws.deviceCountLimitChecker(client)
+		})
+	}
 	if !userOK {
 		ws.clients.Set(client.UserID, client)
 		log.ZDebug(client.ctx, "user not exist", "userID", client.UserID, "platformID", client.PlatformID)
@@ -289,21 +329,27 @@ func (ws *WsServer) registerClient(client *Client) {
 		}
 	}
 
-	wg := sync.WaitGroup{}
-	if config.Config.Envs.Discovery == "zookeeper" {
+	// the client is not put back to the pool before its unregister side effects, which run after these.
+	ws.sideEffect(client.UserID, func() {
+		wg := sync.WaitGroup{}
+		// every registry lists all gateway nodes, so the multi login policy is enforced cluster-wide
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			_ = ws.sendUserOnlineInfoToOtherNode(client.ctx, client)
 		}()
-	}
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		ws.SetUserOnlineStatus(client.ctx, client, constant.Online)
-	}()
-
-	wg.Wait()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ws.SetUserOnlineStatus(client.ctx, client, constant.Online)
+		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ws.resumeSession(client)
+		}()
+		wg.Wait()
+	})
 
 	log.ZInfo(
 		client.ctx,
@@ -327,6 +373,18 @@ func getRemoteAdders(client []*Client) string {
 	return ret
 }
 
+// RangeCons calls f for every connection on this node until f returns false.
+func (ws *WsServer) RangeCons(f func(client *Client) bool) {
+	ws.clients.Range(func(userID string, clients []*Client) bool {
+		for _, client := range clients {
+			if !f(client) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
 func (ws *WsServer) KickUserConn(client *Client) error {
 	ws.clients.deleteClients(client.UserID, []*Client{client})
 	return client.KickOnlineMessage()
@@ -403,21 +461,31 @@ func (ws *WsServer) multiTerminalLoginChecker(clientOK bool, oldClients []*Clien
 			log.ZWarn(newClient.ctx, "SetTokenMapByUidPid err", err, "userID", newClient.UserID, "platformID", newClient.PlatformID)
 			return
 		}
+	case constant.DeviceCountLimit:
+		// the evicted tokens were marked kicked by the node the new client logged in to
+		ws.sideEffect(newClient.UserID, func() {
+			ws.kickEvictedConns(newClient)
+		})
 	}
 }
 
 func (ws *WsServer) unregisterClient(client *Client) {
-	defer ws.clientPool.Put(client)
+	client.limiter.release()
 	isDeleteUser := ws.clients.delete(client.UserID, client.ctx.GetRemoteAddr())
 	if isDeleteUser {
 		ws.onlineUserNum.Add(-1)
 		prommetrics.OnlineUserGauge.Dec()
 	}
 	ws.onlineUserConnNum.Add(-1)
-	ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
-	log.ZInfo(client.ctx, "user offline", "close reason", client.closedErr, "online user Num", ws.onlineUserNum.Load(), "online user conn Num",
+	prommetrics.SdkVersionConnGauge.WithLabelValues(constant.PlatformIDToName(client.PlatformID), ws.sdkVersionLabel(client.sdkVersion)).Dec()
+	log.ZInfo(client.ctx, "user offline", "close reason", client.getClosedErr(), "online user Num", ws.onlineUserNum.Load(), "online user conn Num",
 		ws.onlineUserConnNum.Load(),
 	)
+	ws.sideEffect(client.UserID, func() {
+		defer ws.clientPool.Put(client)
+		ws.suspendSession(client)
+		ws.SetUserOnlineStatus(client.ctx, client, constant.Offline)
+	})
 }
 
 func (ws *WsServer) ParseWSArgs(r *http.Request) (args *WSArgs, err error) {
@@ -454,6 +522,20 @@ func (ws *WsServer) ParseWSArgs(r *http.Request) (args *WSArgs, err error) {
 	if r.Header.Get(Compression) == GzipCompressionProtocol {
 		v.Compression = true
 	}
+	if v.Encoding = query.Get(Encoding); v.Encoding == "" {
+		v.Encoding = r.Header.Get(Encoding)
+	}
+	if v.Encoder, err = NewEncoder(v.Encoding); err != nil {
+		return nil, errs.ErrConnArgsErr.Wrap("encoding is not supported")
+	}
+	v.ResumeToken = query.Get(ResumeToken)
+	v.PushAck, _ = strconv.ParseBool(query.Get(PushAck))
+	if v.SdkVersion = query.Get(SdkVersion); v.SdkVersion == "" {
+		v.SdkVersion = r.Header.Get(SdkVersion)
+	}
+	if v.sdkVersionTips, err = ws.checkSdkVersion(platformID, v.SdkVersion); err != nil {
+		return nil, err
+	}
 	m, err := ws.cache.GetTokensWithoutError(context.Background(), v.UserID, platformID)
 	if err != nil {
 		return nil, err
@@ -478,11 +560,22 @@ type WSArgs struct {
 	PlatformID  int
 	Compression bool
 	MsgResp     bool
+	Encoding    string
+	Encoder     Encoder
+	ResumeToken string
+	PushAck     bool
+	SdkVersion  string
+	// set when the sdk version is below the recommended or minimum version of the platform
+	sdkVersionTips *sdkws.SdkVersionTips
 }
 
 func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 	connContext := newContext(w, r)
 	args, pErr := ws.ParseWSArgs(r)
+	if pErr != nil && args.sdkVersionTips != nil {
+		ws.rejectSdkVersion(connContext, w, r, args, pErr)
+		return
+	}
 	var wsLongConn *GWebSocket
 	if args.MsgResp {
 		wsLongConn = newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
@@ -515,7 +608,27 @@ func (ws *WsServer) wsHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 	client := ws.clientPool.Get().(*Client)
-	client.ResetClient(connContext, wsLongConn, connContext.GetBackground(), args.Compression, ws, args.Token)
-	ws.registerChan <- client
+	client.ResetClient(connContext, wsLongConn, connContext.GetBackground(), args.Compression, ws, args.Token, args.Encoder)
+	client.queue = newSendQueue(ws.sendQueueSize, ws.sendQueueHigh, ws.slowConsumer)
+	client.limiter = ws.rateLimiter.acquire(client.UserID)
+	client.encoding = args.Encoding
+	if client.encoding == "" {
+		client.encoding = GobEncoding
+	}
+	client.connectTime = time.Now().UnixMilli()
+	client.sdkVersion = args.SdkVersion
+	prommetrics.SdkVersionConnGauge.WithLabelValues(constant.PlatformIDToName(client.PlatformID), ws.sdkVersionLabel(client.sdkVersion)).Inc()
+	if args.sdkVersionTips != nil {
+		client.warnSdkVersion(args.sdkVersionTips)
+	}
+	if args.PushAck {
+		client.acks = ws.pushAcks
+	}
+	if ws.resumeWindow > 0 {
+		client.resumeToken = uuid.NewString()
+		client.resumeFrom = args.ResumeToken
+	}
+	ws.dispatch(client.UserID, connEvent{register: client})
+	go client.writeMessage()
 	go client.readMessage()
 }
@@ -14,7 +14,12 @@
 
 package msggateway
 
-import "time"
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
 
 type (
 	Option  func(opt *configs)
@@ -29,6 +34,28 @@ type (
 		messageMaxMsgLength int
 		// websocket write buffer, default: 4096, 4kb.
 		writeBufferSize int
+		// per connection outbound queue length, default: 256.
+		sendQueueSize int
+		// queue depth at which the slow consumer policy applies to pushes, default: sendQueueSize.
+		sendQueueHighWater int
+		// slow consumer policy, drop or close, default: drop.
+		slowConsumerPolicy string
+		// inbound request token buckets.
+		rateLimit config.WsRateLimit
+		// how long a disconnected session may be resumed, zero disables session resume.
+		resumeWindow time.Duration
+		// pushes buffered per suspended session before the client has to resync.
+		resumeMaxBufferedMsgs int64
+		// number of user map shards, each with its own register worker.
+		userMapShards int
+		// how long a pushed message waits for the client ack before it is pushed offline, zero disables acks.
+		pushAckTimeout time.Duration
+		// how long the delivery states of pushed messages are kept.
+		deliveryStateExpire time.Duration
+		// per platform minimum and recommended sdk versions checked at handshake.
+		sdkVersion config.WsSdkVersion
+		// serve wss when set.
+		tlsConfig *tls.Config
 	}
 )
 
@@ -61,3 +88,59 @@ func WithWriteBufferSize(size int) Option {
 		opt.writeBufferSize = size
 	}
 }
+
+func WithSendQueueSize(size int) Option {
+	return func(opt *configs) {
+		opt.sendQueueSize = size
+	}
+}
+
+func WithSendQueueHighWater(highWater int) Option {
+	return func(opt *configs) {
+		opt.sendQueueHighWater = highWater
+	}
+}
+
+func WithSlowConsumerPolicy(policy string) Option {
+	return func(opt *configs) {
+		opt.slowConsumerPolicy = policy
+	}
+}
+
+func WithRateLimit(rateLimit config.WsRateLimit) Option {
+	return func(opt *configs) {
+		opt.rateLimit = rateLimit
+	}
+}
+
+func WithSessionResume(window time.Duration, maxBufferedMsgs int64) Option {
+	return func(opt *configs) {
+		opt.resumeWindow = window
+		opt.resumeMaxBufferedMsgs = maxBufferedMsgs
+	}
+}
+
+func WithPushAck(timeout, deliveryStateExpire time.Duration) Option {
+	return func(opt *configs) {
+		opt.pushAckTimeout = timeout
+		opt.deliveryStateExpire = deliveryStateExpire
+	}
+}
+
+func WithSdkVersion(sdkVersion config.WsSdkVersion) Option {
+	return func(opt *configs) {
+		opt.sdkVersion = sdkVersion
+	}
+}
+
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(opt *configs) {
+		opt.tlsConfig = tlsConfig
+	}
+}
+
+func WithUserMapShards(shards int) Option {
+	return func(opt *configs) {
+		opt.userMapShards = shards
+	}
+}
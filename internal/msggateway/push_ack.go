@@ -0,0 +1,281 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+const (
+	pushAckCheckInterval = time.Second
+	pushAckFlushSize     = 128
+	pushAckUpdateBuffer  = 4096
+	// acks arriving within pushAckLateWindow after the offline push still count
+	pushAckLateWindow = 5 * time.Minute
+	// pushAckMaxSeqs bounds the seqs of one PushAckReq
+	pushAckMaxSeqs = 1000
+)
+
+type pushAckKey struct {
+	userID         string
+	conversationID string
+	seq            int64
+}
+
+type pendingPush struct {
+	msg      *sdkws.MsgData
+	deadline time.Time
+}
+
+// pushAckTracker waits for the clients that negotiated acks to confirm the messages pushed to them.
+// Messages not acked within the timeout are pushed offline, every transition is recorded as the
+// delivery state of the recipient.
+type pushAckTracker struct {
+	timeout     time.Duration
+	stateExpire time.Duration
+	lock        sync.Mutex
+	pending     map[pushAckKey]*pendingPush
+	late        map[pushAckKey]time.Time // pushed offline, still accepting the ack until the time
+	updates     chan *cache.MsgDeliveryState
+	setStates   func(ctx context.Context, states []*cache.MsgDeliveryState, expire time.Duration) error
+	fallback    func(ctx context.Context, msg *sdkws.MsgData, userIDs []string) error
+}
+
+func newPushAckTracker(
+	timeout, stateExpire time.Duration,
+	setStates func(ctx context.Context, states []*cache.MsgDeliveryState, expire time.Duration) error,
+	fallback func(ctx context.Context, msg *sdkws.MsgData, userIDs []string) error,
+) *pushAckTracker {
+	if timeout <= 0 {
+		return nil
+	}
+	return &pushAckTracker{
+		timeout:     timeout,
+		stateExpire: stateExpire,
+		pending:     make(map[pushAckKey]*pendingPush),
+		late:        make(map[pushAckKey]time.Time),
+		updates:     make(chan *cache.MsgDeliveryState, pushAckUpdateBuffer),
+		setStates:   setStates,
+		fallback:    fallback,
+	}
+}
+
+func (t *pushAckTracker) update(key pushAckKey, state int32) {
+	select {
+	case t.updates <- &cache.MsgDeliveryState{
+		ConversationID: key.conversationID,
+		Seq:            key.seq,
+		UserID:         key.userID,
+		State:          state,
+		UpdateTime:     utils.GetCurrentTimestampByMill(),
+	}:
+	default:
+		log.ZWarn(context.Background(), "delivery state dropped, update buffer full", nil, "key", key)
+	}
+}
+
+// track starts waiting for the ack of the persisted msgs pushed to userID and returns the keys it added.
+func (t *pushAckTracker) track(userID string, msgs []*sdkws.MsgData) []pushAckKey {
+	if t == nil {
+		return nil
+	}
+	deadline := time.Now().Add(t.timeout)
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	var keys []pushAckKey
+	for _, msg := range msgs {
+		if msg.Seq <= 0 {
+			continue
+		}
+		key := pushAckKey{userID: userID, conversationID: msgprocessor.GetConversationIDByMsg(msg), seq: msg.Seq}
+		if _, ok := t.pending[key]; ok {
+			// another connection of the user is already waiting for it
+			continue
+		}
+		t.pending[key] = &pendingPush{msg: msg, deadline: deadline}
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		t.update(key, cache.MsgDeliveryPushed)
+	}
+	return keys
+}
+
+// untrack stops waiting for the keys of a push that was refused, its caller falls back itself.
+func (t *pushAckTracker) untrack(keys []pushAckKey) {
+	if t == nil || len(keys) == 0 {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, key := range keys {
+		delete(t.pending, key)
+	}
+}
+
+// dropped expires the msgs pushed to userID whose frame was discarded, so they are pushed offline
+// at the next check instead of after the ack timeout.
+func (t *pushAckTracker) dropped(userID string, msgs []*sdkws.MsgData) {
+	if t == nil {
+		return
+	}
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, msg := range msgs {
+		key := pushAckKey{userID: userID, conversationID: msgprocessor.GetConversationIDByMsg(msg), seq: msg.Seq}
+		if p, ok := t.pending[key]; ok {
+			p.deadline = time.Time{}
+		}
+	}
+}
+
+// ack marks the seqs as delivered to userID, acks that arrive within pushAckLateWindow after the
+// fallback still count. Acks of msgs that were not pushed to userID are ignored.
+func (t *pushAckTracker) ack(userID string, acks []*sdkws.PushAck) {
+	if t == nil {
+		return
+	}
+	now := time.Now()
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for _, a := range acks {
+		for _, seq := range a.Seqs {
+			key := pushAckKey{userID: userID, conversationID: a.ConversationID, seq: seq}
+			if _, ok := t.pending[key]; ok {
+				delete(t.pending, key)
+			} else if until, ok := t.late[key]; ok && now.Before(until) {
+				delete(t.late, key)
+			} else {
+				continue
+			}
+			t.update(key, cache.MsgDeliveryAcked)
+		}
+	}
+}
+
+func (t *pushAckTracker) expire(now time.Time) map[*sdkws.MsgData][]string {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for key, until := range t.late {
+		if !now.Before(until) {
+			delete(t.late, key)
+		}
+	}
+	expired := make(map[*sdkws.MsgData][]string)
+	for key, p := range t.pending {
+		if now.Before(p.deadline) {
+			continue
+		}
+		delete(t.pending, key)
+		t.late[key] = now.Add(pushAckLateWindow)
+		expired[p.msg] = append(expired[p.msg], key.userID)
+		t.update(key, cache.MsgDeliveryOfflinePushed)
+	}
+	return expired
+}
+
+func (t *pushAckTracker) run(done <-chan struct{}) {
+	if t == nil {
+		return
+	}
+	ticker := time.NewTicker(pushAckCheckInterval)
+	defer ticker.Stop()
+	var states []*cache.MsgDeliveryState
+	flush := func() {
+		if len(states) == 0 {
+			return
+		}
+		ctx := mcontext.SetOperationID(context.Background(), "pushAck"+utils.OperationIDGenerator())
+		if err := t.setStates(ctx, states, t.stateExpire); err != nil {
+			log.ZWarn(ctx, "SetMsgDeliveryStates err", err, "count", len(states))
+		}
+		states = states[:0]
+	}
+	for {
+		select {
+		case <-done:
+			flush()
+			return
+		case state := <-t.updates:
+			if states = append(states, state); len(states) >= pushAckFlushSize {
+				flush()
+			}
+		case now := <-ticker.C:
+			for msg, userIDs := range t.expire(now) {
+				prommetrics.PushAckTimeoutCounter.Add(float64(len(userIDs)))
+				go offlinePushFallback(t.fallback, msg, userIDs)
+			}
+			flush()
+		}
+	}
+}
+
+// pushAck handles the acks a client sends for the messages pushed to it.
+func (c *Client) pushAck(ctx context.Context, req *Req) ([]byte, error) {
+	var ackReq sdkws.PushAckReq
+	if err := proto.Unmarshal(req.Data, &ackReq); err != nil {
+		return nil, err
+	}
+	var seqNum int
+	for _, a := range ackReq.Acks {
+		seqNum += len(a.Seqs)
+	}
+	if seqNum > pushAckMaxSeqs {
+		return nil, errs.ErrArgs.Wrap("too many acks")
+	}
+	c.acks.ack(c.UserID, ackReq.Acks)
+	return nil, nil
+}
+
+func (ws *WsServer) setDeliveryStates(ctx context.Context, states []*cache.MsgDeliveryState, expire time.Duration) error {
+	return ws.cache.SetMsgDeliveryStates(ctx, states, expire)
+}
+
+func (ws *WsServer) offlinePushFallback(ctx context.Context, msg *sdkws.MsgData, userIDs []string) error {
+	return ws.pushClient.OfflinePushFallback(ctx, msg, userIDs)
+}
+
+// OfflinePushDropped pushes offline the msgs discarded from the send queue of a client without acks.
+func (ws *WsServer) OfflinePushDropped(userID string, msgs []*sdkws.MsgData) {
+	prommetrics.PushDroppedOfflineCounter.Add(float64(len(msgs)))
+	for _, msg := range msgs {
+		go offlinePushFallback(ws.offlinePushFallback, msg, []string{userID})
+	}
+}
+
+func offlinePushFallback(
+	fallback func(ctx context.Context, msg *sdkws.MsgData, userIDs []string) error,
+	msg *sdkws.MsgData,
+	userIDs []string,
+) {
+	ctx := mcontext.SetOperationID(context.Background(), "pushAck"+utils.OperationIDGenerator())
+	if err := fallback(ctx, msg, userIDs); err != nil {
+		log.ZWarn(ctx, "OfflinePushFallback err", err, "userIDs", userIDs, "seq", msg.Seq)
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+func TestPushAckTracker(t *testing.T) {
+	tracker := newPushAckTracker(time.Second, time.Hour, nil, nil)
+	msgs := []*sdkws.MsgData{
+		{SendID: "a", RecvID: "b", SessionType: constant.SingleChatType, Seq: 1},
+		{SendID: "a", RecvID: "b", SessionType: constant.SingleChatType, Seq: 2},
+		// unpersisted messages are not tracked
+		{SendID: "a", RecvID: "b", SessionType: constant.SingleChatType},
+	}
+	conversationID := msgprocessor.GetConversationIDByMsg(msgs[0])
+	tracker.track("b", msgs)
+	tracker.track("b", msgs)
+	assert.Len(t, tracker.pending, 2)
+
+	tracker.ack("b", []*sdkws.PushAck{{ConversationID: conversationID, Seqs: []int64{1}}})
+	assert.Len(t, tracker.pending, 1)
+
+	assert.Empty(t, tracker.expire(time.Now()))
+	expired := tracker.expire(time.Now().Add(2 * time.Second))
+	assert.Equal(t, map[*sdkws.MsgData][]string{msgs[1]: {"b"}}, expired)
+	assert.Empty(t, tracker.pending)
+
+	var states []int32
+	for len(tracker.updates) > 0 {
+		states = append(states, (<-tracker.updates).State)
+	}
+	assert.Equal(t, []int32{cache.MsgDeliveryPushed, cache.MsgDeliveryPushed, cache.MsgDeliveryAcked, cache.MsgDeliveryOfflinePushed}, states)
+}
+
+func TestPushAckTrackerIgnoresUnpushed(t *testing.T) {
+	tracker := newPushAckTracker(time.Second, time.Hour, nil, nil)
+	msg := &sdkws.MsgData{SendID: "a", RecvID: "b", SessionType: constant.SingleChatType, Seq: 1}
+	conversationID := msgprocessor.GetConversationIDByMsg(msg)
+	tracker.track("b", []*sdkws.MsgData{msg})
+	<-tracker.updates
+
+	// acks of other users, conversations or seqs are not recorded
+	tracker.ack("c", []*sdkws.PushAck{{ConversationID: conversationID, Seqs: []int64{1}}})
+	tracker.ack("b", []*sdkws.PushAck{{ConversationID: "si_x_y", Seqs: []int64{1}}, {ConversationID: conversationID, Seqs: []int64{2}}})
+	assert.Empty(t, tracker.updates)
+	assert.Len(t, tracker.pending, 1)
+
+	// a late ack after the offline push counts once, within the window
+	tracker.expire(time.Now().Add(2 * time.Second))
+	<-tracker.updates
+	tracker.ack("b", []*sdkws.PushAck{{ConversationID: conversationID, Seqs: []int64{1, 1}}})
+	assert.Len(t, tracker.updates, 1)
+	assert.Equal(t, int32(cache.MsgDeliveryAcked), (<-tracker.updates).State)
+
+	tracker.track("b", []*sdkws.MsgData{msg})
+	<-tracker.updates
+	tracker.expire(time.Now().Add(2 * time.Second))
+	<-tracker.updates
+	tracker.expire(time.Now().Add(2 * pushAckLateWindow))
+	assert.Empty(t, tracker.late)
+	tracker.ack("b", []*sdkws.PushAck{{ConversationID: conversationID, Seqs: []int64{1}}})
+	assert.Empty(t, tracker.updates)
+}
+
+func TestPushAckTrackerDisabled(t *testing.T) {
+	tracker := newPushAckTracker(0, time.Hour, nil, nil)
+	assert.Nil(t, tracker)
+	tracker.track("b", []*sdkws.MsgData{{Seq: 1}})
+	tracker.ack("b", nil)
+}
@@ -0,0 +1,181 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+const (
+	// Rate limit scopes, also used as the prometheus label.
+	rateLimitScopeConn          = "conn"
+	rateLimitScopeUser          = "user"
+	rateLimitScopeReqIdentifier = "reqIdentifier"
+
+	signalSweepInterval = time.Minute
+)
+
+// rateLimiter holds the inbound token buckets shared by the connections of one node.
+type rateLimiter struct {
+	conf  config.WsRateLimit
+	lock  sync.Mutex
+	users map[string]*userLimiter
+
+	// ephemeral signal buckets per conversation, full buckets are swept periodically.
+	signalLock      sync.Mutex
+	conversations   map[string]*rate.Limiter
+	lastSignalSweep time.Time
+}
+
+type userLimiter struct {
+	limiter *rate.Limiter
+	refs    int
+}
+
+// connLimiter is owned by the read goroutine of a single client and needs no locking.
+type connLimiter struct {
+	userID  string
+	conn    *rate.Limiter
+	user    *rate.Limiter
+	reqs    map[int32]*rate.Limiter
+	limiter *rateLimiter
+}
+
+func newRateLimiter(conf config.WsRateLimit) *rateLimiter {
+	return &rateLimiter{
+		conf:          conf,
+		users:         make(map[string]*userLimiter),
+		conversations: make(map[string]*rate.Limiter),
+	}
+}
+
+func newLimiter(conf config.RateLimitConf) *rate.Limiter {
+	if conf.Rate <= 0 {
+		return nil
+	}
+	burst := conf.Burst
+	if burst <= 0 {
+		burst = int(conf.Rate) + 1
+	}
+	return rate.NewLimiter(rate.Limit(conf.Rate), burst)
+}
+
+// acquire returns the limiter of a new connection, the user bucket is shared until release.
+func (r *rateLimiter) acquire(userID string) *connLimiter {
+	if r == nil || !r.conf.Enable {
+		return nil
+	}
+	c := &connLimiter{
+		userID:  userID,
+		conn:    newLimiter(r.conf.Conn),
+		reqs:    make(map[int32]*rate.Limiter),
+		limiter: r,
+	}
+	if l := newLimiter(r.conf.User); l != nil {
+		r.lock.Lock()
+		u, ok := r.users[userID]
+		if !ok {
+			u = &userLimiter{limiter: l}
+			r.users[userID] = u
+		}
+		u.refs++
+		c.user = u.limiter
+		r.lock.Unlock()
+	}
+	return c
+}
+
+func (c *connLimiter) release() {
+	if c == nil || c.user == nil {
+		return
+	}
+	r := c.limiter
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if u, ok := r.users[c.userID]; ok {
+		if u.refs--; u.refs <= 0 {
+			delete(r.users, c.userID)
+		}
+	}
+}
+
+// allow takes one token from every bucket the request is subject to. When any bucket is empty
+// no token is consumed and the rejecting scope is returned with the time until it refills.
+func (c *connLimiter) allow(reqIdentifier int32) (scope string, retryAfter time.Duration, ok bool) {
+	if c == nil {
+		return "", 0, true
+	}
+	req, exist := c.reqs[reqIdentifier]
+	if !exist {
+		req = newLimiter(c.limiter.conf.ReqIdentifier[reqIdentifier])
+		c.reqs[reqIdentifier] = req
+	}
+	type bucket struct {
+		scope   string
+		limiter *rate.Limiter
+	}
+	buckets := []bucket{{rateLimitScopeReqIdentifier, req}, {rateLimitScopeConn, c.conn}, {rateLimitScopeUser, c.user}}
+	now := time.Now()
+	reserved := make([]*rate.Reservation, 0, len(buckets))
+	for _, b := range buckets {
+		if b.limiter == nil {
+			continue
+		}
+		r := b.limiter.ReserveN(now, 1)
+		if delay := r.DelayFrom(now); !r.OK() || delay > 0 {
+			r.CancelAt(now)
+			for _, v := range reserved {
+				v.CancelAt(now)
+			}
+			return b.scope, delay, false
+		}
+		reserved = append(reserved, r)
+	}
+	return "", 0, true
+}
+
+// allowSignal takes one token from the ephemeral signal bucket of conversationID.
+func (r *rateLimiter) allowSignal(conversationID string) (retryAfter time.Duration, ok bool) {
+	if r == nil || !r.conf.Enable || r.conf.Conversation.Rate <= 0 {
+		return 0, true
+	}
+	r.signalLock.Lock()
+	defer r.signalLock.Unlock()
+	now := time.Now()
+	if now.Sub(r.lastSignalSweep) > signalSweepInterval {
+		for id, l := range r.conversations {
+			if l.TokensAt(now) >= float64(l.Burst()) {
+				delete(r.conversations, id)
+			}
+		}
+		r.lastSignalSweep = now
+	}
+	l, exist := r.conversations[conversationID]
+	if !exist {
+		l = newLimiter(r.conf.Conversation)
+		r.conversations[conversationID] = l
+	}
+	res := l.ReserveN(now, 1)
+	if delay := res.DelayFrom(now); !res.OK() || delay > 0 {
+		res.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestRateLimiterAllow(t *testing.T) {
+	limiter := newRateLimiter(config.WsRateLimit{
+		Enable: true,
+		Conn:   config.RateLimitConf{Rate: 1, Burst: 3},
+		User:   config.RateLimitConf{Rate: 1, Burst: 4},
+		ReqIdentifier: map[int32]config.RateLimitConf{
+			WSSendMsg: {Rate: 1, Burst: 1},
+		},
+	})
+	c1 := limiter.acquire("user")
+	c2 := limiter.acquire("user")
+
+	_, _, ok := c1.allow(WSSendMsg)
+	assert.True(t, ok)
+	scope, retryAfter, ok := c1.allow(WSSendMsg)
+	assert.False(t, ok)
+	assert.Equal(t, rateLimitScopeReqIdentifier, scope)
+	assert.True(t, retryAfter > 0)
+
+	_, _, ok = c1.allow(WSGetNewestSeq)
+	assert.True(t, ok)
+	_, _, ok = c1.allow(WSGetNewestSeq)
+	assert.True(t, ok)
+	scope, _, ok = c1.allow(WSGetNewestSeq)
+	assert.False(t, ok)
+	assert.Equal(t, rateLimitScopeConn, scope)
+
+	// the user bucket is shared between the connections of the same user
+	_, _, ok = c2.allow(WSGetNewestSeq)
+	assert.True(t, ok)
+	scope, _, ok = c2.allow(WSGetNewestSeq)
+	assert.False(t, ok)
+	assert.Equal(t, rateLimitScopeUser, scope)
+
+	c1.release()
+	c2.release()
+	assert.Len(t, limiter.users, 0)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	c := newRateLimiter(config.WsRateLimit{}).acquire("user")
+	assert.Nil(t, c)
+	_, _, ok := c.allow(WSSendMsg)
+	assert.True(t, ok)
+}
+
+func TestRateLimiterAllowSignal(t *testing.T) {
+	limiter := newRateLimiter(config.WsRateLimit{
+		Enable:       true,
+		Conversation: config.RateLimitConf{Rate: 1, Burst: 2},
+	})
+	_, ok := limiter.allowSignal("si_a_b")
+	assert.True(t, ok)
+	_, ok = limiter.allowSignal("si_a_b")
+	assert.True(t, ok)
+	retryAfter, ok := limiter.allowSignal("si_a_b")
+	assert.False(t, ok)
+	assert.True(t, retryAfter > 0)
+
+	// other conversations have their own bucket
+	_, ok = limiter.allowSignal("sg_group")
+	assert.True(t, ok)
+}
@@ -0,0 +1,186 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/apiresp"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+)
+
+const (
+	// unknownSdkVersion labels the connections of clients not sending their version.
+	unknownSdkVersion = "unknown"
+	// otherSdkVersion labels the connections of the versions not listed in metricVersions.
+	otherSdkVersion = "other"
+)
+
+var sdkVersionRegexp = regexp.MustCompile(`^v?\d+(\.\d+){0,3}([-+][0-9A-Za-z.-]+)?$`)
+
+// sdkVersionParts returns the dot separated numeric parts of a version, without the leading v
+// and any pre-release or build suffix.
+func sdkVersionParts(v string) []string {
+	v = strings.TrimPrefix(v, "v")
+	if i := strings.IndexAny(v, "-+"); i >= 0 {
+		v = v[:i]
+	}
+	return strings.Split(v, ".")
+}
+
+// compareSdkVersion compares the numeric parts of two versions, missing parts count as zero.
+func compareSdkVersion(a, b string) int {
+	pa, pb := sdkVersionParts(a), sdkVersionParts(b)
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var na, nb int
+		if i < len(pa) {
+			na, _ = strconv.Atoi(pa[i])
+		}
+		if i < len(pb) {
+			nb, _ = strconv.Atoi(pb[i])
+		}
+		if na != nb {
+			if na < nb {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// checkSdkVersion checks version against the limits of the platform. Tips are returned when the
+// version is below the recommended one, together with ErrConnSdkVersionTooLow if it is below the minimum.
+func (ws *WsServer) checkSdkVersion(platformID int, version string) (*sdkws.SdkVersionTips, error) {
+	if version == "" {
+		if ws.sdkVersion.Required {
+			return nil, errs.ErrConnArgsErr.Wrap("sdkVersion is empty")
+		}
+		return nil, nil
+	}
+	if len(version) > 64 || !sdkVersionRegexp.MatchString(version) {
+		return nil, errs.ErrConnArgsErr.Wrap("sdkVersion is invalid")
+	}
+	platform := constant.PlatformIDToName(platformID)
+	limit, ok := ws.sdkVersion.Platforms[platform]
+	if !ok {
+		return nil, nil
+	}
+	tips := &sdkws.SdkVersionTips{
+		PlatformID:         int32(platformID),
+		SdkVersion:         version,
+		MinVersion:         limit.Min,
+		RecommendedVersion: limit.Recommended,
+	}
+	if limit.Min != "" && compareSdkVersion(version, limit.Min) < 0 {
+		tips.UpgradeRequired = true
+		prommetrics.SdkVersionRejectedCounter.WithLabelValues(platform).Inc()
+		return tips, errs.ErrConnSdkVersionTooLow.Wrap(fmt.Sprintf("sdkVersion %s is lower than %s", version, limit.Min))
+	}
+	if limit.Recommended != "" && compareSdkVersion(version, limit.Recommended) < 0 {
+		return tips, nil
+	}
+	return nil, nil
+}
+
+func sdkVersionTipsResp(operationID string, tips *sdkws.SdkVersionTips, err error) (Resp, error) {
+	data, mErr := proto.Marshal(tips)
+	if mErr != nil {
+		return Resp{}, utils.Wrap(mErr, "")
+	}
+	resp := Resp{ReqIdentifier: WSDataError, OperationID: operationID, Data: data}
+	if err != nil {
+		errResp := apiresp.ParseError(err)
+		resp.ErrCode, resp.ErrMsg = errResp.ErrCode, errResp.ErrMsg
+	}
+	return resp, nil
+}
+
+// rejectSdkVersion upgrades the connection only to tell the client to upgrade before closing it.
+func (ws *WsServer) rejectSdkVersion(connContext *UserConnContext, w http.ResponseWriter, r *http.Request, args *WSArgs, pErr error) {
+	log.ZInfo(connContext, "sdk version rejected", "userID", args.UserID, "platformID", args.PlatformID, "sdkVersion", args.SdkVersion)
+	wsLongConn := newGWebSocket(WebSocket, ws.handshakeTimeout, ws.writeBufferSize)
+	if err := wsLongConn.GenerateLongConn(w, r); err != nil {
+		httpError(connContext, err)
+		return
+	}
+	defer wsLongConn.Close()
+	if args.MsgResp {
+		data, err := json.Marshal(apiresp.ParseError(pErr))
+		if err != nil {
+			return
+		}
+		if err := wsLongConn.WriteMessage(MessageText, data); err != nil {
+			return
+		}
+	}
+	resp, err := sdkVersionTipsResp(connContext.GetOperationID(), args.sdkVersionTips, pErr)
+	if err != nil {
+		return
+	}
+	frame, err := args.Encoder.Encode(resp)
+	if err != nil {
+		return
+	}
+	if args.Compression {
+		if frame, err = ws.CompressWithPool(frame); err != nil {
+			return
+		}
+	}
+	if err := wsLongConn.SetWriteDeadline(writeWait); err != nil {
+		return
+	}
+	_ = wsLongConn.WriteMessage(MessageBinary, frame)
+}
+
+// warnSdkVersion tells the client a newer sdk version is recommended.
+func (c *Client) warnSdkVersion(tips *sdkws.SdkVersionTips) {
+	resp, err := sdkVersionTipsResp(c.ctx.GetOperationID(), tips, nil)
+	if err != nil {
+		log.ZWarn(c.ctx, "sdkVersionTipsResp", err)
+		return
+	}
+	if err := c.writeBinaryMsg(resp); err != nil {
+		log.ZWarn(c.ctx, "writeBinaryMsg warnSdkVersion", err)
+	}
+}
+
+// sdkVersionLabel bounds the version label of the connection metrics to the configured major.minor versions.
+func (ws *WsServer) sdkVersionLabel(version string) string {
+	if version == "" {
+		return unknownSdkVersion
+	}
+	if !sdkVersionRegexp.MatchString(version) {
+		return otherSdkVersion
+	}
+	parts := append(sdkVersionParts(version), "0")
+	label := parts[0] + "." + parts[1]
+	if !utils.IsContain(label, ws.sdkVersion.MetricVersions) {
+		return otherSdkVersion
+	}
+	return label
+}
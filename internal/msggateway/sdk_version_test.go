@@ -0,0 +1,69 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestCompareSdkVersion(t *testing.T) {
+	assert.Equal(t, 0, compareSdkVersion("v3.5.0", "3.5"))
+	assert.Equal(t, -1, compareSdkVersion("3.4.12", "3.5.0"))
+	assert.Equal(t, 1, compareSdkVersion("3.10.0-beta.1", "3.9.9"))
+}
+
+func TestCheckSdkVersion(t *testing.T) {
+	ws := &WsServer{sdkVersion: config.WsSdkVersion{
+		Platforms: map[string]config.SdkVersionConf{
+			constant.IOSPlatformStr: {Min: "3.4.0", Recommended: "3.5.0"},
+		},
+	}}
+	tips, err := ws.checkSdkVersion(constant.IOSPlatformID, "3.3.9")
+	assert.True(t, errs.ErrConnSdkVersionTooLow.Is(err))
+	assert.True(t, tips.UpgradeRequired)
+
+	tips, err = ws.checkSdkVersion(constant.IOSPlatformID, "3.4.1")
+	assert.NoError(t, err)
+	assert.False(t, tips.UpgradeRequired)
+	assert.Equal(t, "3.5.0", tips.RecommendedVersion)
+
+	tips, err = ws.checkSdkVersion(constant.IOSPlatformID, "3.5.0")
+	assert.NoError(t, err)
+	assert.Nil(t, tips)
+
+	tips, err = ws.checkSdkVersion(constant.AndroidPlatformID, "1.0.0")
+	assert.NoError(t, err)
+	assert.Nil(t, tips)
+
+	_, err = ws.checkSdkVersion(constant.IOSPlatformID, "3.5;drop")
+	assert.True(t, errs.ErrConnArgsErr.Is(err))
+}
+
+func TestSdkVersionLabel(t *testing.T) {
+	ws := &WsServer{sdkVersion: config.WsSdkVersion{MetricVersions: []string{"3.5", "3.4"}}}
+	assert.Equal(t, unknownSdkVersion, ws.sdkVersionLabel(""))
+	assert.Equal(t, "3.5", ws.sdkVersionLabel("v3.5.2-beta.1"))
+	assert.Equal(t, "3.4", ws.sdkVersionLabel("3.4"))
+	assert.Equal(t, otherSdkVersion, ws.sdkVersionLabel("3"))
+	assert.Equal(t, otherSdkVersion, ws.sdkVersionLabel("2.9.9"))
+	assert.Equal(t, otherSdkVersion, ws.sdkVersionLabel("not a version"))
+}
@@ -0,0 +1,150 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+)
+
+const (
+	// SlowConsumerDrop drops pushes while the send queue is above its high-water mark.
+	SlowConsumerDrop = "drop"
+	// SlowConsumerClose closes the connection once the send queue reaches its high-water mark.
+	SlowConsumerClose = "close"
+)
+
+var ErrSendQueueFull = errors.New("send queue is full")
+
+type queuedFrame struct {
+	data []byte
+	// msgs are the pushed messages carried by the frame, pushed offline if the frame is discarded
+	msgs []*sdkws.MsgData
+}
+
+// sendQueue is the bounded outbound buffer of a client, drained by Client.writeMessage.
+type sendQueue struct {
+	mu        sync.Mutex
+	closed    bool
+	frames    chan queuedFrame
+	done      chan struct{}
+	highWater int
+	policy    string
+}
+
+func newSendQueue(size, highWater int, policy string) *sendQueue {
+	return &sendQueue{
+		frames:    make(chan queuedFrame, size),
+		done:      make(chan struct{}),
+		highWater: highWater,
+		policy:    policy,
+	}
+}
+
+// push reports whether the frame was queued, a closed queue is reported as ErrConnClosed.
+// Droppable frames are refused at the high-water mark, the others only when the queue is full.
+func (q *sendQueue) push(frame queuedFrame, droppable bool) (bool, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false, ErrConnClosed
+	}
+	if droppable && len(q.frames) >= q.highWater {
+		return false, nil
+	}
+	select {
+	case q.frames <- frame:
+		prommetrics.SendQueueDepthGauge.Inc()
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+func (q *sendQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return
+	}
+	q.closed = true
+	close(q.done)
+}
+
+// drain discards the frames left behind by a closed client and returns the pushed messages they carried.
+func (q *sendQueue) drain() []*sdkws.MsgData {
+	var msgs []*sdkws.MsgData
+	for {
+		select {
+		case frame := <-q.frames:
+			prommetrics.SendQueueDepthGauge.Dec()
+			msgs = append(msgs, frame.msgs...)
+		default:
+			return msgs
+		}
+	}
+}
+
+// enqueue hands an encoded frame to the writer goroutine and applies the slow consumer policy
+// when the queue refuses it.
+func (c *Client) enqueue(frame queuedFrame, droppable bool) error {
+	ok, err := c.queue.push(frame, droppable)
+	if err != nil || ok {
+		return err
+	}
+	prommetrics.SendQueueDroppedCounter.Inc()
+	if c.queue.policy == SlowConsumerClose || !droppable {
+		log.ZWarn(c.ctx, "evict slow consumer", ErrSendQueueFull, "userID", c.UserID, "platformID", c.PlatformID,
+			"depth", len(c.queue.frames))
+		prommetrics.SlowConsumerEvictedCounter.Inc()
+		c.setClosedErr(ErrSendQueueFull)
+		// the writer may hold the conn lock until its write deadline, so the caller must not wait for it
+		c.queue.close()
+		go c.close()
+	}
+	return ErrSendQueueFull
+}
+
+// writeMessage continuously writes queued frames to the connection until the client is closed.
+func (c *Client) writeMessage() {
+	q, conn := c.queue, c.conn
+	// close unregisters the client, which is then returned to the pool and may be reset
+	// before the deferred push runs
+	userID, acks, server := c.UserID, c.acks, c.longConnServer
+	var dropped []*sdkws.MsgData
+	defer func() {
+		pushDropped(userID, acks, server, append(dropped, q.drain()...))
+	}()
+	for {
+		select {
+		case <-q.done:
+			return
+		case frame := <-q.frames:
+			prommetrics.SendQueueDepthGauge.Dec()
+			if err := c.writeFrame(conn, frame.data); err != nil {
+				log.ZWarn(c.ctx, "writeMessage", err, "userID", c.UserID, "platformID", c.PlatformID)
+				c.setClosedErr(err)
+				c.close()
+				dropped = frame.msgs
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConn struct {
+	LongConn
+	mu       sync.Mutex
+	frames   [][]byte
+	closed   bool
+	writeErr error
+}
+
+func (c *testConn) SetWriteDeadline(time.Duration) error { return nil }
+
+func (c *testConn) WriteMessage(_ int, message []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.writeErr != nil {
+		return c.writeErr
+	}
+	c.frames = append(c.frames, message)
+	return nil
+}
+
+func (c *testConn) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	return nil
+}
+
+func (c *testConn) isClosed() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closed
+}
+
+type testConnServer struct {
+	LongConnServer
+	unregistered   chan *Client
+	onUnRegister   func(c *Client)
+	dropped        []*sdkws.MsgData
+	droppedUserIDs []string
+}
+
+func (s *testConnServer) UnRegister(c *Client) {
+	if s.onUnRegister != nil {
+		s.onUnRegister(c)
+	}
+	s.unregistered <- c
+}
+
+func (s *testConnServer) OfflinePushDropped(userID string, msgs []*sdkws.MsgData) {
+	s.dropped = append(s.dropped, msgs...)
+	s.droppedUserIDs = append(s.droppedUserIDs, userID)
+}
+
+func testFrame(data string) queuedFrame {
+	return queuedFrame{data: []byte(data)}
+}
+
+func newQueueTestClient(policy string) (*Client, *testConn, *testConnServer) {
+	conn := &testConn{}
+	server := &testConnServer{unregistered: make(chan *Client, 1)}
+	c := &Client{
+		conn:           conn,
+		UserID:         "u1",
+		ctx:            &UserConnContext{},
+		longConnServer: server,
+		queue:          newSendQueue(4, 2, policy),
+	}
+	return c, conn, server
+}
+
+func TestSendQueuePush(t *testing.T) {
+	q := newSendQueue(3, 1, SlowConsumerDrop)
+	ok, err := q.push(testFrame("a"), true)
+	assert.True(t, ok)
+	assert.NoError(t, err)
+	// droppable frames are refused at the high-water mark
+	ok, err = q.push(testFrame("b"), true)
+	assert.False(t, ok)
+	assert.NoError(t, err)
+	// the others until the queue is full
+	for i := 0; i < 2; i++ {
+		ok, _ = q.push(testFrame("c"), false)
+		assert.True(t, ok)
+	}
+	ok, _ = q.push(testFrame("d"), false)
+	assert.False(t, ok)
+
+	q.close()
+	_, err = q.push(testFrame("e"), false)
+	assert.ErrorIs(t, err, ErrConnClosed)
+	q.drain()
+	assert.Empty(t, q.frames)
+}
+
+func TestEnqueueDropPolicy(t *testing.T) {
+	c, conn, _ := newQueueTestClient(SlowConsumerDrop)
+	assert.NoError(t, c.enqueue(testFrame("a"), true))
+	assert.NoError(t, c.enqueue(testFrame("b"), true))
+	// pushes are dropped, the connection stays open
+	assert.ErrorIs(t, c.enqueue(testFrame("c"), true), ErrSendQueueFull)
+	assert.False(t, c.closed.Load())
+	assert.Nil(t, c.getClosedErr())
+	// replies are still queued above the high-water mark
+	assert.NoError(t, c.enqueue(testFrame("d"), false))
+	assert.False(t, conn.isClosed())
+}
+
+func TestEnqueueEvictsSlowConsumer(t *testing.T) {
+	for _, policy := range []string{SlowConsumerDrop, SlowConsumerClose} {
+		c, conn, server := newQueueTestClient(policy)
+		droppable := policy == SlowConsumerClose
+		for i := 0; i < 4; i++ {
+			assert.NoError(t, c.enqueue(testFrame("a"), false))
+		}
+		// a reply that does not fit, or any frame at the high-water mark with the close policy, evicts the client
+		assert.ErrorIs(t, c.enqueue(testFrame("b"), droppable), ErrSendQueueFull)
+		select {
+		case unregistered := <-server.unregistered:
+			assert.Equal(t, c, unregistered)
+		case <-time.After(time.Second):
+			t.Fatalf("client not evicted with policy %s", policy)
+		}
+		assert.True(t, conn.isClosed())
+		assert.ErrorIs(t, c.getClosedErr(), ErrSendQueueFull)
+		_, err := c.queue.push(testFrame("c"), false)
+		assert.ErrorIs(t, err, ErrConnClosed)
+	}
+}
+
+func TestWriteMessageDrainsQueue(t *testing.T) {
+	c, conn, _ := newQueueTestClient(SlowConsumerDrop)
+	assert.NoError(t, c.enqueue(testFrame("a"), false))
+	assert.NoError(t, c.enqueue(testFrame("b"), false))
+	done := make(chan struct{})
+	go func() {
+		c.writeMessage()
+		close(done)
+	}()
+	assert.Eventually(t, func() bool {
+		conn.mu.Lock()
+		defer conn.mu.Unlock()
+		return len(conn.frames) == 2
+	}, time.Second, 10*time.Millisecond)
+	c.queue.close()
+	<-done
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, conn.frames)
+}
+
+func TestLogoutReplyWrittenBeforeClose(t *testing.T) {
+	c, conn, _ := newQueueTestClient(SlowConsumerDrop)
+	c.encoder = NewGobEncoder()
+	err := c.replyMessage(c.ctx, &Req{ReqIdentifier: WsLogoutMsg}, nil, nil)
+	assert.Error(t, err)
+	// the ack bypasses the queue, which is dropped once the reader returns the error
+	assert.Len(t, conn.frames, 1)
+	assert.Empty(t, c.queue.frames)
+}
+
+func TestDiscardedPushesPushedOffline(t *testing.T) {
+	msgs := []*sdkws.MsgData{
+		{SendID: "a", RecvID: "u1", SessionType: constant.SingleChatType, Seq: 1},
+		// unpersisted messages are not pushed offline
+		{SendID: "a", RecvID: "u1", SessionType: constant.SingleChatType},
+	}
+	c, _, server := newQueueTestClient(SlowConsumerDrop)
+	assert.NoError(t, c.enqueue(queuedFrame{data: []byte("a"), msgs: msgs}, true))
+	assert.NoError(t, c.enqueue(testFrame("b"), false))
+	c.queue.close()
+	pushDropped(c.UserID, c.acks, c.longConnServer, c.queue.drain())
+	assert.Equal(t, msgs[:1], server.dropped)
+
+	// with acks the tracker pushes them at its next check instead of after the ack timeout
+	c, _, server = newQueueTestClient(SlowConsumerDrop)
+	c.acks = newPushAckTracker(time.Hour, time.Hour, nil, nil)
+	c.acks.track(c.UserID, msgs)
+	pushDropped(c.UserID, c.acks, c.longConnServer, msgs)
+	assert.Empty(t, server.dropped)
+	assert.Equal(t, map[*sdkws.MsgData][]string{msgs[0]: {"u1"}}, c.acks.expire(time.Now()))
+}
+
+func TestWriteFailurePushesDroppedForOriginalUser(t *testing.T) {
+	msgs := []*sdkws.MsgData{{SendID: "a", RecvID: "u1", SessionType: constant.SingleChatType, Seq: 1}}
+	c, conn, server := newQueueTestClient(SlowConsumerDrop)
+	conn.writeErr = ErrConnClosed
+	// the unregistered client is reused for another user before the writer returns
+	server.onUnRegister = func(c *Client) {
+		c.UserID = "u2"
+		c.acks = newPushAckTracker(time.Hour, time.Hour, nil, nil)
+	}
+	assert.NoError(t, c.enqueue(queuedFrame{data: []byte("a"), msgs: msgs}, true))
+	c.writeMessage()
+	assert.Equal(t, msgs, server.dropped)
+	assert.Equal(t, []string{"u1"}, server.droppedUserIDs)
+}
+
+func TestRefusedPushUntracked(t *testing.T) {
+	c, _, _ := newQueueTestClient(SlowConsumerDrop)
+	c.encoder = NewGobEncoder()
+	c.acks = newPushAckTracker(time.Hour, time.Hour, nil, nil)
+	for i := 0; i < 2; i++ {
+		assert.NoError(t, c.enqueue(testFrame("a"), false))
+	}
+	// refused at the high-water mark, the push service falls back on the returned error
+	err := c.PushMessage(context.Background(), &sdkws.MsgData{SendID: "a", RecvID: "u1", SessionType: constant.SingleChatType, Seq: 1})
+	assert.ErrorIs(t, err, ErrSendQueueFull)
+	assert.Empty(t, c.acks.pending)
+}
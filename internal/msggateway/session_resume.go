@@ -0,0 +1,157 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/log"
+)
+
+type resumeSession struct {
+	token    string
+	expireAt time.Time
+}
+
+// resumeSessions indexes the sessions that disconnected from this node and may still be resumed,
+// only the node that owned a session buffers the pushes it misses.
+type resumeSessions struct {
+	lock sync.Mutex
+	m    map[string]map[int]resumeSession
+}
+
+func newResumeSessions() *resumeSessions {
+	return &resumeSessions{m: make(map[string]map[int]resumeSession)}
+}
+
+func (r *resumeSessions) add(userID string, platformID int, token string, window time.Duration) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	sessions, ok := r.m[userID]
+	if !ok {
+		sessions = make(map[int]resumeSession)
+		r.m[userID] = sessions
+	}
+	sessions[platformID] = resumeSession{token: token, expireAt: time.Now().Add(window)}
+	time.AfterFunc(window, func() {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		if s, ok := r.m[userID][platformID]; ok && s.token == token {
+			r.deleteLocked(userID, platformID)
+		}
+	})
+}
+
+func (r *resumeSessions) remove(userID string, platformID int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.deleteLocked(userID, platformID)
+}
+
+func (r *resumeSessions) deleteLocked(userID string, platformID int) {
+	delete(r.m[userID], platformID)
+	if len(r.m[userID]) == 0 {
+		delete(r.m, userID)
+	}
+}
+
+func (r *resumeSessions) tokens(userID string) []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	now := time.Now()
+	var tokens []string
+	for platformID, s := range r.m[userID] {
+		if now.After(s.expireAt) {
+			r.deleteLocked(userID, platformID)
+			continue
+		}
+		tokens = append(tokens, s.token)
+	}
+	return tokens
+}
+
+// suspendSession keeps the session of a disconnected client resumable for the resume window.
+func (ws *WsServer) suspendSession(client *Client) {
+	if ws.resumeWindow <= 0 || client.resumeToken == "" {
+		return
+	}
+	err := ws.cache.SetResumeSession(client.ctx, client.resumeToken, client.UserID, client.PlatformID, ws.resumeWindow)
+	if err != nil {
+		log.ZWarn(client.ctx, "SetResumeSession err", err, "userID", client.UserID, "platformID", client.PlatformID)
+		return
+	}
+	ws.resumeSessions.add(client.UserID, client.PlatformID, client.resumeToken, ws.resumeWindow)
+}
+
+// resumeSession replays the pushes buffered for the token the client reconnected with,
+// then hands it the token of the new session.
+func (ws *WsServer) resumeSession(client *Client) {
+	if ws.resumeWindow <= 0 || client.resumeToken == "" {
+		return
+	}
+	ws.resumeSessions.remove(client.UserID, client.PlatformID)
+	tips := &sdkws.SessionResumeTips{
+		ResumeToken:   client.resumeToken,
+		ExpireSeconds: int64(ws.resumeWindow / time.Second),
+	}
+	if client.resumeFrom != "" {
+		userID, platformID, msgs, overflow, ok, err := ws.cache.TakeResumeSession(client.ctx, client.resumeFrom)
+		switch {
+		case err != nil:
+			log.ZWarn(client.ctx, "TakeResumeSession err", err, "resumeToken", client.resumeFrom)
+		case !ok || overflow || userID != client.UserID || platformID != client.PlatformID:
+			log.ZInfo(client.ctx, "session not resumable", "resumeToken", client.resumeFrom, "exist", ok, "overflow", overflow)
+		case len(msgs) == 0:
+			tips.Resumed = true
+		default:
+			if err := client.PushMessages(client.ctx, msgs); err != nil {
+				log.ZWarn(client.ctx, "replay pushes err", err, "resumeToken", client.resumeFrom)
+				break
+			}
+			tips.Resumed = true
+			tips.Replayed = int32(len(msgs))
+		}
+	}
+	data, err := proto.Marshal(tips)
+	if err != nil {
+		log.ZWarn(client.ctx, "marshal SessionResumeTips err", err)
+		return
+	}
+	resp := Resp{
+		ReqIdentifier: WSSessionResume,
+		OperationID:   client.ctx.GetOperationID(),
+		Data:          data,
+	}
+	if err := client.writeBinaryMsg(resp); err != nil {
+		log.ZWarn(client.ctx, "wireBinaryMsg resumeSession", err, "resp", resp.String())
+	}
+}
+
+// BufferResumePush keeps msgData for the sessions of userID that disconnected from this node.
+func (ws *WsServer) BufferResumePush(ctx context.Context, userID string, msgData *sdkws.MsgData) {
+	if ws.resumeWindow <= 0 {
+		return
+	}
+	for _, token := range ws.resumeSessions.tokens(userID) {
+		if err := ws.cache.AppendResumeMsg(ctx, token, msgData, ws.resumeMaxBuffered, ws.resumeWindow); err != nil {
+			log.ZWarn(ctx, "AppendResumeMsg err", err, "userID", userID)
+		}
+	}
+}
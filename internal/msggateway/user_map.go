@@ -16,33 +16,58 @@ package msggateway
 
 import (
 	"context"
+	"hash/fnv"
 	"sync"
 
 	"github.com/OpenIMSDK/tools/log"
 	"github.com/OpenIMSDK/tools/utils"
 )
 
+// UserMap is sharded by the hash of the userID, all the connections of a user live in one shard.
+// The slices handed out are never modified in place, writers always store a new slice.
 type UserMap struct {
-	m sync.Map
+	shards []*userShard
 }
 
-func newUserMap() *UserMap {
-	return &UserMap{}
+type userShard struct {
+	lock sync.RWMutex
+	m    map[string][]*Client
 }
 
-func (u *UserMap) GetAll(key string) ([]*Client, bool) {
-	allClients, ok := u.m.Load(key)
-	if ok {
-		return allClients.([]*Client), ok
+func newUserMap(shards int) *UserMap {
+	if shards <= 0 {
+		shards = 1
+	}
+	u := &UserMap{shards: make([]*userShard, shards)}
+	for i := range u.shards {
+		u.shards[i] = &userShard{m: make(map[string][]*Client)}
 	}
-	return nil, ok
+	return u
+}
+
+func (u *UserMap) shardIndex(key string) int {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % uint32(len(u.shards)))
+}
+
+func (u *UserMap) shard(key string) *userShard {
+	return u.shards[u.shardIndex(key)]
+}
+
+func (u *UserMap) GetAll(key string) ([]*Client, bool) {
+	s := u.shard(key)
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	allClients, ok := s.m[key]
+	return allClients, ok
 }
 
 func (u *UserMap) Get(key string, platformID int) ([]*Client, bool, bool) {
-	allClients, userExisted := u.m.Load(key)
+	allClients, userExisted := u.GetAll(key)
 	if userExisted {
 		var clients []*Client
-		for _, client := range allClients.([]*Client) {
+		for _, client := range allClients {
 			if client.PlatformID == platformID {
 				clients = append(clients, client)
 			}
@@ -56,65 +81,79 @@ func (u *UserMap) Get(key string, platformID int) ([]*Client, bool, bool) {
 }
 
 func (u *UserMap) Set(key string, v *Client) {
-	allClients, existed := u.m.Load(key)
+	s := u.shard(key)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	oldClients, existed := s.m[key]
 	if existed {
-		log.ZDebug(context.Background(), "Set existed", "user_id", key, "client", *v)
-		oldClients := allClients.([]*Client)
-		oldClients = append(oldClients, v)
-		u.m.Store(key, oldClients)
+		log.ZDebug(context.Background(), "Set existed", "user_id", key, "platformID", v.PlatformID)
 	} else {
-		log.ZDebug(context.Background(), "Set not existed", "user_id", key, "client", *v)
-		var clients []*Client
-		clients = append(clients, v)
-		u.m.Store(key, clients)
+		log.ZDebug(context.Background(), "Set not existed", "user_id", key, "platformID", v.PlatformID)
 	}
+	clients := make([]*Client, 0, len(oldClients)+1)
+	clients = append(clients, oldClients...)
+	s.m[key] = append(clients, v)
 }
 
-func (u *UserMap) delete(key string, connRemoteAddr string) (isDeleteUser bool) {
-	allClients, existed := u.m.Load(key)
-	if existed {
-		oldClients := allClients.([]*Client)
-		var a []*Client
-		for _, client := range oldClients {
-			if client.ctx.GetRemoteAddr() != connRemoteAddr {
-				a = append(a, client)
-			}
-		}
-		if len(a) == 0 {
-			u.m.Delete(key)
-			return true
-		} else {
-			u.m.Store(key, a)
-			return false
+// filter keeps the clients of key for which keep returns true and reports whether the user is gone.
+func (u *UserMap) filter(key string, keep func(c *Client) bool) (isDeleteUser bool) {
+	s := u.shard(key)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	oldClients, existed := s.m[key]
+	if !existed {
+		return false
+	}
+	var a []*Client
+	for _, client := range oldClients {
+		if keep(client) {
+			a = append(a, client)
 		}
 	}
-	return existed
+	if len(a) == 0 {
+		delete(s.m, key)
+		return true
+	}
+	s.m[key] = a
+	return false
+}
+
+func (u *UserMap) delete(key string, connRemoteAddr string) (isDeleteUser bool) {
+	return u.filter(key, func(c *Client) bool {
+		return c.ctx.GetRemoteAddr() != connRemoteAddr
+	})
 }
 
 func (u *UserMap) deleteClients(key string, clients []*Client) (isDeleteUser bool) {
 	m := utils.SliceToMapAny(clients, func(c *Client) (string, struct{}) {
 		return c.ctx.GetRemoteAddr(), struct{}{}
 	})
-	allClients, existed := u.m.Load(key)
-	if existed {
-		oldClients := allClients.([]*Client)
-		var a []*Client
-		for _, client := range oldClients {
-			if _, ok := m[client.ctx.GetRemoteAddr()]; !ok {
-				a = append(a, client)
-			}
+	return u.filter(key, func(c *Client) bool {
+		_, ok := m[c.ctx.GetRemoteAddr()]
+		return !ok
+	})
+}
+
+// Range calls f for every user until f returns false, f runs without holding any shard lock.
+func (u *UserMap) Range(f func(userID string, clients []*Client) bool) {
+	for _, s := range u.shards {
+		s.lock.RLock()
+		users := make(map[string][]*Client, len(s.m))
+		for k, v := range s.m {
+			users[k] = v
 		}
-		if len(a) == 0 {
-			u.m.Delete(key)
-			return true
-		} else {
-			u.m.Store(key, a)
-			return false
+		s.lock.RUnlock()
+		for k, v := range users {
+			if !f(k, v) {
+				return
+			}
 		}
 	}
-	return existed
 }
 
 func (u *UserMap) DeleteAll(key string) {
-	u.m.Delete(key)
+	s := u.shard(key)
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.m, key)
 }
@@ -0,0 +1,75 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msggateway
+
+import (
+	"fmt"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestClient(userID string, platformID int, remoteAddr string) *Client {
+	return &Client{UserID: userID, PlatformID: platformID, ctx: &UserConnContext{RemoteAddr: remoteAddr}}
+}
+
+func TestUserMap(t *testing.T) {
+	u := newUserMap(4)
+	c1 := newTestClient("user", 1, "addr1")
+	c2 := newTestClient("user", 2, "addr2")
+	u.Set("user", c1)
+	u.Set("user", c2)
+
+	all, ok := u.GetAll("user")
+	assert.True(t, ok)
+	assert.Equal(t, []*Client{c1, c2}, all)
+
+	clients, userOK, clientOK := u.Get("user", 2)
+	assert.True(t, userOK)
+	assert.True(t, clientOK)
+	assert.Equal(t, []*Client{c2}, clients)
+
+	_, userOK, clientOK = u.Get("user", 3)
+	assert.True(t, userOK)
+	assert.False(t, clientOK)
+
+	assert.False(t, u.deleteClients("user", []*Client{c1}))
+	// the slice handed out before is not modified
+	assert.Equal(t, []*Client{c1, c2}, all)
+	assert.True(t, u.delete("user", "addr2"))
+	_, ok = u.GetAll("user")
+	assert.False(t, ok)
+}
+
+func BenchmarkUserMap(b *testing.B) {
+	for _, shards := range []int{1, defaultUserMapShards} {
+		b.Run(fmt.Sprintf("shards-%d", shards), func(b *testing.B) {
+			u := newUserMap(shards)
+			var seq atomic.Int64
+			b.RunParallel(func(pb *testing.PB) {
+				for pb.Next() {
+					i := seq.Add(1)
+					userID := strconv.FormatInt(i%10000, 10)
+					remoteAddr := strconv.FormatInt(i, 10)
+					u.Set(userID, newTestClient(userID, 1, remoteAddr))
+					u.GetAll(userID)
+					u.delete(userID, remoteAddr)
+				}
+			})
+		})
+	}
+}
@@ -32,8 +32,10 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
 	kdisc "github.com/openimsdk/open-im-server/v3/pkg/common/discoveryregister"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
@@ -73,15 +75,29 @@ func StartTransfer(prometheusPort int) error {
 	msgDatabase := controller.NewCommonMsgDatabase(msgDocModel, msgModel)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
-	msgTransfer := NewMsgTransfer(msgDatabase, &conversationRpcClient, &groupRpcClient)
+	events, err := eventbus.NewPublisher(rdb)
+	if err != nil {
+		return err
+	}
+	threadDB, err := mgo.NewMsgThreadMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	threadDatabase := controller.NewMsgThreadDatabase(threadDB, cache.NewMsgThreadCacheRedis(rdb, threadDB, cache.GetDefaultOpt()))
+	searchDB, err := mgo.NewMsgSearchMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	searchDatabase := controller.NewMsgSearchDatabase(searchDB)
+	msgTransfer := NewMsgTransfer(msgDatabase, threadDatabase, searchDatabase, &conversationRpcClient, &groupRpcClient, events)
 	return msgTransfer.Start(prometheusPort)
 }
 
-func NewMsgTransfer(msgDatabase controller.CommonMsgDatabase, conversationRpcClient *rpcclient.ConversationRpcClient,
-	groupRpcClient *rpcclient.GroupRpcClient) *MsgTransfer {
+func NewMsgTransfer(msgDatabase controller.CommonMsgDatabase, threadDatabase controller.MsgThreadDatabase, searchDatabase controller.MsgSearchDatabase, conversationRpcClient *rpcclient.ConversationRpcClient,
+	groupRpcClient *rpcclient.GroupRpcClient, events eventbus.Publisher) *MsgTransfer {
 	return &MsgTransfer{
-		historyCH:      NewOnlineHistoryRedisConsumerHandler(msgDatabase, conversationRpcClient, groupRpcClient),
-		historyMongoCH: NewOnlineHistoryMongoConsumerHandler(msgDatabase),
+		historyCH:      NewOnlineHistoryRedisConsumerHandler(msgDatabase, conversationRpcClient, groupRpcClient, events),
+		historyMongoCH: NewOnlineHistoryMongoConsumerHandler(msgDatabase, threadDatabase, searchDatabase),
 	}
 }
 
@@ -37,6 +37,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
@@ -81,15 +82,18 @@ type OnlineHistoryRedisConsumerHandler struct {
 	msgDatabase           controller.CommonMsgDatabase
 	conversationRpcClient *rpcclient.ConversationRpcClient
 	groupRpcClient        *rpcclient.GroupRpcClient
+	events                eventbus.Publisher
 }
 
 func NewOnlineHistoryRedisConsumerHandler(
 	database controller.CommonMsgDatabase,
 	conversationRpcClient *rpcclient.ConversationRpcClient,
 	groupRpcClient *rpcclient.GroupRpcClient,
+	events eventbus.Publisher,
 ) *OnlineHistoryRedisConsumerHandler {
 	var och OnlineHistoryRedisConsumerHandler
 	och.msgDatabase = database
+	och.events = events
 	och.msgDistributionCh = make(chan Cmd2Value) // no buffer channel
 	go och.MessagesDistributionHandle()
 	for i := 0; i < ChannelNum; i++ {
@@ -327,7 +331,12 @@ func (och *OnlineHistoryRedisConsumerHandler) handleMsg(
 		och.toPushTopic(ctx, key, conversationID, storageList)
 
 		// 仅用户消息 同步推送至es
-		_ = och.msgDatabase.MsgToEsMQ(ctx, conversationID, storageList)
+		if err := och.events.Publish(ctx, eventbus.EventMsgIndex, conversationID, &eventbus.MsgIndexPayload{
+			ConversationID: conversationID,
+			Msgs:           storageList,
+		}); err != nil {
+			log.ZWarn(ctx, "publish msg index failed", err, "conversationID", conversationID)
+		}
 	}
 }
 
@@ -31,16 +31,21 @@ import (
 type OnlineHistoryMongoConsumerHandler struct {
 	historyConsumerGroup *kfk.MConsumerGroup
 	msgDatabase          controller.CommonMsgDatabase
+	threadDatabase       controller.MsgThreadDatabase
+	searchDatabase       controller.MsgSearchDatabase
 }
 
-func NewOnlineHistoryMongoConsumerHandler(database controller.CommonMsgDatabase) *OnlineHistoryMongoConsumerHandler {
+func NewOnlineHistoryMongoConsumerHandler(database controller.CommonMsgDatabase, threadDatabase controller.MsgThreadDatabase,
+	searchDatabase controller.MsgSearchDatabase) *OnlineHistoryMongoConsumerHandler {
 	mc := &OnlineHistoryMongoConsumerHandler{
 		historyConsumerGroup: kfk.NewMConsumerGroup(&kfk.MConsumerGroupConfig{
 			KafkaVersion:   sarama.V2_0_0_0,
 			OffsetsInitial: sarama.OffsetNewest, IsReturnErr: false,
 		}, []string{config.Config.Kafka.MsgToMongo.Topic},
 			config.Config.Kafka.Addr, config.Config.Kafka.ConsumerGroupID.MsgToMongo),
-		msgDatabase: database,
+		msgDatabase:    database,
+		threadDatabase: threadDatabase,
+		searchDatabase: searchDatabase,
 	}
 	return mc
 }
@@ -77,6 +82,15 @@ func (mc *OnlineHistoryMongoConsumerHandler) handleChatWs2Mongo(
 		prommetrics.MsgInsertMongoFailedCounter.Inc()
 	} else {
 		prommetrics.MsgInsertMongoSuccessCounter.Inc()
+		// 消息落库后记录话题回复
+		if err := mc.threadDatabase.AddReplies(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData); err != nil {
+			log.ZError(ctx, "add thread replies err", err, "conversationID", msgFromMQ.ConversationID)
+		}
+		if config.Config.MsgSearch.Enable {
+			if err := mc.searchDatabase.IndexMsgs(ctx, msgFromMQ.ConversationID, msgFromMQ.MsgData); err != nil {
+				log.ZError(ctx, "index msgs err", err, "conversationID", msgFromMQ.ConversationID)
+			}
+		}
 	}
 	var seqs []int64
 	for _, msg := range msgFromMQ.MsgData {
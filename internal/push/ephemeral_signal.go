@@ -0,0 +1,137 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package push
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msggateway"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// PushEphemeralSignal delivers signal to the online connections of its recipients only,
+// it is neither persisted nor pushed offline.
+func (p *Pusher) PushEphemeralSignal(ctx context.Context, signal *sdkws.EphemeralSignal) error {
+	var pushToUserIDs []string
+	switch signal.SessionType {
+	case constant.SingleChatType:
+		if signal.RecvID == "" || signal.RecvID == signal.SendID {
+			return errs.ErrArgs.Wrap("invalid recvID")
+		}
+		if err := p.verifySingleSignal(ctx, signal.SendID, signal.RecvID); err != nil {
+			return err
+		}
+		pushToUserIDs = []string{signal.RecvID}
+	case constant.SuperGroupChatType:
+		memberIDs, err := p.groupLocalCache.GetGroupMemberIDs(ctx, signal.GroupID)
+		if err != nil {
+			return err
+		}
+		if !utils.Contain(signal.SendID, memberIDs...) {
+			return errs.ErrNotInGroupYet.Wrap("sender is not in group " + signal.GroupID)
+		}
+		pushToUserIDs = utils.Filter(memberIDs, func(userID string) (string, bool) {
+			return userID, userID != signal.SendID
+		})
+	default:
+		return errs.ErrArgs.Wrap("unsupported sessionType")
+	}
+	if len(pushToUserIDs) == 0 {
+		return nil
+	}
+	usersConns, err := p.getGatewayConns(ctx, pushToUserIDs)
+	if err != nil {
+		return err
+	}
+	wg := errgroup.Group{}
+	wg.SetLimit(utils.WaitGroupSetLimit(len(usersConns)))
+	for conn, userIDs := range usersConns {
+		conn, userIDs := conn, userIDs
+		wg.Go(func() error {
+			input := &msggateway.OnlinePushEphemeralSignalReq{Signal: signal, PushToUserIDs: userIDs}
+			if _, err := msggateway.NewMsgGatewayClient(conn).OnlinePushEphemeralSignal(ctx, input); err != nil {
+				log.ZWarn(ctx, "OnlinePushEphemeralSignal failed", err, "target", conn.Target())
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+	return nil
+}
+
+// verifySingleSignal applies the blacklist and friend verification of single chat messages to signals.
+func (p *Pusher) verifySingleSignal(ctx context.Context, sendID, recvID string) error {
+	if utils.IsContain(sendID, config.Config.Manager.UserID) {
+		return nil
+	}
+	black, err := p.friendRpcClient.IsBlocked(ctx, sendID, recvID)
+	if err != nil {
+		return err
+	}
+	if black {
+		return errs.ErrBlockedByPeer.Wrap()
+	}
+	if *config.Config.MessageVerify.FriendVerify {
+		friend, err := p.friendRpcClient.IsFriend(ctx, sendID, recvID)
+		if err != nil {
+			return err
+		}
+		if !friend {
+			return errs.ErrNotPeersFriend.Wrap()
+		}
+	}
+	return nil
+}
+
+// getGatewayConns maps the gateway connections to the users they should be asked for,
+// every gateway is asked for all users unless the users are hashed onto gateways by k8s.
+func (p *Pusher) getGatewayConns(ctx context.Context, userIDs []string) (map[*grpc.ClientConn][]string, error) {
+	usersConns := make(map[*grpc.ClientConn][]string)
+	if config.Config.Envs.Discovery != "k8s" {
+		conns, err := p.discov.GetConns(ctx, config.Config.RpcRegisterName.OpenImMessageGatewayName)
+		if err != nil {
+			return nil, err
+		}
+		for _, conn := range conns {
+			usersConns[conn] = userIDs
+		}
+		return usersConns, nil
+	}
+	hostUsers := make(map[string][]string)
+	for _, userID := range userIDs {
+		host, err := p.discov.GetUserIdHashGatewayHost(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		hostUsers[host] = append(hostUsers[host], userID)
+	}
+	for host, users := range hostUsers {
+		conn, err := p.discov.GetConn(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		usersConns[conn] = users
+	}
+	return usersConns, nil
+}
@@ -26,11 +26,13 @@ import (
 	"github.com/OpenIMSDK/protocol/constant"
 	pbpush "github.com/OpenIMSDK/protocol/push"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/localcache"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 )
 
@@ -56,6 +58,7 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	conversationRpcClient := rpcclient.NewConversationRpcClient(client)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
+	friendRpcClient := rpcclient.NewFriendRpcClient(client)
 	notificationSender := rpcclient.NewNotificationSender()
 	pusher := NewPusher(
 		client,
@@ -67,6 +70,7 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		&conversationRpcClient,
 		&groupRpcClient,
 		&msgRpcClient,
+		&friendRpcClient,
 		notificationSender,
 	)
 	var wg sync.WaitGroup
@@ -125,3 +129,40 @@ func (r *pushServer) DelUserPushToken(
 	}
 	return &pbpush.DelUserPushTokenResp{}, nil
 }
+
+func (r *pushServer) PushEphemeralSignal(
+	ctx context.Context,
+	req *pbpush.PushEphemeralSignalReq,
+) (resp *pbpush.PushEphemeralSignalResp, err error) {
+	if req.Signal == nil {
+		return nil, errs.ErrArgs.Wrap("signal is nil")
+	}
+	if err = r.pusher.PushEphemeralSignal(ctx, req.Signal); err != nil {
+		return nil, err
+	}
+	return &pbpush.PushEphemeralSignalResp{}, nil
+}
+
+// OfflinePushFallback pushes msgData offline to the users that did not ack its online push in time.
+func (r *pushServer) OfflinePushFallback(
+	ctx context.Context,
+	req *pbpush.OfflinePushFallbackReq,
+) (resp *pbpush.OfflinePushFallbackResp, err error) {
+	if req.MsgData == nil {
+		return nil, errs.ErrArgs.Wrap("msgData is nil")
+	}
+	if len(req.UserIDs) == 0 {
+		return &pbpush.OfflinePushFallbackResp{}, nil
+	}
+	if err = callbackOfflinePush(ctx, req.UserIDs, req.MsgData, &[]string{}); err != nil {
+		return nil, err
+	}
+	conversationID := msgprocessor.GetConversationIDByMsg(req.MsgData)
+	if err = r.pusher.offlinePushMsg(ctx, conversationID, req.MsgData, nil, req.UserIDs); err != nil {
+		if err != errNoOfflinePusher {
+			return nil, err
+		}
+		log.ZWarn(ctx, "offline push failed", err, "msg", req.MsgData.String())
+	}
+	return &pbpush.OfflinePushFallbackResp{}, nil
+}
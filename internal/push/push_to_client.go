@@ -55,6 +55,7 @@ type Pusher struct {
 	msgRpcClient           *rpcclient.MessageRpcClient
 	conversationRpcClient  *rpcclient.ConversationRpcClient
 	groupRpcClient         *rpcclient.GroupRpcClient
+	friendRpcClient        *rpcclient.FriendRpcClient
 	notificationSender     *rpcclient.NotificationSender
 }
 
@@ -64,7 +65,7 @@ func NewPusher(discov discoveryregistry.SvcDiscoveryRegistry, offlinePusher offl
 	msgDatabase controller.CommonMsgDatabase,
 	groupLocalCache *localcache.GroupLocalCache, conversationLocalCache *localcache.ConversationLocalCache,
 	conversationRpcClient *rpcclient.ConversationRpcClient, groupRpcClient *rpcclient.GroupRpcClient, msgRpcClient *rpcclient.MessageRpcClient,
-	notificationSender *rpcclient.NotificationSender,
+	friendRpcClient *rpcclient.FriendRpcClient, notificationSender *rpcclient.NotificationSender,
 ) *Pusher {
 	return &Pusher{
 		discov:                 discov,
@@ -76,6 +77,7 @@ func NewPusher(discov discoveryregistry.SvcDiscoveryRegistry, offlinePusher offl
 		msgRpcClient:           msgRpcClient,
 		conversationRpcClient:  conversationRpcClient,
 		groupRpcClient:         groupRpcClient,
+		friendRpcClient:        friendRpcClient,
 		notificationSender:     notificationSender,
 	}
 }
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc"
 	"sort"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
@@ -45,6 +46,7 @@ type conversationServer struct {
 	groupRpcClient                 *rpcclient.GroupRpcClient
 	conversationDatabase           controller.ConversationDatabase
 	conversationNotificationSender *notification.ConversationNotificationSender
+	pinDatabase                    controller.MsgPinDatabase
 }
 
 func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) error {
@@ -60,6 +62,10 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	if err != nil {
 		return err
 	}
+	pinDB, err := mgo.NewMsgPinMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
 	userRpcClient := rpcclient.NewUserRpcClient(client)
@@ -70,6 +76,7 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		conversationNotificationSender: notification.NewConversationNotificationSender(&msgRpcClient),
 		groupRpcClient:                 &groupRpcClient,
 		conversationDatabase:           controller.NewConversationDatabase(conversationDB, cache.NewConversationRedis(rdb, cache.GetDefaultOpt(), conversationDB), tx.NewMongo(mongo.GetClient())),
+		pinDatabase:                    controller.NewMsgPinDatabase(pinDB, cache.NewMsgPinCacheRedis(rdb, pinDB, cache.GetDefaultOpt())),
 	})
 	return nil
 }
@@ -84,9 +91,31 @@ func (c *conversationServer) GetConversation(ctx context.Context, req *pbconvers
 	}
 	resp := &pbconversation.GetConversationResp{Conversation: &pbconversation.Conversation{}}
 	resp.Conversation = convert.ConversationDB2Pb(conversations[0])
+	c.attachPinnedMsgs(ctx, []*pbconversation.Conversation{resp.Conversation})
 	return resp, nil
 }
 
+// attachPinnedMsgs 填充会话的置顶消息，只包含置顶信息，消息内容由 GetPinnedMsgs 获取，失败不影响获取会话
+func (c *conversationServer) attachPinnedMsgs(ctx context.Context, conversations []*pbconversation.Conversation) {
+	if !config.Config.MsgPin.Enable || len(conversations) == 0 {
+		return
+	}
+	conversationIDs := make([]string, 0, len(conversations))
+	for _, conversation := range conversations {
+		conversationIDs = append(conversationIDs, conversation.ConversationID)
+	}
+	pins, err := c.pinDatabase.GetConversationsPins(ctx, conversationIDs)
+	if err != nil {
+		log.ZWarn(ctx, "GetConversationsPins failed", err, "conversationIDs", conversationIDs)
+		return
+	}
+	for _, conversation := range conversations {
+		if ps, ok := pins[conversation.ConversationID]; ok {
+			conversation.PinnedMsgs = convert.MsgPinsDB2Pb(ps)
+		}
+	}
+}
+
 func (m *conversationServer) GetSortedConversationList(ctx context.Context, req *pbconversation.GetSortedConversationListReq) (resp *pbconversation.GetSortedConversationListResp, err error) {
 	log.ZDebug(ctx, "GetSortedConversationList", "seqs", req, "userID", req.UserID)
 	var conversationIDs []string
@@ -168,6 +197,7 @@ func (c *conversationServer) GetAllConversations(ctx context.Context, req *pbcon
 	}
 	resp := &pbconversation.GetAllConversationsResp{Conversations: []*pbconversation.Conversation{}}
 	resp.Conversations = convert.ConversationsDB2Pb(conversations)
+	c.attachPinnedMsgs(ctx, resp.Conversations)
 	return resp, nil
 }
 
@@ -178,6 +208,7 @@ func (c *conversationServer) GetConversations(ctx context.Context, req *pbconver
 	}
 	resp := &pbconversation.GetConversationsResp{Conversations: []*pbconversation.Conversation{}}
 	resp.Conversations = convert.ConversationsDB2Pb(conversations)
+	c.attachPinnedMsgs(ctx, resp.Conversations)
 	return resp, nil
 }
 
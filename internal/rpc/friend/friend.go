@@ -38,12 +38,16 @@ import (
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/utils"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/mgo"
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/open-im-server/v3/tools/live"
 )
 
 type friendServer struct {
@@ -53,6 +57,7 @@ type friendServer struct {
 	notificationSender    *notification.FriendNotificationSender
 	conversationRpcClient rpcclient.ConversationRpcClient
 	RegisterCenter        registry.SvcDiscoveryRegistry
+	sensitiveFilter       *live.SensitiveFieldFilter
 }
 
 func (s *friendServer) GetFriendsInfo(ctx context.Context, req *pbfriend.GetSpecifiedFriendsInfoReq) (*pbfriend.GetSpecifiedFriendsInfoResp, error) {
@@ -135,6 +140,18 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 		&msgRpcClient,
 		notification.WithRpcFunc(userRpcClient.GetUsersInfo),
 	)
+	normalizer, err := live.NewNormalizer(config.Config.SensitiveNormalize)
+	if err != nil {
+		return err
+	}
+	events, err := eventbus.NewPublisher(rdb)
+	if err != nil {
+		return err
+	}
+	sensitiveFilter, err := live.NewSensitiveFieldFilter(context.Background(), rdb, events, config.Config.SensitiveFields, normalizer, prommetrics.SetSensitiveWordNum)
+	if err != nil {
+		return err
+	}
 	// Register Friend server with refactored MongoDB and Redis integrations
 	pbfriend.RegisterFriendServer(server, &friendServer{
 		friendDatabase: controller.NewFriendDatabase(
@@ -151,6 +168,7 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 		notificationSender:    notificationSender,
 		RegisterCenter:        client,
 		conversationRpcClient: rpcclient.NewConversationRpcClient(client),
+		sensitiveFilter:       sensitiveFilter,
 	})
 
 	return nil
@@ -179,6 +197,10 @@ func (s *friendServer) ApplyToAddFriend(ctx context.Context, req *pbfriend.Apply
 	if in1 && in2 {
 		return nil, errs.ErrRelationshipAlready.Wrap()
 	}
+	hit := live.HitSensitiveMessage{From: req.FromUserID, Type: live.HitTypeFriendApply, Target: req.ToUserID}
+	if req.ReqMsg, err = s.sensitiveFilter.Filter(ctx, live.SensitiveFieldFriendReqMsg, req.ReqMsg, hit); err != nil {
+		return nil, err
+	}
 	if err = s.friendDatabase.AddFriendRequest(ctx, req.FromUserID, req.ToUserID, req.ReqMsg, req.Ex); err != nil {
 		return nil, err
 	}
@@ -304,6 +326,10 @@ func (s *friendServer) SetFriendRemark(ctx context.Context, req *pbfriend.SetFri
 	if err != nil {
 		return nil, err
 	}
+	hit := live.HitSensitiveMessage{From: req.OwnerUserID, Type: live.HitTypeFriendRemark, Target: req.FriendUserID}
+	if req.Remark, err = s.sensitiveFilter.Filter(ctx, live.SensitiveFieldFriendRemark, req.Remark, hit); err != nil {
+		return nil, err
+	}
 	if err := s.friendDatabase.UpdateRemark(ctx, req.OwnerUserID, req.FriendUserID, req.Remark); err != nil {
 		return nil, err
 	}
@@ -40,7 +40,11 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/open-im-server/v3/tools/live"
 
 	"github.com/OpenIMSDK/tools/mw/specialerror"
 
@@ -110,6 +114,18 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	gs.msgRpcClient = msgRpcClient
 	gs.FriendRpcClient = friendRpcClient
 	gs.msgCache = cache.NewMsgCacheModel(rdb)
+	normalizer, err := live.NewNormalizer(config.Config.SensitiveNormalize)
+	if err != nil {
+		return err
+	}
+	events, err := eventbus.NewPublisher(rdb)
+	if err != nil {
+		return err
+	}
+	gs.sensitiveFilter, err = live.NewSensitiveFieldFilter(context.Background(), rdb, events, config.Config.SensitiveFields, normalizer, prommetrics.SetSensitiveWordNum)
+	if err != nil {
+		return err
+	}
 	pbgroup.RegisterGroupServer(server, &gs)
 	return nil
 }
@@ -122,6 +138,7 @@ type groupServer struct {
 	conversationRpcClient rpcclient.ConversationRpcClient
 	msgRpcClient          rpcclient.MessageRpcClient
 	msgCache              cache.MsgModel
+	sensitiveFilter       *live.SensitiveFieldFilter
 }
 
 func (s *groupServer) NotificationUserInfoUpdate(ctx context.Context, req *pbgroup.NotificationUserInfoUpdateReq) (*pbgroup.NotificationUserInfoUpdateResp, error) {
@@ -250,6 +267,9 @@ func (s *groupServer) CreateGroup(ctx context.Context, req *pbgroup.CreateGroupR
 	if err := s.GenGroupID(ctx, &group.GroupID); err != nil {
 		return nil, err
 	}
+	if err := s.filterGroupFields(ctx, group.GroupID, &group.GroupName, &group.Notification, &group.Introduction); err != nil {
+		return nil, err
+	}
 	joinGroup := func(userID string, roleLevel int32) error {
 		groupMember := &relationtb.GroupMemberModel{
 			GroupID:        group.GroupID,
@@ -1116,6 +1136,10 @@ func (s *groupServer) SetGroupInfo(ctx context.Context, req *pbgroup.SetGroupInf
 	if err := CallbackBeforeSetGroupInfo(ctx, req); err != nil {
 		return nil, err
 	}
+	info := req.GroupInfoForSet
+	if err := s.filterGroupFields(ctx, info.GroupID, &info.GroupName, &info.Notification, &info.Introduction); err != nil {
+		return nil, err
+	}
 	group, err := s.db.TakeGroup(ctx, req.GroupInfoForSet.GroupID)
 	if err != nil {
 		return nil, err
@@ -1597,6 +1621,18 @@ func (s *groupServer) SetGroupMemberInfo(ctx context.Context, req *pbgroup.SetGr
 			return nil, errs.ErrArgs.Wrap("user not in group")
 		}
 	}
+	// 鉴权通过后再过滤，无权限的请求不推送命中
+	for _, member := range req.Members {
+		if member.Nickname == nil {
+			continue
+		}
+		hit := live.HitSensitiveMessage{From: opUserID, Type: live.HitTypeGroupMember, Target: member.GroupID}
+		value, err := s.sensitiveFilter.Filter(ctx, live.SensitiveFieldGroupMemberNickname, member.Nickname.Value, hit)
+		if err != nil {
+			return nil, err
+		}
+		member.Nickname.Value = value
+	}
 	for i := 0; i < len(req.Members); i++ {
 		if err := CallbackBeforeSetGroupMemberInfo(ctx, req.Members[i]); err != nil {
 			return nil, err
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package group
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/openimsdk/open-im-server/v3/tools/live"
+)
+
+// filterGroupFields 群名称、群公告、群介绍敏感词过滤，创建和修改群资料共用
+func (s *groupServer) filterGroupFields(ctx context.Context, groupID string, groupName, notification, introduction *string) error {
+	hit := live.HitSensitiveMessage{From: mcontext.GetOpUserID(ctx), Type: live.HitTypeGroupInfo, Target: groupID}
+	fields := []struct {
+		name  string
+		value *string
+	}{
+		{live.SensitiveFieldGroupName, groupName},
+		{live.SensitiveFieldGroupNotification, notification},
+		{live.SensitiveFieldGroupIntroduction, introduction},
+	}
+	for _, field := range fields {
+		value, err := s.sensitiveFilter.Filter(ctx, field.name, *field.value, hit)
+		if err != nil {
+			return err
+		}
+		*field.value = value
+	}
+	return nil
+}
@@ -204,3 +204,48 @@ func CallbackAfterRevokeMsg(ctx context.Context, req *pbchat.RevokeMsgReq) error
 	}
 	return nil
 }
+
+func CallbackBeforeModifyMsg(ctx context.Context, req *pbchat.ModifyMsgReq, msgData *sdkws.MsgData, oldContent string) error {
+	if !config.Config.Callback.CallbackBeforeModifyMsg.Enable {
+		return nil
+	}
+	callbackReq := &cbapi.CallbackBeforeModifyMsgReq{
+		CallbackCommand: cbapi.CallbackBeforeModifyMsgCommand,
+		ConversationID:  req.ConversationID,
+		Seq:             req.Seq,
+		UserID:          req.UserID,
+		SendID:          msgData.SendID,
+		ContentType:     msgData.ContentType,
+		OldContent:      oldContent,
+		Content:         string(msgData.Content),
+	}
+	resp := &cbapi.CallbackBeforeModifyMsgResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), callbackReq, resp, config.Config.Callback.CallbackBeforeModifyMsg); err != nil {
+		return err
+	}
+	if resp.Content != nil {
+		msgData.Content = []byte(*resp.Content)
+	}
+	return nil
+}
+
+func CallbackAfterModifyMsg(ctx context.Context, req *pbchat.ModifyMsgReq, msgData *sdkws.MsgData, modifyTime int64) error {
+	if !config.Config.Callback.CallbackAfterModifyMsg.Enable {
+		return nil
+	}
+	callbackReq := &cbapi.CallbackAfterModifyMsgReq{
+		CallbackCommand: cbapi.CallbackAfterModifyMsgCommand,
+		ConversationID:  req.ConversationID,
+		Seq:             req.Seq,
+		UserID:          req.UserID,
+		SendID:          msgData.SendID,
+		ContentType:     msgData.ContentType,
+		Content:         string(msgData.Content),
+		ModifyTime:      modifyTime,
+	}
+	resp := &cbapi.CallbackAfterModifyMsgResp{}
+	if err := http.CallBackPostReturn(ctx, cbURL(), callbackReq, resp, config.Config.Callback.CallbackAfterModifyMsg); err != nil {
+		return err
+	}
+	return nil
+}
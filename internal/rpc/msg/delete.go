@@ -82,6 +82,9 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 		if err := m.MsgDatabase.DeleteMsgsPhysicalBySeqs(ctx, req.ConversationID, req.Seqs); err != nil {
 			return nil, err
 		}
+		if err := m.searchDatabase.RemoveMsgs(ctx, req.ConversationID, req.Seqs); err != nil {
+			log.ZWarn(ctx, "RemoveMsgs failed", err, "conversationID", req.ConversationID, "seqs", req.Seqs)
+		}
 		conversations, err := m.Conversation.GetConversationsByConversationID(ctx, []string{req.ConversationID})
 		if err != nil {
 			return nil, err
@@ -99,6 +102,9 @@ func (m *msgServer) DeleteMsgs(ctx context.Context, req *msg.DeleteMsgsReq) (*ms
 		if err := m.MsgDatabase.DeleteUserMsgsBySeqs(ctx, req.UserID, req.ConversationID, req.Seqs); err != nil {
 			return nil, err
 		}
+		if err := m.searchDatabase.RemoveUserMsgs(ctx, req.UserID, req.ConversationID, req.Seqs); err != nil {
+			log.ZWarn(ctx, "RemoveUserMsgs failed", err, "conversationID", req.ConversationID, "seqs", req.Seqs)
+		}
 		if isSyncSelf {
 			tips := &sdkws.DeleteMsgsTips{UserID: req.UserID, ConversationID: req.ConversationID, Seqs: req.Seqs}
 			m.notificationSender.NotificationWithSessionType(ctx, req.UserID, req.UserID, constant.DeleteMsgsNotification, constant.SingleChatType, tips)
@@ -115,6 +121,9 @@ func (m *msgServer) DeleteMsgPhysicalBySeq(
 	if err != nil {
 		return nil, err
 	}
+	if err := m.searchDatabase.RemoveMsgs(ctx, req.ConversationID, req.Seqs); err != nil {
+		log.ZWarn(ctx, "RemoveMsgs failed", err, "conversationID", req.ConversationID, "seqs", req.Seqs)
+	}
 	return &msg.DeleteMsgPhysicalBySeqResp{}, nil
 }
 
@@ -138,6 +147,9 @@ func (m *msgServer) DeleteMsgPhysical(
 				err,
 			)
 		}
+		if err := m.searchDatabase.RemoveMsgsBySendTime(ctx, conversationID, req.Timestamp*1000); err != nil {
+			log.ZWarn(ctx, "RemoveMsgsBySendTime failed", err, "conversationID", conversationID)
+		}
 	}
 	return &msg.DeleteMsgPhysicalResp{}, nil
 }
@@ -169,6 +181,11 @@ func (m *msgServer) clearConversation(
 		if err := m.MsgDatabase.SetUserConversationsMinSeqs(ctx, userID, m.getMinSeqs(maxSeqs)); err != nil {
 			return err
 		}
+		for conversationID, maxSeq := range maxSeqs {
+			if err := m.searchDatabase.RemoveUserMsgsBySeq(ctx, userID, conversationID, maxSeq); err != nil {
+				log.ZWarn(ctx, "RemoveUserMsgsBySeq failed", err, "conversationID", conversationID)
+			}
+		}
 		// notification 2 self
 		if isSyncSelf {
 			tips := &sdkws.ClearConversationTips{UserID: userID, ConversationIDs: existConversationIDs}
@@ -185,6 +202,11 @@ func (m *msgServer) clearConversation(
 		if err := m.MsgDatabase.SetMinSeqs(ctx, m.getMinSeqs(maxSeqs)); err != nil {
 			return err
 		}
+		for conversationID, maxSeq := range maxSeqs {
+			if err := m.searchDatabase.RemoveMsgsBySeq(ctx, conversationID, maxSeq); err != nil {
+				log.ZWarn(ctx, "RemoveMsgsBySeq failed", err, "conversationID", conversationID)
+			}
+		}
 		for _, conversation := range existConversations {
 			tips := &sdkws.ClearConversationTips{UserID: userID, ConversationIDs: []string{conversation.ConversationID}}
 			m.notificationSender.NotificationWithSessionType(ctx, userID, m.conversationAndGetRecvID(conversation, userID), constant.ClearConversationNotification, conversation.ConversationType, tips)
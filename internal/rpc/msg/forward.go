@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/msgsearch"
+	"github.com/openimsdk/open-im-server/v3/sdk_struct"
+)
+
+// 合并转发的摘要条数
+const forwardAbstractNum = 4
+
+// forwardSummaries 无文本的消息类型在摘要中的显示
+var forwardSummaries = map[int32]string{
+	constant.Picture:  "[Picture]",
+	constant.Voice:    "[Voice]",
+	constant.Video:    "[Video]",
+	constant.File:     "[File]",
+	constant.Merger:   "[Chat History]",
+	constant.Card:     "[Card]",
+	constant.Location: "[Location]",
+	constant.Custom:   "[Custom]",
+	constant.Face:     "[Face]",
+}
+
+func forwardable(contentType int32) bool {
+	switch contentType {
+	case constant.Text, constant.Picture, constant.Voice, constant.Video, constant.File, constant.AtText,
+		constant.Merger, constant.Card, constant.Location, constant.Custom, constant.Quote, constant.Face,
+		constant.AdvancedText:
+		return true
+	}
+	return false
+}
+
+func checkForwardReq(req *msg.ForwardMsgsReq) error {
+	if !config.Config.MsgForward.Enable {
+		return errs.ErrNoPermission.Wrap("msg forward is disabled")
+	}
+	if req.UserID == "" || req.ConversationID == "" {
+		return errs.ErrArgs.Wrap("user_id or conversation_id is empty")
+	}
+	if len(req.Seqs) == 0 {
+		return errs.ErrArgs.Wrap("seqs is empty")
+	}
+	if len(req.Targets) == 0 {
+		return errs.ErrArgs.Wrap("targets is empty")
+	}
+	if max := config.Config.MsgForward.MaxMsgs; max > 0 && len(req.Seqs) > max {
+		return errs.ErrArgs.Wrap(fmt.Sprintf("forward up to %d msgs", max))
+	}
+	if max := config.Config.MsgForward.MaxTargets; max > 0 && len(req.Targets) > max {
+		return errs.ErrArgs.Wrap(fmt.Sprintf("forward to up to %d targets", max))
+	}
+	for _, target := range req.Targets {
+		switch target.SessionType {
+		case constant.SingleChatType:
+			if target.RecvID == "" {
+				return errs.ErrArgs.Wrap("target recv_id is empty")
+			}
+		case constant.SuperGroupChatType:
+			if target.GroupID == "" {
+				return errs.ErrArgs.Wrap("target group_id is empty")
+			}
+		default:
+			return errs.ErrArgs.Wrap("unsupported target session type")
+		}
+	}
+	return nil
+}
+
+// ForwardMsgs 转发会话中的消息，内容（包括对象存储的地址）原样复用；
+// 每个目标单独发送并返回结果，一个目标失败不影响其他目标
+func (m *msgServer) ForwardMsgs(ctx context.Context, req *msg.ForwardMsgsReq) (*msg.ForwardMsgsResp, error) {
+	if err := checkForwardReq(req); err != nil {
+		return nil, err
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	user, err := m.User.GetUserInfo(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	seqs := utils.Distinct(req.Seqs)
+	msgs, err := m.findUserMsgsBySeqs(ctx, user, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) != len(seqs) {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	for _, msgData := range msgs {
+		if msgData.ContentType == constant.MsgRevokeNotification {
+			return nil, errs.ErrMsgAlreadyRevoke.Wrap(fmt.Sprintf("msg %d already revoke", msgData.Seq))
+		}
+		if !forwardable(msgData.ContentType) {
+			return nil, errs.ErrArgs.Wrap(fmt.Sprintf("msg %d can not be forwarded", msgData.Seq))
+		}
+	}
+	if err := m.checkMsgMember(ctx, msgs[0], req.UserID); err != nil {
+		return nil, err
+	}
+	if req.IsMerge {
+		merged, err := mergeForwardMsgs(req.Title, msgs)
+		if err != nil {
+			return nil, err
+		}
+		msgs = []*sdkws.MsgData{merged}
+	}
+	resp := &msg.ForwardMsgsResp{Results: make([]*msg.ForwardResult, 0, len(req.Targets))}
+	for _, target := range req.Targets {
+		send := func(ctx context.Context, msgData *sdkws.MsgData) (*msg.SendMsgResp, error) {
+			return m.SendMsg(ctx, &msg.SendMsgReq{MsgData: newForwardMsg(ctx, user, target, msgData)})
+		}
+		resp.Results = append(resp.Results, forwardToTarget(ctx, target, msgs, send))
+	}
+	return resp, nil
+}
+
+// forwardToTarget 依次发送到 target，发送失败或消息未发出时停止并记录错误
+func forwardToTarget(ctx context.Context, target *msg.ForwardTarget, msgs []*sdkws.MsgData,
+	send func(ctx context.Context, msgData *sdkws.MsgData) (*msg.SendMsgResp, error)) *msg.ForwardResult {
+	result := &msg.ForwardResult{Target: target}
+	for _, msgData := range msgs {
+		sendResp, err := send(ctx, msgData)
+		if err == nil && sendResp == nil {
+			// 单聊接收方设置了不接收消息，消息未发出
+			err = errs.ErrNoPermission.Wrap("msg not delivered by the recv msg opt of the target")
+		}
+		if err != nil {
+			log.ZWarn(ctx, "forward msg failed", err, "target", target, "seq", msgData.Seq)
+			if codeErr, ok := errs.Unwrap(err).(errs.CodeError); ok {
+				result.ErrCode, result.ErrMsg = int32(codeErr.Code()), codeErr.Msg()
+			} else {
+				result.ErrCode, result.ErrMsg = errs.ServerInternalError, err.Error()
+			}
+			break
+		}
+		result.Msgs = append(result.Msgs, sendResp)
+	}
+	return result
+}
+
+// mergeForwardMsgs 合并为一条聊天记录消息，content 为客户端的 MergeElem
+func mergeForwardMsgs(title string, msgs []*sdkws.MsgData) (*sdkws.MsgData, error) {
+	elem := sdk_struct.MergeElem{
+		Title:        title,
+		MultiMessage: make([]*sdk_struct.MsgStruct, 0, len(msgs)),
+	}
+	for i, msgData := range msgs {
+		msgStruct, err := convert.MsgPb2SdkStruct(msgData)
+		if err != nil {
+			return nil, err
+		}
+		elem.MultiMessage = append(elem.MultiMessage, msgStruct)
+		if i < forwardAbstractNum {
+			elem.AbstractList = append(elem.AbstractList, msgData.SenderNickname+": "+forwardSummary(msgData))
+		}
+	}
+	return &sdkws.MsgData{
+		ContentType: constant.Merger,
+		Content:     []byte(utils.StructToJsonString(&elem)),
+	}, nil
+}
+
+func forwardSummary(msgData *sdkws.MsgData) string {
+	if summary, ok := forwardSummaries[msgData.ContentType]; ok {
+		return summary
+	}
+	return msgsearch.ExtractText(msgData.ContentType, msgData.Content)
+}
+
+// newForwardMsg 以转发者的身份发送，@、话题和 ex 等与源消息相关的信息不保留
+func newForwardMsg(ctx context.Context, user *sdkws.UserInfo, target *msg.ForwardTarget, source *sdkws.MsgData) *sdkws.MsgData {
+	return &sdkws.MsgData{
+		SendID:           user.UserID,
+		RecvID:           target.RecvID,
+		GroupID:          target.GroupID,
+		ClientMsgID:      utils.GetMsgID(user.UserID),
+		SenderPlatformID: int32(constant.PlatformNameToID(mcontext.GetOpUserPlatform(ctx))),
+		SenderNickname:   user.Nickname,
+		SenderFaceURL:    user.FaceURL,
+		SessionType:      target.SessionType,
+		MsgFrom:          constant.UserMsgType,
+		ContentType:      source.ContentType,
+		Content:          source.Content,
+		CreateTime:       utils.GetCurrentTimestampByMill(),
+		Options:          make(map[string]bool),
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/sdk_struct"
+)
+
+func TestCheckForwardReq(t *testing.T) {
+	forward := config.Config.MsgForward
+	defer func() { config.Config.MsgForward = forward }()
+	config.Config.MsgForward.Enable = true
+	config.Config.MsgForward.MaxMsgs = 2
+
+	req := &msg.ForwardMsgsReq{
+		UserID:         "u1",
+		ConversationID: "si_u1_u2",
+		Seqs:           []int64{1, 2},
+		Targets:        []*msg.ForwardTarget{{SessionType: constant.SuperGroupChatType, GroupID: "g1"}},
+	}
+	assert.NoError(t, checkForwardReq(req))
+
+	req.Seqs = []int64{1, 2, 3}
+	assert.True(t, errs.ErrArgs.Is(checkForwardReq(req)))
+
+	req.Seqs = []int64{1}
+	req.Targets = []*msg.ForwardTarget{{SessionType: constant.SingleChatType, GroupID: "g1"}}
+	assert.True(t, errs.ErrArgs.Is(checkForwardReq(req)))
+}
+
+func TestMergeForwardMsgs(t *testing.T) {
+	merged, err := mergeForwardMsgs("chat history", []*sdkws.MsgData{
+		{Seq: 1, SenderNickname: "a", ContentType: constant.Text, Content: []byte(`{"content":"hello"}`)},
+		{Seq: 2, SenderNickname: "b", ContentType: constant.Picture, Content: []byte(`{"sourcePicture":{"url":"https://s3/object/1"}}`)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(constant.Merger), merged.ContentType)
+
+	var elem sdk_struct.MergeElem
+	assert.NoError(t, json.Unmarshal(merged.Content, &elem))
+	assert.Equal(t, "chat history", elem.Title)
+	assert.Equal(t, []string{"a: hello", "b: [Picture]"}, elem.AbstractList)
+	assert.Len(t, elem.MultiMessage, 2)
+	assert.Equal(t, "hello", elem.MultiMessage[0].TextElem.Content)
+	assert.Equal(t, "https://s3/object/1", elem.MultiMessage[1].PictureElem.SourcePicture.Url)
+}
+
+func TestForwardToTarget(t *testing.T) {
+	msgs := []*sdkws.MsgData{{Seq: 1}, {Seq: 2}, {Seq: 3}}
+	target := &msg.ForwardTarget{SessionType: constant.SingleChatType, RecvID: "u2"}
+
+	var sent []int64
+	result := forwardToTarget(context.Background(), target, msgs, func(_ context.Context, msgData *sdkws.MsgData) (*msg.SendMsgResp, error) {
+		sent = append(sent, msgData.Seq)
+		return &msg.SendMsgResp{ServerMsgID: "s"}, nil
+	})
+	assert.Equal(t, []int64{1, 2, 3}, sent)
+	assert.Len(t, result.Msgs, 3)
+	assert.Zero(t, result.ErrCode)
+
+	// 接收方不接收消息时 SendMsg 返回空结果，记为未发出并停止
+	sent = nil
+	result = forwardToTarget(context.Background(), target, msgs, func(_ context.Context, msgData *sdkws.MsgData) (*msg.SendMsgResp, error) {
+		sent = append(sent, msgData.Seq)
+		if msgData.Seq == 2 {
+			return nil, nil
+		}
+		return &msg.SendMsgResp{ServerMsgID: "s"}, nil
+	})
+	assert.Equal(t, []int64{1, 2}, sent)
+	assert.Len(t, result.Msgs, 1)
+	assert.Equal(t, int32(errs.ErrNoPermission.Code()), result.ErrCode)
+
+	result = forwardToTarget(context.Background(), target, msgs, func(context.Context, *sdkws.MsgData) (*msg.SendMsgResp, error) {
+		return nil, errs.ErrArgs.Wrap("bad msg")
+	})
+	assert.Empty(t, result.Msgs)
+	assert.Equal(t, int32(errs.ErrArgs.Code()), result.ErrCode)
+}
@@ -16,17 +16,89 @@ package msg
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/msg"
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
+	"github.com/openimsdk/open-im-server/v3/tools/live"
+)
+
+// 拦截器名称，config msgInterceptors 按名称配置
+const (
+	InterceptorHasReadReceipt  = "hasReadReceipt"
+	InterceptorSensitiveFilter = "sensitiveFilter"
+	InterceptorBrushLimit      = "brushLimit"
 )
 
 type MessageInterceptorFunc func(ctx context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error)
 
+// MessageInterceptor applies Handler to the messages of SessionTypes and ContentTypes, empty matches all.
+type MessageInterceptor struct {
+	Name         string
+	SessionTypes []int32
+	ContentTypes []int32
+	Handler      MessageInterceptorFunc
+}
+
+func (i *MessageInterceptor) match(msgData *sdkws.MsgData) bool {
+	if len(i.SessionTypes) > 0 && !utils.IsContainInt32(msgData.SessionType, i.SessionTypes) {
+		return false
+	}
+	return len(i.ContentTypes) == 0 || utils.IsContainInt32(msgData.ContentType, i.ContentTypes)
+}
+
+// defaultMsgInterceptors is the chain used when none is configured.
+var defaultMsgInterceptors = []config.MsgInterceptor{
+	{Name: InterceptorHasReadReceipt, Enable: true, ContentTypes: []int32{constant.HasReadReceipt}},
+	{Name: InterceptorSensitiveFilter, Enable: true, ContentTypes: []int32{constant.Text, constant.AtText, constant.Quote}},
+	{Name: InterceptorBrushLimit, Enable: true},
+}
+
+func (m *msgServer) interceptorHandlers() map[string]MessageInterceptorFunc {
+	return map[string]MessageInterceptorFunc{
+		InterceptorHasReadReceipt:  MessageHasReadEnabled,
+		InterceptorSensitiveFilter: m.sensitiveFilter,
+		InterceptorBrushLimit:      m.brushLimit,
+	}
+}
+
+// initInterceptorHandlers builds the chain in the configured order, unknown names are rejected
+// so that a misspelled interceptor does not silently disable a check.
+func (m *msgServer) initInterceptorHandlers(confs []config.MsgInterceptor) error {
+	if len(confs) == 0 {
+		confs = defaultMsgInterceptors
+	}
+	handlers := m.interceptorHandlers()
+	for _, conf := range confs {
+		handler, ok := handlers[conf.Name]
+		if !ok {
+			return errs.ErrArgs.Wrap(fmt.Sprintf("unknown msg interceptor %s", conf.Name))
+		}
+		if !conf.Enable {
+			continue
+		}
+		m.addInterceptorHandler(&MessageInterceptor{
+			Name:         conf.Name,
+			SessionTypes: conf.SessionTypes,
+			ContentTypes: conf.ContentTypes,
+			Handler:      handler,
+		})
+	}
+	return nil
+}
+
 func MessageHasReadEnabled(_ context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error) {
 	switch {
 	case req.MsgData.ContentType == constant.HasReadReceipt && req.MsgData.SessionType == constant.SingleChatType:
@@ -42,3 +114,140 @@ func MessageHasReadEnabled(_ context.Context, req *msg.SendMsgReq) (*sdkws.MsgDa
 	}
 	return req.MsgData, nil
 }
+
+// sensitiveFilter 敏感词过滤 | 只验证文本，高权限账号和管理员不过滤
+func (m *msgServer) sensitiveFilter(ctx context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error) {
+	msgData := req.MsgData
+	auth, _ := utils.VerifyRights(msgData.Ex)
+	if auth != 0 || authverify.IsAppManagerUid(ctx) {
+		return msgData, nil
+	}
+	type Content1 struct {
+		Content string `json:"content"`
+	}
+	type Content2 struct {
+		Content string `json:"text"`
+	}
+	contentType := msgData.ContentType
+	var content string
+	if contentType == constant.Text {
+		var tmp Content1
+		_ = json.Unmarshal(msgData.Content, &tmp)
+		content = tmp.Content
+	} else {
+		var tmp Content2
+		_ = json.Unmarshal(msgData.Content, &tmp)
+		content = tmp.Content
+	}
+	if content == "" {
+		return msgData, nil
+	}
+	// 过滤配置随词库定期刷新，不在每条消息上读 redis
+	flag := m.sensitiveWords.Flag()
+	start := time.Now()
+	sentence, keywords, found := m.sensitiveWords.Filter(content)
+	prommetrics.SensitiveFilterDuration.Observe(time.Since(start).Seconds())
+	if !found {
+		return msgData, nil
+	}
+	// 推送命中队列
+	var hitMessage live.HitSensitiveMessage
+	hitMessage.From = msgData.SendID
+	hitMessage.Target = msgData.RecvID
+	hitMessage.Type = 0
+	if msgData.SessionType == constant.SuperGroupChatType {
+		hitMessage.Type = 1
+		hitMessage.Target = msgData.GroupID
+	}
+	hitMessage.DT = time.Now().Unix()
+	hitMessage.Content = content
+	hitMessage.IP = msgData.Ip
+	type sensitiveWord struct {
+		SensitiveWords []string `json:"sensitiveWords"`
+	}
+	extra, _ := json.Marshal(sensitiveWord{SensitiveWords: keywords})
+	hitMessage.Extra = string(extra)
+	if err := m.events.Publish(ctx, eventbus.EventSensitiveHit, msgData.SendID, hitMessage); err != nil {
+		log.ZWarn(ctx, "publish sensitive hit failed", err, "sendID", msgData.SendID)
+	}
+
+	// 处理结果
+	switch flag {
+	case 1, 2: // 直接返回 发送失败
+		return nil, errs.ErrMsgSensitiveWordFailed.Wrap("Cause by sensitive word.")
+	case 3: // 敏感词替换
+		var data []byte
+		if contentType == constant.Text {
+			data, _ = json.Marshal(Content1{Content: sentence})
+		} else {
+			data, _ = json.Marshal(Content2{Content: sentence})
+		}
+		msgData.Content = data
+		if msgData.OfflinePushInfo != nil {
+			msgData.OfflinePushInfo.Desc = sentence
+		}
+	}
+	return msgData, nil
+}
+
+// brushLimit 刷屏禁言限制 | 只限制用户消息，管理员和高权限账号不限制；群消息只限制普通群员，群禁言、个人禁言和非群成员交给 messageVerification
+func (m *msgServer) brushLimit(ctx context.Context, req *msg.SendMsgReq) (*sdkws.MsgData, error) {
+	msgData := req.MsgData
+	if msgData.MsgFrom != constant.UserMsgType {
+		return msgData, nil
+	}
+	var groupID string
+	switch msgData.SessionType {
+	case constant.SingleChatType:
+	case constant.SuperGroupChatType:
+		groupID = msgData.GroupID
+	default:
+		return msgData, nil
+	}
+	if utils.IsContain(msgData.SendID, config.Config.Manager.UserID) {
+		return msgData, nil
+	}
+	// 高权限：不限制
+	if auth, _ := utils.VerifyRights(msgData.Ex); auth > 0 {
+		return msgData, nil
+	}
+	if groupID != "" {
+		groupInfo, err := m.Group.GetGroupInfoCache(ctx, groupID)
+		if err != nil {
+			return nil, err
+		}
+		if groupInfo.GroupType == constant.SuperGroup || groupInfo.Status != constant.GroupOk {
+			return msgData, nil
+		}
+		groupMemberInfo, err := m.Group.GetGroupMemberCache(ctx, groupID, msgData.SendID)
+		if err != nil {
+			if err == errs.ErrRecordNotFound {
+				return msgData, nil
+			}
+			return nil, err
+		}
+		if groupMemberInfo.RoleLevel != constant.GroupOrdinaryUsers || groupMemberInfo.MuteEndTime >= time.Now().UnixMilli() {
+			return msgData, nil
+		}
+	}
+	result, err := m.brushLimiter.Check(ctx, msgData.SendID, groupID, msgData.RecvID)
+	if err != nil {
+		log.ZWarn(ctx, "brush limit check failed", err, "sendID", msgData.SendID)
+		return msgData, nil
+	}
+	if result.Allowed {
+		return msgData, nil
+	}
+	// 群范围的禁言以系统管理员(im-admin)身份写入群成员 MuteEndTime 并通知群成员
+	if result.Scope == live.BrushScopeGroup && result.MuteSeconds > 0 {
+		if len(config.Config.IMAdmin.UserID) == 0 {
+			log.ZWarn(ctx, "brush limit group mute skipped, im-admin not configured", nil, "groupID", groupID, "userID", msgData.SendID)
+		} else {
+			systemCtx := mcontext.WithOpUserIDContext(ctx, config.Config.IMAdmin.UserID[0])
+			if err := m.Group.MuteGroupMember(systemCtx, groupID, msgData.SendID, uint32(result.MuteSeconds)); err != nil {
+				log.ZWarn(ctx, "MuteGroupMember", err, "groupID", groupID, "userID", msgData.SendID)
+			}
+		}
+	}
+	return nil, errs.GetSendMsgLimitErr(live.BrushLimitError(result.RetryAfter))
+}
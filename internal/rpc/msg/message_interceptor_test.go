@@ -0,0 +1,53 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestInitInterceptorHandlers(t *testing.T) {
+	m := &msgServer{}
+	assert.NoError(t, m.initInterceptorHandlers(nil))
+	var names []string
+	for _, interceptor := range m.Handlers {
+		names = append(names, interceptor.Name)
+	}
+	assert.Equal(t, []string{InterceptorHasReadReceipt, InterceptorSensitiveFilter, InterceptorBrushLimit}, names)
+
+	m = &msgServer{}
+	assert.NoError(t, m.initInterceptorHandlers([]config.MsgInterceptor{
+		{Name: InterceptorBrushLimit, Enable: false},
+		{Name: InterceptorHasReadReceipt, Enable: true, SessionTypes: []int32{constant.SingleChatType}},
+	}))
+	assert.Len(t, m.Handlers, 1)
+
+	defer func(enable bool) { config.Config.SingleMessageHasReadReceiptEnable = enable }(config.Config.SingleMessageHasReadReceiptEnable)
+	config.Config.SingleMessageHasReadReceiptEnable = false
+	req := &msg.SendMsgReq{MsgData: &sdkws.MsgData{SessionType: constant.SingleChatType, ContentType: constant.HasReadReceipt}}
+	assert.Error(t, m.execInterceptorHandler(context.Background(), req))
+	req.MsgData.SessionType = constant.SuperGroupChatType
+	assert.NoError(t, m.execInterceptorHandler(context.Background(), req))
+
+	assert.Error(t, (&msgServer{}).initInterceptorHandlers([]config.MsgInterceptor{{Name: "unknown", Enable: true}}))
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+)
+
+// ModifyMsg 修改消息 | 发送者在时间窗口内可修改自己的消息，管理员不受限制，修改前的版本记录在消息上
+func (m *msgServer) ModifyMsg(ctx context.Context, req *msg.ModifyMsgReq) (*msg.ModifyMsgResp, error) {
+	if !config.Config.MsgModify.Enable {
+		return nil, errs.ErrNoPermission.Wrap("msg modify is disabled")
+	}
+	if req.UserID == "" {
+		return nil, errs.ErrArgs.Wrap("user_id is empty")
+	}
+	if req.ConversationID == "" {
+		return nil, errs.ErrArgs.Wrap("conversation_id is empty")
+	}
+	if req.Seq <= 0 {
+		return nil, errs.ErrArgs.Wrap("seq is invalid")
+	}
+	if req.Content == "" {
+		return nil, errs.ErrArgs.Wrap("content is empty")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	source, err := m.getMsgBySeq(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	adminAuth := authverify.IsAppManagerUid(ctx)
+	if err := checkModifyMsg(source, req.UserID, adminAuth, time.Now().UnixMilli()); err != nil {
+		return nil, err
+	}
+	// 群消息的发送者需要仍在群内
+	if !adminAuth {
+		if err := m.checkMsgMember(ctx, source, req.UserID); err != nil {
+			return nil, err
+		}
+	}
+
+	// 修改后的内容按配置的拦截器链重新检查
+	sendReq := &msg.SendMsgReq{MsgData: proto.Clone(source).(*sdkws.MsgData)}
+	sendReq.MsgData.Content = []byte(req.Content)
+	if err := m.execInterceptorHandler(ctx, sendReq); err != nil {
+		return nil, err
+	}
+	msgData := sendReq.MsgData
+	if err := CallbackBeforeModifyMsg(ctx, req, msgData, string(source.Content)); err != nil {
+		return nil, err
+	}
+	now := time.Now().UnixMilli()
+	err = m.MsgDatabase.ModifyMsg(ctx, req.ConversationID, msgData, &unrelationtb.MsgEditModel{
+		UserID:      req.UserID,
+		ContentType: source.ContentType,
+		Content:     string(source.Content),
+		Ex:          source.Ex,
+		Time:        now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 推送至es队列
+	if err := m.events.Publish(ctx, eventbus.EventMsgIndex, req.ConversationID, &eventbus.MsgIndexPayload{
+		ConversationID: req.ConversationID,
+		Msgs:           []*sdkws.MsgData{msgData},
+	}); err != nil {
+		log.ZWarn(ctx, "publish msg index failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
+	if config.Config.MsgSearch.Enable {
+		if err := m.searchDatabase.ReindexMsg(ctx, req.ConversationID, msgData); err != nil {
+			log.ZWarn(ctx, "reindex modified msg failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+		}
+	}
+
+	tips := sdkws.MsgModifyTips{
+		ModifierUserID: req.UserID,
+		ClientMsgID:    msgData.ClientMsgID,
+		ModifyTime:     now,
+		SessionType:    msgData.SessionType,
+		Seq:            req.Seq,
+		ConversationID: req.ConversationID,
+		IsAdminModify:  req.UserID != msgData.SendID,
+		ContentType:    msgData.ContentType,
+		Content:        string(msgData.Content),
+	}
+	recvID := msgData.RecvID
+	if msgData.SessionType == constant.SuperGroupChatType {
+		recvID = msgData.GroupID
+	}
+	if err := m.notificationSender.NotificationWithSessionType(ctx, msgData.SendID, recvID, constant.MsgModifyNotification, msgData.SessionType, &tips); err != nil {
+		return nil, err
+	}
+	if err := CallbackAfterModifyMsg(ctx, req, msgData, now); err != nil {
+		return nil, err
+	}
+	return &msg.ModifyMsgResp{Content: string(msgData.Content), ModifyTime: now}, nil
+}
+
+// checkModifyMsg 校验消息类型可修改，非管理员只能在时间窗口内修改自己的消息
+func checkModifyMsg(source *sdkws.MsgData, userID string, adminAuth bool, now int64) error {
+	if !utils.Contain(source.ContentType, config.Config.MsgModify.ContentTypes...) {
+		return errs.ErrArgs.Wrap("msg content type can not be modified")
+	}
+	if adminAuth {
+		return nil
+	}
+	if source.SendID != userID {
+		return errs.ErrNoPermission.Wrap("only the sender can modify the msg")
+	}
+	if window := config.Config.MsgModify.TimeWindow; window > 0 && now-source.SendTime > window*1000 {
+		return errs.ErrNoPermission.Wrap("msg modify time window expired")
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestCheckModifyMsg(t *testing.T) {
+	modify := config.Config.MsgModify
+	defer func() { config.Config.MsgModify = modify }()
+	config.Config.MsgModify.TimeWindow = 60
+	config.Config.MsgModify.ContentTypes = []int32{constant.Text}
+
+	now := time.Now().UnixMilli()
+	source := &sdkws.MsgData{SendID: "u1", ContentType: constant.Text, SendTime: now - 30*1000}
+	assert.NoError(t, checkModifyMsg(source, "u1", false, now))
+	assert.True(t, errs.ErrNoPermission.Is(checkModifyMsg(source, "u2", false, now)))
+	assert.NoError(t, checkModifyMsg(source, "admin", true, now))
+
+	// 超过时间窗口只有管理员可以修改
+	source.SendTime = now - 90*1000
+	assert.True(t, errs.ErrNoPermission.Is(checkModifyMsg(source, "u1", false, now)))
+	assert.NoError(t, checkModifyMsg(source, "admin", true, now))
+	config.Config.MsgModify.TimeWindow = 0
+	assert.NoError(t, checkModifyMsg(source, "u1", false, now))
+
+	source.ContentType = constant.Picture
+	assert.True(t, errs.ErrArgs.Is(checkModifyMsg(source, "admin", true, now)))
+}
@@ -19,7 +19,10 @@ import (
 
 	"github.com/OpenIMSDK/protocol/constant"
 	pbmsg "github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/mcontext"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 )
 
 func (m *msgServer) SetSendMsgStatus(
@@ -48,3 +51,38 @@ func (m *msgServer) GetSendMsgStatus(
 	resp.Status = status
 	return resp, nil
 }
+
+// maxDeliveryStateSeqs 单次查询投递状态的消息数上限.
+const maxDeliveryStateSeqs = 100
+
+// GetMsgDeliveryStates 获取消息的各接收者投递状态, 只有会话成员和管理员可以查询.
+func (m *msgServer) GetMsgDeliveryStates(
+	ctx context.Context,
+	req *pbmsg.GetMsgDeliveryStatesReq,
+) (*pbmsg.GetMsgDeliveryStatesResp, error) {
+	if len(req.Seqs) > maxDeliveryStateSeqs {
+		return nil, errs.ErrArgs.Wrap("too many seqs")
+	}
+	if !authverify.IsAppManagerUid(ctx) {
+		if _, err := m.Conversation.GetConversation(ctx, mcontext.GetOpUserID(ctx), req.ConversationID); err != nil {
+			return nil, err
+		}
+	}
+	states, err := m.MsgDatabase.GetMsgDeliveryStates(ctx, req.ConversationID, req.Seqs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &pbmsg.GetMsgDeliveryStatesResp{}
+	for _, seq := range req.Seqs {
+		state := &pbmsg.MsgDeliveryState{Seq: seq}
+		for _, v := range states[seq] {
+			state.Recipients = append(state.Recipients, &pbmsg.RecipientDeliveryState{
+				UserID:     v.UserID,
+				State:      v.State,
+				UpdateTime: v.UpdateTime,
+			})
+		}
+		resp.States = append(resp.States, state)
+	}
+	return resp, nil
+}
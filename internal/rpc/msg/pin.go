@@ -0,0 +1,212 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/convert"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func checkPinReq(conversationID string, seq int64, userID string) error {
+	if !config.Config.MsgPin.Enable {
+		return errs.ErrNoPermission.Wrap("msg pin is disabled")
+	}
+	if userID == "" {
+		return errs.ErrArgs.Wrap("user_id is empty")
+	}
+	if conversationID == "" {
+		return errs.ErrArgs.Wrap("conversation_id is empty")
+	}
+	if seq <= 0 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	return nil
+}
+
+// checkPinPermission 群聊只有群主和管理员可以置顶，单聊双方都可以，管理员不受限制
+func (m *msgServer) checkPinPermission(ctx context.Context, msgData *sdkws.MsgData, userID string) error {
+	if msgData.SessionType != constant.SuperGroupChatType {
+		return m.checkMsgMember(ctx, msgData, userID)
+	}
+	if authverify.IsAppManagerUid(ctx) {
+		return nil
+	}
+	member, err := m.Group.GetGroupMemberCache(ctx, msgData.GroupID, userID)
+	if err != nil {
+		if errs.ErrRecordNotFound.Is(err) {
+			return errs.ErrNotInGroupYet.Wrap()
+		}
+		return err
+	}
+	if member.RoleLevel != constant.GroupOwner && member.RoleLevel != constant.GroupAdmin {
+		return errs.ErrNoPermission.Wrap("only the group owner or admin can pin msgs")
+	}
+	return nil
+}
+
+func (m *msgServer) PinMsg(ctx context.Context, req *msg.PinMsgReq) (*msg.PinMsgResp, error) {
+	if err := checkPinReq(req.ConversationID, req.Seq, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	msgData, err := m.getMsgBySeq(ctx, req.UserID, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if msgData.ContentType >= constant.NotificationBegin {
+		return nil, errs.ErrArgs.Wrap("notification can not be pinned")
+	}
+	if err := m.checkPinPermission(ctx, msgData, req.UserID); err != nil {
+		return nil, err
+	}
+	pins, err := m.pinDatabase.GetPins(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	for _, pin := range pins {
+		if pin.Seq == req.Seq {
+			return &msg.PinMsgResp{}, nil
+		}
+	}
+	// 缓存中已超限时直接拒绝，写入时再按数据库校验
+	if max := config.Config.MsgPin.MaxPerConversation; max > 0 && len(pins) >= max {
+		return nil, errs.ErrNoPermission.Wrap("too many pinned msgs in the conversation")
+	}
+	pinned, err := m.pinDatabase.Pin(ctx, &relation.MsgPinModel{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		PinUserID:      req.UserID,
+		PinTime:        time.Now(),
+	}, config.Config.MsgPin.MaxPerConversation)
+	if err != nil {
+		return nil, err
+	}
+	if pinned {
+		m.pinNotification(ctx, req.UserID, req.ConversationID, true, msgData)
+	}
+	return &msg.PinMsgResp{}, nil
+}
+
+func (m *msgServer) UnpinMsg(ctx context.Context, req *msg.UnpinMsgReq) (*msg.UnpinMsgResp, error) {
+	if err := checkPinReq(req.ConversationID, req.Seq, req.UserID); err != nil {
+		return nil, err
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	// 已撤回的消息也可以取消置顶
+	msgs, err := m.findMsgsBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.Seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if err := m.checkPinPermission(ctx, msgs[0], req.UserID); err != nil {
+		return nil, err
+	}
+	unpinned, err := m.pinDatabase.Unpin(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	if unpinned {
+		m.pinNotification(ctx, req.UserID, req.ConversationID, false, msgs[0])
+	}
+	return &msg.UnpinMsgResp{}, nil
+}
+
+// GetPinnedMsgs 获取会话的置顶消息，按置顶时间倒序
+func (m *msgServer) GetPinnedMsgs(ctx context.Context, req *msg.GetPinnedMsgsReq) (*msg.GetPinnedMsgsResp, error) {
+	if !config.Config.MsgPin.Enable {
+		return nil, errs.ErrNoPermission.Wrap("msg pin is disabled")
+	}
+	if req.UserID == "" || req.ConversationID == "" {
+		return nil, errs.ErrArgs.Wrap("user_id or conversation_id is empty")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	pins, err := m.pinDatabase.GetPins(ctx, req.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	if len(pins) == 0 {
+		return &msg.GetPinnedMsgsResp{}, nil
+	}
+	seqs := make([]int64, 0, len(pins))
+	for _, pin := range pins {
+		seqs = append(seqs, pin.Seq)
+	}
+	msgs, err := m.findMsgsBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return &msg.GetPinnedMsgsResp{}, nil
+	}
+	if err := m.checkMsgMember(ctx, msgs[0], req.UserID); err != nil {
+		return nil, err
+	}
+	msgMap := make(map[int64]*sdkws.MsgData, len(msgs))
+	for _, msgData := range msgs {
+		msgMap[msgData.Seq] = msgData
+	}
+	pinnedMsgs := convert.MsgPinsDB2Pb(pins)
+	for _, pinnedMsg := range pinnedMsgs {
+		pinnedMsg.MsgData = msgMap[pinnedMsg.Seq]
+	}
+	return &msg.GetPinnedMsgsResp{PinnedMsgs: pinnedMsgs}, nil
+}
+
+// pinNotification 通知会话成员置顶变更，单聊由操作者发给对方，群聊发给群
+func (m *msgServer) pinNotification(ctx context.Context, userID, conversationID string, isPin bool, msgData *sdkws.MsgData) {
+	pins, err := m.pinDatabase.GetPins(ctx, conversationID)
+	if err != nil {
+		log.ZWarn(ctx, "GetPins failed", err, "conversationID", conversationID)
+	}
+	tips := sdkws.MsgPinTips{
+		UserID:         userID,
+		ClientMsgID:    msgData.ClientMsgID,
+		SessionType:    msgData.SessionType,
+		Seq:            msgData.Seq,
+		ConversationID: conversationID,
+		IsPin:          isPin,
+		PinnedMsgs:     convert.MsgPinsDB2Pb(pins),
+		Time:           time.Now().UnixMilli(),
+	}
+	recvID := msgData.GroupID
+	if msgData.SessionType == constant.SingleChatType {
+		recvID = msgData.RecvID
+		if recvID == userID {
+			recvID = msgData.SendID
+		}
+	}
+	if err := m.notificationSender.NotificationWithSessionType(ctx, userID, recvID, constant.MsgPinNotification, msgData.SessionType, &tips); err != nil {
+		log.ZWarn(ctx, "pin notification failed", err, "conversationID", conversationID, "seq", msgData.Seq)
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+const maxReactionLen = 32
+
+func checkReactionReq(conversationID string, seq int64, userID, reaction string) error {
+	if reaction == "" || utf8.RuneCountInString(reaction) > maxReactionLen {
+		return errs.ErrArgs.Wrap("reaction is invalid")
+	}
+	return checkReactionMsgReq(conversationID, seq, userID)
+}
+
+func checkReactionMsgReq(conversationID string, seq int64, userID string) error {
+	if userID == "" {
+		return errs.ErrArgs.Wrap("user_id is empty")
+	}
+	if conversationID == "" {
+		return errs.ErrArgs.Wrap("conversation_id is empty")
+	}
+	if seq <= 0 {
+		return errs.ErrArgs.Wrap("seq is invalid")
+	}
+	return nil
+}
+
+// getReactionMsg 获取被回应的消息，并校验用户是会话成员
+func (m *msgServer) getReactionMsg(ctx context.Context, conversationID string, seq int64, userID string) (*sdkws.MsgData, error) {
+	if !config.Config.MsgReaction.Enable {
+		return nil, errs.ErrNoPermission.Wrap("msg reaction is disabled")
+	}
+	if err := authverify.CheckAccessV3(ctx, userID); err != nil {
+		return nil, err
+	}
+	msgData, err := m.getMsgBySeq(ctx, userID, conversationID, seq)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkMsgMember(ctx, msgData, userID); err != nil {
+		return nil, err
+	}
+	return msgData, nil
+}
+
+func (m *msgServer) getMsgReactions(ctx context.Context, conversationID string, seq int64, userID string) ([]*sdkws.MsgReaction, error) {
+	reactions, err := m.reactionDatabase.GetMsgsReactions(ctx, conversationID, []int64{seq}, userID)
+	if err != nil {
+		return nil, err
+	}
+	return reactions[seq], nil
+}
+
+func (m *msgServer) AddReaction(ctx context.Context, req *msg.AddReactionReq) (*msg.AddReactionResp, error) {
+	if err := checkReactionReq(req.ConversationID, req.Seq, req.UserID, req.Reaction); err != nil {
+		return nil, err
+	}
+	msgData, err := m.getReactionMsg(ctx, req.ConversationID, req.Seq, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	counts, err := m.reactionDatabase.GetReactionCounts(ctx, req.ConversationID, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	// 缓存中已超限时直接拒绝，写入时再按数据库校验
+	exist := utils.IndexOf(req.Reaction, utils.Slice(counts, func(c *relation.MsgReactionCountModel) string { return c.Reaction })...) >= 0
+	if max := config.Config.MsgReaction.MaxPerMsg; max > 0 && !exist && len(counts) >= max {
+		return nil, errs.ErrNoPermission.Wrap("too many reactions on the msg")
+	}
+	added, err := m.reactionDatabase.AddReaction(ctx, &relation.MsgReactionModel{
+		ConversationID: req.ConversationID,
+		Seq:            req.Seq,
+		UserID:         req.UserID,
+		Reaction:       req.Reaction,
+		CreateTime:     time.Now(),
+	}, config.Config.MsgReaction.MaxPerMsg, config.Config.MsgReaction.MaxPerUser)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := m.getMsgReactions(ctx, req.ConversationID, req.Seq, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if added {
+		m.reactionNotification(ctx, req.UserID, req.ConversationID, req.Reaction, true, msgData, reactions)
+	}
+	return &msg.AddReactionResp{Reactions: reactions}, nil
+}
+
+func (m *msgServer) RemoveReaction(ctx context.Context, req *msg.RemoveReactionReq) (*msg.RemoveReactionResp, error) {
+	if err := checkReactionReq(req.ConversationID, req.Seq, req.UserID, req.Reaction); err != nil {
+		return nil, err
+	}
+	msgData, err := m.getReactionMsg(ctx, req.ConversationID, req.Seq, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	removed, err := m.reactionDatabase.RemoveReaction(ctx, req.ConversationID, req.Seq, req.UserID, req.Reaction)
+	if err != nil {
+		return nil, err
+	}
+	reactions, err := m.getMsgReactions(ctx, req.ConversationID, req.Seq, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if removed {
+		m.reactionNotification(ctx, req.UserID, req.ConversationID, req.Reaction, false, msgData, reactions)
+	}
+	return &msg.RemoveReactionResp{Reactions: reactions}, nil
+}
+
+// GetReactions 获取消息的回应数，并分页获取回应的用户，reaction 为空时获取所有回应的用户
+func (m *msgServer) GetReactions(ctx context.Context, req *msg.GetReactionsReq) (*msg.GetReactionsResp, error) {
+	if err := checkReactionMsgReq(req.ConversationID, req.Seq, req.UserID); err != nil {
+		return nil, err
+	}
+	if _, err := m.getReactionMsg(ctx, req.ConversationID, req.Seq, req.UserID); err != nil {
+		return nil, err
+	}
+	reactions, err := m.getMsgReactions(ctx, req.ConversationID, req.Seq, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	total, users, err := m.reactionDatabase.FindReactions(ctx, req.ConversationID, req.Seq, req.Reaction, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &msg.GetReactionsResp{
+		Reactions: reactions,
+		Total:     total,
+		Users: utils.Slice(users, func(e *relation.MsgReactionModel) *msg.ReactionUser {
+			return &msg.ReactionUser{UserID: e.UserID, Reaction: e.Reaction, CreateTime: e.CreateTime.UnixMilli()}
+		}),
+	}, nil
+}
+
+// reactionNotification 通知会话成员回应变更，单聊由回应者发给对方，群聊发给群
+func (m *msgServer) reactionNotification(ctx context.Context, userID, conversationID, reaction string, isAdd bool, msgData *sdkws.MsgData, reactions []*sdkws.MsgReaction) {
+	// 通知中的 isReacted 对接收者无意义
+	counts := make([]*sdkws.MsgReaction, 0, len(reactions))
+	for _, r := range reactions {
+		counts = append(counts, &sdkws.MsgReaction{Reaction: r.Reaction, Count: r.Count})
+	}
+	tips := sdkws.MsgReactionTips{
+		UserID:         userID,
+		ClientMsgID:    msgData.ClientMsgID,
+		SessionType:    msgData.SessionType,
+		Seq:            msgData.Seq,
+		ConversationID: conversationID,
+		Reaction:       reaction,
+		IsAdd:          isAdd,
+		Reactions:      counts,
+		Time:           time.Now().UnixMilli(),
+	}
+	recvID := msgData.GroupID
+	if msgData.SessionType == constant.SingleChatType {
+		recvID = msgData.RecvID
+		if recvID == userID {
+			recvID = msgData.SendID
+		}
+	}
+	if err := m.notificationSender.NotificationWithSessionType(ctx, userID, recvID, constant.MsgReactionNotification, msgData.SessionType, &tips); err != nil {
+		log.ZWarn(ctx, "reaction notification failed", err, "conversationID", conversationID, "seq", msgData.Seq)
+	}
+}
+
+// attachReactions 拉取消息时附带回应数，失败不影响拉取
+func (m *msgServer) attachReactions(ctx context.Context, conversationID, userID string, msgs []*sdkws.MsgData) {
+	if !config.Config.MsgReaction.Enable || len(msgs) == 0 {
+		return
+	}
+	seqs := make([]int64, 0, len(msgs))
+	for _, msgData := range msgs {
+		if msgData != nil && msgData.Seq > 0 {
+			seqs = append(seqs, msgData.Seq)
+		}
+	}
+	reactions, err := m.reactionDatabase.GetMsgsReactions(ctx, conversationID, seqs, userID)
+	if err != nil {
+		log.ZWarn(ctx, "GetMsgsReactions failed", err, "conversationID", conversationID)
+		return
+	}
+	for _, msgData := range msgs {
+		if msgData != nil {
+			msgData.Reactions = reactions[msgData.Seq]
+		}
+	}
+}
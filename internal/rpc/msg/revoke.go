@@ -32,6 +32,7 @@ import (
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	unrelationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
 )
 
 func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.RevokeMsgResp, error) {
@@ -131,10 +132,19 @@ func (m *msgServer) RevokeMsg(ctx context.Context, req *msg.RevokeMsgReq) (*msg.
 	if err != nil {
 		return nil, err
 	}
+	// 撤回的消息取消置顶，客户端收到撤回通知后自行移除
+	if _, err := m.pinDatabase.Unpin(ctx, req.ConversationID, req.Seq); err != nil {
+		log.ZWarn(ctx, "unpin revoked msg failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
+	if err := m.searchDatabase.RemoveMsgs(ctx, req.ConversationID, []int64{req.Seq}); err != nil {
+		log.ZWarn(ctx, "remove revoked msg from search failed", err, "conversationID", req.ConversationID, "seq", req.Seq)
+	}
 
 	// 推送至es队列
 	_id := fmt.Sprintf("%s%d", msgs[0].ServerMsgID, req.Seq)
-	_ = m.MsgDatabase.RevokeMsgToEsMQ(ctx, _id)
+	if err := m.events.Publish(ctx, eventbus.EventMsgRevoke, req.ConversationID, &eventbus.MsgRevokePayload{ID: _id}); err != nil {
+		log.ZWarn(ctx, "publish msg revoke failed", err, "id", _id)
+	}
 
 	revokerUserID := mcontext.GetOpUserID(ctx)
 	tips := sdkws.RevokeMsgTips{
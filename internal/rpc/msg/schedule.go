@@ -0,0 +1,180 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func checkScheduleTime(scheduleTime int64) error {
+	now := time.Now().UnixMilli()
+	if scheduleTime <= now {
+		return errs.ErrArgs.Wrap("schedule time must be in the future")
+	}
+	if maxAhead := config.Config.MsgSchedule.MaxAhead; maxAhead > 0 && scheduleTime > now+maxAhead*1000 {
+		return errs.ErrArgs.Wrap("schedule time is too far in the future")
+	}
+	return nil
+}
+
+// scheduleMsg 保存定时消息，到时由定时任务按正常流程发送，拦截器和发送校验在发送时执行
+func (m *msgServer) scheduleMsg(ctx context.Context, req *msg.SendMsgReq) (*msg.SendMsgResp, error) {
+	if !config.Config.MsgSchedule.Enable {
+		return nil, errs.ErrNoPermission.Wrap("msg schedule is disabled")
+	}
+	msgData := req.MsgData
+	switch msgData.SessionType {
+	case constant.SingleChatType, constant.SuperGroupChatType:
+	default:
+		return nil, errs.ErrArgs.Wrap("only chat msgs can be scheduled")
+	}
+	if msgData.SendID == "" {
+		return nil, errs.ErrArgs.Wrap("send_id is empty")
+	}
+	if err := authverify.CheckAccessV3(ctx, msgData.SendID); err != nil {
+		return nil, err
+	}
+	if err := checkScheduleTime(req.ScheduleTime); err != nil {
+		return nil, err
+	}
+	if maxPending := config.Config.MsgSchedule.MaxPending; maxPending > 0 {
+		count, err := m.scheduleDatabase.CountPending(ctx, msgData.SendID)
+		if err != nil {
+			return nil, err
+		}
+		if count >= maxPending {
+			return nil, errs.ErrNoPermission.Wrap("too many scheduled msgs")
+		}
+	}
+	if msgData.ClientMsgID == "" {
+		msgData.ClientMsgID = GetMsgID(msgData.SendID)
+	}
+	// 发送时间在发送时设置
+	msgData.SendTime = 0
+	data, err := proto.Marshal(msgData)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	now := time.Now()
+	schedule := &relation.MsgScheduleModel{
+		ScheduleID:   GetMsgID(msgData.SendID),
+		SendID:       msgData.SendID,
+		Msg:          data,
+		ScheduleTime: time.UnixMilli(req.ScheduleTime),
+		Status:       relation.MsgSchedulePending,
+		CreateTime:   now,
+		UpdateTime:   now,
+	}
+	if err := m.scheduleDatabase.Create(ctx, schedule); err != nil {
+		return nil, err
+	}
+	return &msg.SendMsgResp{
+		ClientMsgID: msgData.ClientMsgID,
+		SendTime:    req.ScheduleTime,
+		ScheduleID:  schedule.ScheduleID,
+	}, nil
+}
+
+// takeSchedule 获取 sendID 的定时消息
+func (m *msgServer) takeSchedule(ctx context.Context, sendID, scheduleID string) (*relation.MsgScheduleModel, error) {
+	if sendID == "" || scheduleID == "" {
+		return nil, errs.ErrArgs.Wrap("send_id or schedule_id is empty")
+	}
+	if err := authverify.CheckAccessV3(ctx, sendID); err != nil {
+		return nil, err
+	}
+	schedule, err := m.scheduleDatabase.Take(ctx, scheduleID)
+	if err != nil {
+		if IsNotFound(err) {
+			return nil, errs.ErrRecordNotFound.Wrap("scheduled msg not found")
+		}
+		return nil, err
+	}
+	if schedule.SendID != sendID {
+		return nil, errs.ErrRecordNotFound.Wrap("scheduled msg not found")
+	}
+	return schedule, nil
+}
+
+func (m *msgServer) GetScheduledMsgs(ctx context.Context, req *msg.GetScheduledMsgsReq) (*msg.GetScheduledMsgsResp, error) {
+	if req.SendID == "" {
+		return nil, errs.ErrArgs.Wrap("send_id is empty")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.SendID); err != nil {
+		return nil, err
+	}
+	total, schedules, err := m.scheduleDatabase.FindBySendID(ctx, req.SendID, req.Status, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]*msg.ScheduledMsg, 0, len(schedules))
+	for _, schedule := range schedules {
+		var msgData sdkws.MsgData
+		if err := proto.Unmarshal(schedule.Msg, &msgData); err != nil {
+			return nil, errs.Wrap(err)
+		}
+		msgs = append(msgs, &msg.ScheduledMsg{
+			ScheduleID:   schedule.ScheduleID,
+			MsgData:      &msgData,
+			ScheduleTime: schedule.ScheduleTime.UnixMilli(),
+			Status:       schedule.Status,
+			Error:        schedule.Error,
+			CreateTime:   schedule.CreateTime.UnixMilli(),
+		})
+	}
+	return &msg.GetScheduledMsgsResp{Total: total, Msgs: msgs}, nil
+}
+
+func (m *msgServer) CancelScheduledMsg(ctx context.Context, req *msg.CancelScheduledMsgReq) (*msg.CancelScheduledMsgResp, error) {
+	if _, err := m.takeSchedule(ctx, req.SendID, req.ScheduleID); err != nil {
+		return nil, err
+	}
+	canceled, err := m.scheduleDatabase.Cancel(ctx, req.ScheduleID)
+	if err != nil {
+		return nil, err
+	}
+	if !canceled {
+		return nil, errs.ErrArgs.Wrap("scheduled msg is not pending")
+	}
+	return &msg.CancelScheduledMsgResp{}, nil
+}
+
+func (m *msgServer) RescheduleMsg(ctx context.Context, req *msg.RescheduleMsgReq) (*msg.RescheduleMsgResp, error) {
+	if err := checkScheduleTime(req.ScheduleTime); err != nil {
+		return nil, err
+	}
+	if _, err := m.takeSchedule(ctx, req.SendID, req.ScheduleID); err != nil {
+		return nil, err
+	}
+	updated, err := m.scheduleDatabase.Reschedule(ctx, req.ScheduleID, time.UnixMilli(req.ScheduleTime))
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errs.ErrArgs.Wrap("scheduled msg is not pending")
+	}
+	return &msg.RescheduleMsgResp{}, nil
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestCheckScheduleTime(t *testing.T) {
+	maxAhead := config.Config.MsgSchedule.MaxAhead
+	defer func() { config.Config.MsgSchedule.MaxAhead = maxAhead }()
+	config.Config.MsgSchedule.MaxAhead = 3600
+
+	now := time.Now().UnixMilli()
+	assert.Error(t, checkScheduleTime(now-1000))
+	assert.NoError(t, checkScheduleTime(now+60*1000))
+	assert.Error(t, checkScheduleTime(now+7200*1000))
+
+	config.Config.MsgSchedule.MaxAhead = 0
+	assert.NoError(t, checkScheduleTime(now+7200*1000))
+}
+
+func TestScheduleMsgRejectsNotification(t *testing.T) {
+	enable := config.Config.MsgSchedule.Enable
+	defer func() { config.Config.MsgSchedule.Enable = enable }()
+	config.Config.MsgSchedule.Enable = true
+
+	m := &msgServer{}
+	_, err := m.scheduleMsg(context.Background(), &msg.SendMsgReq{
+		MsgData:      &sdkws.MsgData{SendID: "u1", RecvID: "u2", SessionType: constant.NotificationChatType},
+		ScheduleTime: time.Now().Add(time.Minute).UnixMilli(),
+	})
+	assert.True(t, errs.ErrArgs.Is(err))
+}
@@ -0,0 +1,179 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/utils"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func checkSearchMsgsReq(req *msg.SearchMsgsReq) error {
+	if !config.Config.MsgSearch.Enable {
+		return errs.ErrNoPermission.Wrap("msg search is disabled")
+	}
+	if req.UserID == "" {
+		return errs.ErrArgs.Wrap("user_id is empty")
+	}
+	if req.Pagination == nil {
+		return errs.ErrArgs.Wrap("pagination is empty")
+	}
+	if max := config.Config.MsgSearch.MaxKeywordLen; max > 0 && utf8.RuneCountInString(req.Keyword) > max {
+		return errs.ErrArgs.Wrap("keyword is too long")
+	}
+	if req.StartTime > 0 && req.EndTime > 0 && req.StartTime > req.EndTime {
+		return errs.ErrArgs.Wrap("start_time is after end_time")
+	}
+	return nil
+}
+
+// SearchMsgs 在用户的会话中搜索消息，按发送时间倒序；
+// 用户看不到的消息（已删除、入群前、已退群的群）在索引查询中过滤，total 为可见的命中数
+func (m *msgServer) SearchMsgs(ctx context.Context, req *msg.SearchMsgsReq) (*msg.SearchMsgsResp, error) {
+	if err := checkSearchMsgsReq(req); err != nil {
+		return nil, err
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	conversationIDs, err := m.ConversationLocalCache.GetConversationIDs(ctx, req.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.ConversationIDs) > 0 {
+		conversationIDs = utils.IntersectString(conversationIDs, req.ConversationIDs)
+	}
+	conversationIDs, minSeqs, err := m.searchVisibleSeqs(ctx, req.UserID, conversationIDs)
+	if err != nil {
+		return nil, err
+	}
+	if len(conversationIDs) == 0 {
+		return &msg.SearchMsgsResp{}, nil
+	}
+	filter := &relation.MsgSearchFilter{
+		UserID:          req.UserID,
+		ConversationIDs: conversationIDs,
+		MinSeqs:         minSeqs,
+		SendIDs:         req.SendIDs,
+		ContentTypes:    req.ContentTypes,
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+	}
+	total, docs, err := m.searchDatabase.Search(ctx, filter, strings.TrimSpace(req.Keyword), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	msgMap, err := m.getSearchedMsgs(ctx, req.UserID, docs)
+	if err != nil {
+		return nil, err
+	}
+	resp := &msg.SearchMsgsResp{Total: total, Msgs: make([]*msg.SearchedMsg, 0, len(docs))}
+	for _, doc := range docs {
+		msgData, ok := msgMap[doc.ConversationID][doc.Seq]
+		if !ok {
+			continue
+		}
+		resp.Msgs = append(resp.Msgs, &msg.SearchedMsg{ConversationID: doc.ConversationID, MsgData: msgData})
+	}
+	return resp, nil
+}
+
+// searchVisibleSeqs 去掉用户已退出的群，返回剩余会话中用户可见的最小 seq
+func (m *msgServer) searchVisibleSeqs(ctx context.Context, userID string, conversationIDs []string) ([]string, map[string]int64, error) {
+	var groupIDs []string
+	for _, conversationID := range conversationIDs {
+		if groupID, ok := superGroupID(conversationID); ok {
+			groupIDs = append(groupIDs, groupID)
+		}
+	}
+	joinedGroupIDs, err := m.Group.GetUserInGroupIDs(ctx, userID, groupIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	joined := utils.SliceSet(joinedGroupIDs)
+	conversationIDs = utils.Filter(conversationIDs, func(conversationID string) (string, bool) {
+		groupID, ok := superGroupID(conversationID)
+		if !ok {
+			return conversationID, true
+		}
+		_, ok = joined[groupID]
+		return conversationID, ok
+	})
+	if len(conversationIDs) == 0 {
+		return nil, nil, nil
+	}
+	minSeqs, err := m.MsgDatabase.GetMinSeqs(ctx, conversationIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	userMinSeqs, err := m.MsgDatabase.GetUserConversationsMinSeqs(ctx, userID, conversationIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for conversationID, seq := range userMinSeqs {
+		if seq > minSeqs[conversationID] {
+			minSeqs[conversationID] = seq
+		}
+	}
+	return conversationIDs, minSeqs, nil
+}
+
+func superGroupID(conversationID string) (string, bool) {
+	if !strings.HasPrefix(conversationID, "sg_") {
+		return "", false
+	}
+	return strings.TrimPrefix(conversationID, "sg_"), true
+}
+
+// getSearchedMsgs 以 userID 的视角按会话获取命中的消息
+func (m *msgServer) getSearchedMsgs(ctx context.Context, userID string, docs []*relation.MsgSearchModel) (map[string]map[int64]*sdkws.MsgData, error) {
+	conversationSeqs := make(map[string][]int64)
+	for _, doc := range docs {
+		conversationSeqs[doc.ConversationID] = append(conversationSeqs[doc.ConversationID], doc.Seq)
+	}
+	user, err := m.User.GetUserInfo(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	msgMap := make(map[string]map[int64]*sdkws.MsgData, len(conversationSeqs))
+	for conversationID, seqs := range conversationSeqs {
+		msgs, err := m.findUserMsgsBySeqs(ctx, user, conversationID, seqs)
+		if err != nil {
+			return nil, err
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		seqMsgs := make(map[int64]*sdkws.MsgData, len(msgs))
+		for _, msgData := range msgs {
+			if msgData.ContentType == constant.MsgRevokeNotification {
+				continue
+			}
+			seqMsgs[msgData.Seq] = msgData
+		}
+		msgMap[conversationID] = seqMsgs
+	}
+	return msgMap, nil
+}
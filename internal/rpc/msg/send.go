@@ -16,7 +16,6 @@ package msg
 
 import (
 	"context"
-	"encoding/json"
 	"github.com/OpenIMSDK/protocol/constant"
 	pbconversation "github.com/OpenIMSDK/protocol/conversation"
 	pbmsg "github.com/OpenIMSDK/protocol/msg"
@@ -29,8 +28,6 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
-	"github.com/openimsdk/open-im-server/v3/tools/live"
-	"strconv"
 	"strings"
 	"time"
 )
@@ -38,91 +35,14 @@ import (
 func (m *msgServer) SendMsg(ctx context.Context, req *pbmsg.SendMsgReq) (resp *pbmsg.SendMsgResp, error error) {
 	resp = &pbmsg.SendMsgResp{}
 	if req.MsgData != nil {
-		flag := isMessageHasReadEnabled(req.MsgData)
-		if !flag {
-			return nil, errs.ErrMessageHasReadDisable.Wrap()
-		}
 		content := strings.TrimSpace(string(req.MsgData.Content))
 		if content == "" {
 			return nil, errs.ErrArgs.Wrap("请输入发送内容")
 		}
-		// 权限验证，是否敏感词过滤
-		auth, _ := utils.VerifyRights(req.MsgData.Ex)
-		if auth == 0 && !authverify.IsAppManagerUid(ctx) {
-			contentType := req.MsgData.ContentType
-			// 敏感词 | 只验证文本
-			contentTypes2 := make([]int32, 0)
-			contentTypes2 = append(contentTypes2, 101, 106, 114)
-			if utils.IsContainInt32(contentType, contentTypes2) {
-				type Content1 struct {
-					Content string `json:"content"`
-				}
-				type Content2 struct {
-					Content string `json:"text"`
-				}
-
-				var content string
-				if contentType == 101 {
-					var tmp Content1
-					_ = json.Unmarshal(req.MsgData.Content, &tmp)
-					content = tmp.Content
-				} else {
-					var tmp Content2
-					_ = json.Unmarshal(req.MsgData.Content, &tmp)
-					content = tmp.Content
-				}
-
-				// 获取redis连接
-				redisClient := m.MsgDatabase.GetRedis()
-				sensitive := live.NewSensitive(redisClient, content)
-				SenCfg := sensitive.GetSensitiveConfig()
-				flag, _ := strconv.Atoi(SenCfg.Flag)
-				if content != "" {
-					sentence, keywords, found := sensitive.Filter()
-					if found {
-						// 推送命中队列
-						var hitMessage live.HitSensitiveMessage
-						hitMessage.From = req.MsgData.SendID
-						hitMessage.Target = req.MsgData.RecvID
-						hitMessage.Type = 0
-						if req.MsgData.SessionType == 3 {
-							hitMessage.Type = 1
-							hitMessage.Target = req.MsgData.GroupID
-						}
-						hitMessage.DT = time.Now().Unix()
-						hitMessage.Content = content
-						hitMessage.IP = req.MsgData.Ip
-						type sensitiveWord struct {
-							SensitiveWords []string `json:"sensitiveWords"`
-						}
-						extra, _ := json.Marshal(sensitiveWord{SensitiveWords: keywords})
-						hitMessage.Extra = string(extra)
-						sensitive.PushToHitMQ(hitMessage)
-
-						// 处理结果
-						switch flag {
-						case 1, 2: // 直接返回 发送失败
-							return nil, errs.ErrMsgSensitiveWordFailed.Wrap("Cause by sensitive word.")
-						case 3: // 敏感词替换
-							var data []byte
-							if contentType == 101 {
-								var tmp Content1
-								tmp.Content = sentence
-								data, _ = json.Marshal(tmp)
-							} else {
-								var tmp Content2
-								tmp.Content = sentence
-								data, _ = json.Marshal(tmp)
-							}
-							req.MsgData.Content = data
-							req.MsgData.OfflinePushInfo.Desc = sentence
-						}
-					}
-				}
-			}
-
+		// 定时消息保存后由定时任务发送
+		if req.ScheduleTime > time.Now().UnixMilli() {
+			return m.scheduleMsg(ctx, req)
 		}
-
 		m.encapsulateMsgData(req.MsgData)
 		switch req.MsgData.SessionType {
 		case constant.SingleChatType:
@@ -147,6 +67,13 @@ func (m *msgServer) sendMsgSuperGroupChat(
 		prommetrics.GroupChatMsgProcessFailedCounter.Inc()
 		return nil, err
 	}
+	if err = m.checkThreadReply(ctx, req.MsgData); err != nil {
+		return nil, err
+	}
+	// 拦截器链在校验之后执行，被拒绝的消息不计入刷屏窗口
+	if err = m.execInterceptorHandler(ctx, req); err != nil {
+		return nil, err
+	}
 	if err = callbackBeforeSendGroupMsg(ctx, req); err != nil {
 		return nil, err
 	}
@@ -216,6 +143,9 @@ func (m *msgServer) sendMsgNotification(
 	ctx context.Context,
 	req *pbmsg.SendMsgReq,
 ) (resp *pbmsg.SendMsgResp, err error) {
+	if err := m.execInterceptorHandler(ctx, req); err != nil {
+		return nil, err
+	}
 	if err := m.MsgDatabase.MsgToMQ(ctx, utils.GenConversationUniqueKeyForSingle(req.MsgData.SendID, req.MsgData.RecvID), req.MsgData); err != nil {
 		return nil, err
 	}
@@ -231,6 +161,13 @@ func (m *msgServer) sendMsgSingleChat(ctx context.Context, req *pbmsg.SendMsgReq
 	if err := m.messageVerification(ctx, req); err != nil {
 		return nil, err
 	}
+	if err := m.checkThreadReply(ctx, req.MsgData); err != nil {
+		return nil, err
+	}
+	// 拦截器链在校验之后执行，被拒绝的消息不计入刷屏窗口
+	if err := m.execInterceptorHandler(ctx, req); err != nil {
+		return nil, err
+	}
 	isSend := true
 	isNotification := msgprocessor.IsNotificationByMsg(req.MsgData)
 	if !isNotification {
@@ -26,15 +26,19 @@ import (
 	"github.com/OpenIMSDK/protocol/msg"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/localcache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
+	"github.com/openimsdk/open-im-server/v3/tools/live"
 )
 
 type (
-	MessageInterceptorChain []MessageInterceptorFunc
+	MessageInterceptorChain []*MessageInterceptor
 	msgServer               struct {
 		RegisterCenter         discoveryregistry.SvcDiscoveryRegistry
 		MsgDatabase            controller.CommonMsgDatabase
@@ -46,17 +50,28 @@ type (
 		GroupLocalCache        *localcache.GroupLocalCache
 		ConversationLocalCache *localcache.ConversationLocalCache
 		Handlers               MessageInterceptorChain
+		sensitiveWords         *live.SensitiveTrie
+		brushLimiter           *live.BrushLimit
+		events                 eventbus.Publisher
 		notificationSender     *rpcclient.NotificationSender
+		reactionDatabase       controller.MsgReactionDatabase
+		threadDatabase         controller.MsgThreadDatabase
+		scheduleDatabase       controller.MsgScheduleDatabase
+		pinDatabase            controller.MsgPinDatabase
+		searchDatabase         controller.MsgSearchDatabase
 	}
 )
 
-func (m *msgServer) addInterceptorHandler(interceptorFunc ...MessageInterceptorFunc) {
-	m.Handlers = append(m.Handlers, interceptorFunc...)
+func (m *msgServer) addInterceptorHandler(interceptors ...*MessageInterceptor) {
+	m.Handlers = append(m.Handlers, interceptors...)
 }
 
 func (m *msgServer) execInterceptorHandler(ctx context.Context, req *msg.SendMsgReq) error {
-	for _, handler := range m.Handlers {
-		msgData, err := handler(ctx, req)
+	for _, interceptor := range m.Handlers {
+		if !interceptor.match(req.MsgData) {
+			continue
+		}
+		msgData, err := interceptor.Handler(ctx, req)
 		if err != nil {
 			return err
 		}
@@ -96,6 +111,26 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 	if err != nil {
 		return err
 	}
+	reactionDB, err := mgo.NewMsgReactionMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	threadDB, err := mgo.NewMsgThreadMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	scheduleDB, err := mgo.NewMsgScheduleMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	pinDB, err := mgo.NewMsgPinMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
+	searchDB, err := mgo.NewMsgSearchMongo(mongo.GetDatabase())
+	if err != nil {
+		return err
+	}
 	ctxTx := tx.NewMongo(mongo.GetClient())
 	groupDatabase := controller.NewGroupDatabase(rdb, groupDB, groupMemberDB, groupRequestDB, ctxTx, nil)
 	s := &msgServer{
@@ -108,9 +143,33 @@ func Start(client discoveryregistry.SvcDiscoveryRegistry, server *grpc.Server) e
 		GroupLocalCache:        localcache.NewGroupLocalCache(&groupRpcClient),
 		ConversationLocalCache: localcache.NewConversationLocalCache(&conversationClient),
 		friend:                 &friendRpcClient,
+		reactionDatabase:       controller.NewMsgReactionDatabase(reactionDB, cache.NewMsgReactionCacheRedis(rdb, reactionDB, cache.GetDefaultOpt())),
+		threadDatabase:         controller.NewMsgThreadDatabase(threadDB, cache.NewMsgThreadCacheRedis(rdb, threadDB, cache.GetDefaultOpt())),
+		scheduleDatabase:       controller.NewMsgScheduleDatabase(scheduleDB),
+		pinDatabase:            controller.NewMsgPinDatabase(pinDB, cache.NewMsgPinCacheRedis(rdb, pinDB, cache.GetDefaultOpt())),
+		searchDatabase:         controller.NewMsgSearchDatabase(searchDB),
 	}
+	normalizer, err := live.NewNormalizer(config.Config.SensitiveNormalize)
+	if err != nil {
+		return err
+	}
+	if s.events, err = eventbus.NewPublisher(rdb); err != nil {
+		return err
+	}
+	s.sensitiveWords = live.NewSensitiveTrie(rdb, normalizer, prommetrics.SetSensitiveWordNum)
+	if err := s.sensitiveWords.Reload(context.Background(), true); err != nil {
+		return err
+	}
+	go s.sensitiveWords.Run(context.Background())
+	brushScopes := config.Config.BrushLimit.Scopes
+	if len(brushScopes) == 0 {
+		brushScopes = []string{live.BrushScopeGroup}
+	}
+	s.brushLimiter = live.NewBrushLimit(rdb, s.events, brushScopes)
 	s.notificationSender = rpcclient.NewNotificationSender(rpcclient.WithLocalSendMsg(s.SendMsg))
-	s.addInterceptorHandler(MessageHasReadEnabled)
+	if err := s.initInterceptorHandlers(config.Config.MsgInterceptors); err != nil {
+		return err
+	}
 	msg.RegisterMsgServer(server, s)
 	return nil
 }
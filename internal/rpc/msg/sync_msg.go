@@ -84,6 +84,8 @@ func (m *msgServer) PullMessageBySeqs(
 				log.ZWarn(ctx, "not have msgs", nil, "conversationID", seq.ConversationID, "seq", seq, "req", req)
 				continue
 			}
+			m.attachReactions(ctx, seq.ConversationID, req.UserID, msgs)
+			m.attachThreads(ctx, seq.ConversationID, req.UserID, msgs)
 			resp.Msgs[seq.ConversationID] = &sdkws.PullMsgs{Msgs: msgs, IsEnd: isEnd}
 		} else {
 			var seqs []int64
@@ -0,0 +1,186 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msg
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
+)
+
+// checkThreadReply 话题回复的根消息须在同一会话中，且不能是通知或另一条回复
+func (m *msgServer) checkThreadReply(ctx context.Context, msgData *sdkws.MsgData) error {
+	if msgData.ThreadRootSeq == 0 {
+		return nil
+	}
+	if !config.Config.MsgThread.Enable {
+		return errs.ErrNoPermission.Wrap("msg thread is disabled")
+	}
+	if msgData.ThreadRootSeq < 0 {
+		return errs.ErrArgs.Wrap("thread root seq is invalid")
+	}
+	root, err := m.getMsgBySeq(ctx, msgData.SendID, msgprocessor.GetConversationIDByMsg(msgData), msgData.ThreadRootSeq)
+	if err != nil {
+		return err
+	}
+	if root.ThreadRootSeq > 0 {
+		return errs.ErrArgs.Wrap("thread root can not be a thread reply")
+	}
+	if root.ContentType >= constant.NotificationBegin {
+		return errs.ErrArgs.Wrap("thread root can not be a notification")
+	}
+	return nil
+}
+
+// maxThreadMsgNum 单次拉取的话题回复数上限
+const maxThreadMsgNum = 100
+
+func checkThreadReq(conversationID string, rootSeq int64, userID string) error {
+	if !config.Config.MsgThread.Enable {
+		return errs.ErrNoPermission.Wrap("msg thread is disabled")
+	}
+	if userID == "" {
+		return errs.ErrArgs.Wrap("user_id is empty")
+	}
+	if conversationID == "" {
+		return errs.ErrArgs.Wrap("conversation_id is empty")
+	}
+	if rootSeq <= 0 {
+		return errs.ErrArgs.Wrap("root seq is invalid")
+	}
+	return nil
+}
+
+// GetThreadMsgs 从 beginThreadSeq 起按话题内 seq 拉取 num 条回复，根消息附带话题信息和回应数
+func (m *msgServer) GetThreadMsgs(ctx context.Context, req *msg.GetThreadMsgsReq) (*msg.GetThreadMsgsResp, error) {
+	if err := checkThreadReq(req.ConversationID, req.RootSeq, req.UserID); err != nil {
+		return nil, err
+	}
+	if req.BeginThreadSeq < 0 {
+		return nil, errs.ErrArgs.Wrap("begin thread seq is invalid")
+	}
+	if req.Num <= 0 || req.Num > maxThreadMsgNum {
+		return nil, errs.ErrArgs.Wrap("num is invalid")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	thread, err := m.threadDatabase.GetThread(ctx, req.ConversationID, req.RootSeq)
+	if err != nil {
+		return nil, err
+	}
+	replies, err := m.threadDatabase.FindReplies(ctx, req.ConversationID, req.RootSeq, req.BeginThreadSeq, req.Num)
+	if err != nil {
+		return nil, err
+	}
+	seqs := make([]int64, 0, len(replies)+1)
+	seqs = append(seqs, req.RootSeq)
+	threadSeqs := make(map[int64]int64, len(replies))
+	for _, reply := range replies {
+		seqs = append(seqs, reply.Seq)
+		threadSeqs[reply.Seq] = reply.ThreadSeq
+	}
+	// 根消息撤回后话题仍可查看
+	msgs, err := m.findMsgsBySeqs(ctx, req.UserID, req.ConversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0].Seq != req.RootSeq {
+		return nil, errs.ErrRecordNotFound.Wrap("thread root not found")
+	}
+	root := msgs[0]
+	if err := m.checkMsgMember(ctx, root, req.UserID); err != nil {
+		return nil, err
+	}
+	for _, msgData := range msgs[1:] {
+		msgData.ThreadSeq = threadSeqs[msgData.Seq]
+	}
+	m.attachThreads(ctx, req.ConversationID, req.UserID, msgs[:1])
+	m.attachReactions(ctx, req.ConversationID, req.UserID, msgs)
+	return &msg.GetThreadMsgsResp{RootMsg: root, Msgs: msgs[1:], Total: thread.ReplyCount}, nil
+}
+
+// MarkThreadAsRead 按话题内 seq 标记话题已读，hasReadSeq 为 0 时标记到最后一条回复
+func (m *msgServer) MarkThreadAsRead(ctx context.Context, req *msg.MarkThreadAsReadReq) (*msg.MarkThreadAsReadResp, error) {
+	if err := checkThreadReq(req.ConversationID, req.RootSeq, req.UserID); err != nil {
+		return nil, err
+	}
+	if req.HasReadSeq < 0 {
+		return nil, errs.ErrArgs.Wrap("has read seq is invalid")
+	}
+	if err := authverify.CheckAccessV3(ctx, req.UserID); err != nil {
+		return nil, err
+	}
+	thread, err := m.threadDatabase.GetThread(ctx, req.ConversationID, req.RootSeq)
+	if err != nil {
+		return nil, err
+	}
+	if thread.ReplyCount == 0 {
+		return nil, errs.ErrRecordNotFound.Wrap("thread not found")
+	}
+	msgs, err := m.findMsgsBySeqs(ctx, req.UserID, req.ConversationID, []int64{req.RootSeq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 {
+		return nil, errs.ErrRecordNotFound.Wrap("thread root not found")
+	}
+	if err := m.checkMsgMember(ctx, msgs[0], req.UserID); err != nil {
+		return nil, err
+	}
+	hasReadSeq := req.HasReadSeq
+	if hasReadSeq == 0 || hasReadSeq > thread.MaxThreadSeq {
+		hasReadSeq = thread.MaxThreadSeq
+	}
+	if err := m.threadDatabase.MarkAsRead(ctx, req.ConversationID, req.RootSeq, req.UserID, hasReadSeq); err != nil {
+		return nil, err
+	}
+	return &msg.MarkThreadAsReadResp{}, nil
+}
+
+// attachThreads 拉取消息时为话题根消息附带回复数、最后回复和未读数，失败不影响拉取
+func (m *msgServer) attachThreads(ctx context.Context, conversationID, userID string, msgs []*sdkws.MsgData) {
+	if !config.Config.MsgThread.Enable || len(msgs) == 0 {
+		return
+	}
+	seqs := make([]int64, 0, len(msgs))
+	for _, msgData := range msgs {
+		// 回复不能作为根消息
+		if msgData != nil && msgData.Seq > 0 && msgData.ThreadRootSeq == 0 {
+			seqs = append(seqs, msgData.Seq)
+		}
+	}
+	if len(seqs) == 0 {
+		return
+	}
+	threads, err := m.threadDatabase.GetThreadInfos(ctx, conversationID, seqs, userID)
+	if err != nil {
+		log.ZWarn(ctx, "GetThreadInfos failed", err, "conversationID", conversationID)
+		return
+	}
+	for _, msgData := range msgs {
+		if msgData != nil {
+			msgData.ThreadInfo = threads[msgData.Seq]
+		}
+	}
+}
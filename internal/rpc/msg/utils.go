@@ -15,33 +15,19 @@
 package msg
 
 import (
+	"context"
+	"sort"
+
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/utils"
 	"github.com/redis/go-redis/v9"
 	"go.mongodb.org/mongo-driver/mongo"
 
-	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
 )
 
-func isMessageHasReadEnabled(msgData *sdkws.MsgData) bool {
-	switch {
-	case msgData.ContentType == constant.HasReadReceipt && msgData.SessionType == constant.SingleChatType:
-		if config.Config.SingleMessageHasReadReceiptEnable {
-			return true
-		} else {
-			return false
-		}
-	case msgData.ContentType == constant.HasReadReceipt && msgData.SessionType == constant.SuperGroupChatType:
-		if config.Config.GroupMessageHasReadReceiptEnable {
-			return true
-		} else {
-			return false
-		}
-	}
-	return true
-}
-
 func IsNotFound(err error) bool {
 	switch utils.Unwrap(err) {
 	case redis.Nil, mongo.ErrNoDocuments:
@@ -50,3 +36,58 @@ func IsNotFound(err error) bool {
 		return false
 	}
 }
+
+// findMsgsBySeqs 以 userID 的视角获取会话中的消息，按 seq 排序
+func (m *msgServer) findMsgsBySeqs(ctx context.Context, userID, conversationID string, seqs []int64) ([]*sdkws.MsgData, error) {
+	user, err := m.User.GetUserInfo(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return m.findUserMsgsBySeqs(ctx, user, conversationID, seqs)
+}
+
+// findUserMsgsBySeqs 同 findMsgsBySeqs，用于已获取用户信息的调用方
+func (m *msgServer) findUserMsgsBySeqs(ctx context.Context, user *sdkws.UserInfo, conversationID string, seqs []int64) ([]*sdkws.MsgData, error) {
+	_, _, msgs, err := m.MsgDatabase.GetMsgBySeqs(ctx, user.UserID, conversationID, seqs, user, &sdkws.GroupMemberFullInfo{})
+	if err != nil {
+		return nil, err
+	}
+	msgs = utils.Filter(msgs, func(msg *sdkws.MsgData) (*sdkws.MsgData, bool) { return msg, msg != nil })
+	sort.Sort(msgprocessor.MsgBySeq(msgs))
+	return msgs, nil
+}
+
+// getMsgBySeq 以 userID 的视角获取会话中的一条消息，已撤回的消息返回错误
+func (m *msgServer) getMsgBySeq(ctx context.Context, userID, conversationID string, seq int64) (*sdkws.MsgData, error) {
+	msgs, err := m.findMsgsBySeqs(ctx, userID, conversationID, []int64{seq})
+	if err != nil {
+		return nil, err
+	}
+	if len(msgs) == 0 || msgs[0] == nil || msgs[0].Seq != seq {
+		return nil, errs.ErrRecordNotFound.Wrap("msg not found")
+	}
+	if msgs[0].ContentType == constant.MsgRevokeNotification {
+		return nil, errs.ErrMsgAlreadyRevoke.Wrap("msg already revoke")
+	}
+	return msgs[0], nil
+}
+
+// checkMsgMember 校验用户是消息所在单聊或群聊的成员
+func (m *msgServer) checkMsgMember(ctx context.Context, msgData *sdkws.MsgData, userID string) error {
+	switch msgData.SessionType {
+	case constant.SuperGroupChatType:
+		if _, err := m.Group.GetGroupMemberCache(ctx, msgData.GroupID, userID); err != nil {
+			if errs.ErrRecordNotFound.Is(err) {
+				return errs.ErrNotInGroupYet.Wrap()
+			}
+			return err
+		}
+	case constant.SingleChatType:
+		if msgData.SendID != userID && msgData.RecvID != userID {
+			return errs.ErrNoPermission.Wrap("not a member of the conversation")
+		}
+	default:
+		return errs.ErrArgs.Wrap("unsupported session type")
+	}
+	return nil
+}
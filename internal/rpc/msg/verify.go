@@ -16,7 +16,6 @@ package msg
 
 import (
 	"context"
-	"github.com/openimsdk/open-im-server/v3/tools/live"
 	"math/rand"
 	"strconv"
 	"time"
@@ -143,18 +142,7 @@ func (m *msgServer) messageVerification(ctx context.Context, data *msg.SendMsgRe
 		if groupInfo.Status == constant.GroupStatusMuted {
 			return errs.ErrMutedGroup.Wrap()
 		}
-
-		// 刷屏禁言 限制 | 普通群员
-		// 只有普通群员 和 用户消息类型 才限制
-		if groupMemberInfo.RoleLevel == constant.GroupOrdinaryUsers && data.MsgData.MsgFrom == constant.UserMsgType {
-			// 获取redis连接
-			redisClient := m.MsgDatabase.GetRedis()
-			brushLimit := live.NewBrushLimit(redisClient)
-			result, err := brushLimit.Check(data.MsgData.SendID)
-			if result == false {
-				return errs.GetSendMsgLimitErr(err.Error())
-			}
-		}
+		// 刷屏禁言 限制由 brushLimit 拦截器处理
 		return nil
 	default:
 		return nil
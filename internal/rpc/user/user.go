@@ -31,6 +31,7 @@ import (
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/errs"
 	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/unrelation"
@@ -42,8 +43,11 @@ import (
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
 	tablerelation "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/prommetrics"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient"
 	"github.com/openimsdk/open-im-server/v3/pkg/rpcclient/notification"
+	"github.com/openimsdk/open-im-server/v3/tools/live"
 
 	pbuser "github.com/OpenIMSDK/protocol/user"
 	"github.com/OpenIMSDK/tools/utils"
@@ -57,6 +61,7 @@ type userServer struct {
 	friendRpcClient          *rpcclient.FriendRpcClient
 	groupRpcClient           *rpcclient.GroupRpcClient
 	RegisterCenter           registry.SvcDiscoveryRegistry
+	sensitiveFilter          *live.SensitiveFieldFilter
 }
 
 func (s *userServer) ProcessUserCommandGetAll(ctx context.Context, req *pbuser.ProcessUserCommandGetAllReq) (*pbuser.ProcessUserCommandGetAllResp, error) {
@@ -96,6 +101,18 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 	friendRpcClient := rpcclient.NewFriendRpcClient(client)
 	groupRpcClient := rpcclient.NewGroupRpcClient(client)
 	msgRpcClient := rpcclient.NewMessageRpcClient(client)
+	normalizer, err := live.NewNormalizer(config.Config.SensitiveNormalize)
+	if err != nil {
+		return err
+	}
+	events, err := eventbus.NewPublisher(rdb)
+	if err != nil {
+		return err
+	}
+	sensitiveFilter, err := live.NewSensitiveFieldFilter(context.Background(), rdb, events, config.Config.SensitiveFields, normalizer, prommetrics.SetSensitiveWordNum)
+	if err != nil {
+		return err
+	}
 	u := &userServer{
 		UserDatabase:             database,
 		RegisterCenter:           client,
@@ -103,6 +120,7 @@ func Start(client registry.SvcDiscoveryRegistry, server *grpc.Server) error {
 		groupRpcClient:           &groupRpcClient,
 		friendNotificationSender: notification.NewFriendNotificationSender(&msgRpcClient, notification.WithDBFunc(database.FindWithError)),
 		userNotificationSender:   notification.NewUserNotificationSender(&msgRpcClient, notification.WithUserFunc(database.FindWithError)),
+		sensitiveFilter:          sensitiveFilter,
 	}
 	pbuser.RegisterUserServer(server, u)
 	return u.UserDatabase.InitOnce(context.Background(), users)
@@ -143,6 +161,11 @@ func (s *userServer) UpdateUserInfo(ctx context.Context, req *pbuser.UpdateUserI
 	if err := CallbackBeforeUpdateUserInfo(ctx, req); err != nil {
 		return nil, err
 	}
+	hit := live.HitSensitiveMessage{From: mcontext.GetOpUserID(ctx), Type: live.HitTypeUserInfo, Target: req.UserInfo.UserID}
+	req.UserInfo.Nickname, err = s.sensitiveFilter.Filter(ctx, live.SensitiveFieldUserNickname, req.UserInfo.Nickname, hit)
+	if err != nil {
+		return nil, err
+	}
 	data := convert.UserPb2DBMap(req.UserInfo)
 	if err := s.UpdateByMap(ctx, req.UserInfo.UserID, data); err != nil {
 		return nil, err
@@ -178,6 +201,12 @@ func (s *userServer) UpdateUserInfoEx(ctx context.Context, req *pbuser.UpdateUse
 	if err = CallbackBeforeUpdateUserInfoEx(ctx, req); err != nil {
 		return nil, err
 	}
+	if nickname := req.UserInfo.Nickname; nickname != nil {
+		hit := live.HitSensitiveMessage{From: mcontext.GetOpUserID(ctx), Type: live.HitTypeUserInfo, Target: req.UserInfo.UserID}
+		if nickname.Value, err = s.sensitiveFilter.Filter(ctx, live.SensitiveFieldUserNickname, nickname.Value, hit); err != nil {
+			return nil, err
+		}
+	}
 	data := convert.UserPb2DBMapEx(req.UserInfo)
 	if err = s.UpdateByMap(ctx, req.UserInfo.UserID, data); err != nil {
 		return nil, err
@@ -286,6 +315,13 @@ func (s *userServer) UserRegister(ctx context.Context, req *pbuser.UserRegisterR
 	if err := CallbackBeforeUserRegister(ctx, req); err != nil {
 		return nil, err
 	}
+	// 注册由管理员代为提交，昵称仍需过滤
+	for _, user := range req.Users {
+		hit := live.HitSensitiveMessage{From: user.UserID, Type: live.HitTypeUserInfo, Target: user.UserID}
+		if user.Nickname, err = s.sensitiveFilter.FilterSubmitted(ctx, live.SensitiveFieldUserNickname, user.Nickname, hit); err != nil {
+			return nil, err
+		}
+	}
 	now := time.Now()
 	users := make([]*tablerelation.UserModel, 0, len(req.Users))
 	for _, user := range req.Users {
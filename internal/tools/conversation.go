@@ -139,6 +139,9 @@ func (c *MsgTool) ConversationsDestructMsgs() {
 				continue
 			}
 			if len(seqs) > 0 {
+				if err := c.searchDatabase.RemoveUserMsgs(ctx, conversation.OwnerUserID, conversation.ConversationID, seqs); err != nil {
+					log.ZError(ctx, "RemoveUserMsgs failed", err, "conversationID", conversation.ConversationID, "ownerUserID", conversation.OwnerUserID)
+				}
 				if err := c.conversationDatabase.UpdateUsersConversationFiled(ctx, []string{conversation.OwnerUserID}, conversation.ConversationID, map[string]any{"latest_msg_destruct_time": now}); err != nil {
 					log.ZError(ctx, "updateUsersConversationFiled failed", err, "conversationID", conversation.ConversationID, "ownerUserID", conversation.OwnerUserID)
 					continue
@@ -61,6 +61,15 @@ func StartTask() error {
 		panic(err)
 	}
 
+	if config.Config.MsgSchedule.Enable {
+		log.ZInfo(context.Background(), "start msgSchedule cron task", "cron config", config.Config.MsgSchedule.DispatchTime)
+		_, err = crontab.AddFunc(config.Config.MsgSchedule.DispatchTime, cronWrapFunc(rdb, "cron_dispatch_scheduled_msgs", msgTool.DispatchScheduledMsgs))
+		if err != nil {
+			log.ZError(context.Background(), "start dispatchScheduledMsgs cron failed", err)
+			panic(err)
+		}
+	}
+
 	// start crontab
 	crontab.Start()
 
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"time"
 
 	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/tx"
@@ -52,10 +53,14 @@ type MsgTool struct {
 	userDatabase          controller.UserDatabase
 	groupDatabase         controller.GroupDatabase
 	msgNotificationSender *notification.MsgNotificationSender
+	scheduleDatabase      controller.MsgScheduleDatabase
+	msgRpcClient          *rpcclient.MessageRpcClient
+	searchDatabase        controller.MsgSearchDatabase
 }
 
 func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controller.UserDatabase,
 	groupDatabase controller.GroupDatabase, conversationDatabase controller.ConversationDatabase, msgNotificationSender *notification.MsgNotificationSender,
+	scheduleDatabase controller.MsgScheduleDatabase, msgRpcClient *rpcclient.MessageRpcClient, searchDatabase controller.MsgSearchDatabase,
 ) *MsgTool {
 	return &MsgTool{
 		msgDatabase:           msgDatabase,
@@ -63,6 +68,9 @@ func NewMsgTool(msgDatabase controller.CommonMsgDatabase, userDatabase controlle
 		groupDatabase:         groupDatabase,
 		conversationDatabase:  conversationDatabase,
 		msgNotificationSender: msgNotificationSender,
+		scheduleDatabase:      scheduleDatabase,
+		msgRpcClient:          msgRpcClient,
+		searchDatabase:        searchDatabase,
 	}
 }
 
@@ -115,9 +123,18 @@ func InitMsgTool() (*MsgTool, error) {
 		cache.NewConversationRedis(rdb, cache.GetDefaultOpt(), conversationDB),
 		ctxTx,
 	)
+	scheduleDB, err := mgo.NewMsgScheduleMongo(mongo.GetDatabase())
+	if err != nil {
+		return nil, err
+	}
+	searchDB, err := mgo.NewMsgSearchMongo(mongo.GetDatabase())
+	if err != nil {
+		return nil, err
+	}
 	msgRpcClient := rpcclient.NewMessageRpcClient(discov)
 	msgNotificationSender := notification.NewMsgNotificationSender(rpcclient.WithRpcClient(&msgRpcClient))
-	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender)
+	msgTool := NewMsgTool(msgDatabase, userDatabase, groupDatabase, conversationDatabase, msgNotificationSender,
+		controller.NewMsgScheduleDatabase(scheduleDB), &msgRpcClient, controller.NewMsgSearchDatabase(searchDB))
 	return msgTool, nil
 }
 
@@ -182,6 +199,10 @@ func (c *MsgTool) ClearConversationsMsg(ctx context.Context, conversationIDs []s
 		if err := c.msgDatabase.DeleteConversationMsgsAndSetMinSeq(ctx, conversationID, int64(config.Config.RetainChatRecords*24*60*60)); err != nil {
 			log.ZError(ctx, "DeleteUserSuperGroupMsgsAndSetMinSeq failed", err, "conversationID", conversationID, "DBRetainChatRecords", config.Config.RetainChatRecords)
 		}
+		sendTime := time.Now().Add(-time.Duration(config.Config.RetainChatRecords) * 24 * time.Hour).UnixMilli()
+		if err := c.searchDatabase.RemoveMsgsBySendTime(ctx, conversationID, sendTime); err != nil {
+			log.ZError(ctx, "RemoveMsgsBySendTime failed", err, "conversationID", conversationID)
+		}
 		if err := c.checkMaxSeq(ctx, conversationID); err != nil {
 			log.ZError(ctx, "fixSeq failed", err, "conversationID", conversationID)
 		}
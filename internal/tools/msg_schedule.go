@@ -0,0 +1,108 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/msg"
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+const (
+	defaultScheduleBatchSize    = 200
+	defaultScheduleClaimTimeout = 5 * time.Minute
+	// scheduleSendConcurrency 同时发送的定时消息数
+	scheduleSendConcurrency = 16
+)
+
+// DispatchScheduledMsgs 发送到期的定时消息，多个实例同时执行时通过抢占状态保证只发送一次
+func (c *MsgTool) DispatchScheduledMsgs() {
+	ctx := mcontext.NewCtx(utils.GetSelfFuncName())
+	batchSize := config.Config.MsgSchedule.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultScheduleBatchSize
+	}
+	claimTimeout := time.Duration(config.Config.MsgSchedule.ClaimTimeout) * time.Second
+	if claimTimeout <= 0 {
+		claimTimeout = defaultScheduleClaimTimeout
+	}
+	c.dispatchScheduledMsgs(ctx, batchSize, claimTimeout, c.sendScheduledMsg)
+}
+
+// dispatchScheduledMsgs 抢占成功的消息并发发送；实例在发送中退出或记录结果失败时，消息停留在发送中，
+// 超过 claimTimeout 后重新抢占发送，重发的消息 ClientMsgID 不变；
+// 服务端不按 ClientMsgID 去重，发送是至少一次，可能重复的消息由客户端按 ClientMsgID 去重
+func (c *MsgTool) dispatchScheduledMsgs(ctx context.Context, batchSize int64, claimTimeout time.Duration,
+	send func(ctx context.Context, schedule *relation.MsgScheduleModel) error,
+) {
+	schedules, err := c.scheduleDatabase.FindDue(ctx, time.Now(), claimTimeout, batchSize)
+	if err != nil {
+		log.ZError(ctx, "find due scheduled msgs failed", err)
+		return
+	}
+	var wg errgroup.Group
+	wg.SetLimit(scheduleSendConcurrency)
+	for _, schedule := range schedules {
+		schedule := schedule
+		wg.Go(func() error {
+			claimed, err := c.scheduleDatabase.Claim(ctx, schedule.ScheduleID, claimTimeout)
+			if err != nil {
+				log.ZError(ctx, "claim scheduled msg failed", err, "scheduleID", schedule.ScheduleID)
+				return nil
+			}
+			if !claimed {
+				return nil
+			}
+			if schedule.Status == relation.MsgScheduleSending {
+				log.ZWarn(ctx, "reclaim stale scheduled msg", nil, "scheduleID", schedule.ScheduleID, "claimTime", schedule.ClaimTime)
+			}
+			sendErr := send(ctx, schedule)
+			if sendErr != nil {
+				log.ZWarn(ctx, "send scheduled msg failed", sendErr, "scheduleID", schedule.ScheduleID, "sendID", schedule.SendID)
+			}
+			if err := c.scheduleDatabase.Finish(ctx, schedule.ScheduleID, sendErr); err != nil {
+				log.ZError(ctx, "finish scheduled msg failed", err, "scheduleID", schedule.ScheduleID)
+			}
+			return nil
+		})
+	}
+	_ = wg.Wait()
+	if len(schedules) > 0 {
+		log.ZInfo(ctx, "dispatch scheduled msgs", "count", len(schedules))
+	}
+}
+
+// sendScheduledMsg 以发送者身份走正常发送流程
+func (c *MsgTool) sendScheduledMsg(ctx context.Context, schedule *relation.MsgScheduleModel) error {
+	var msgData sdkws.MsgData
+	if err := proto.Unmarshal(schedule.Msg, &msgData); err != nil {
+		return errs.Wrap(err)
+	}
+	ctx = mcontext.SetOperationID(ctx, mcontext.GetOperationID(ctx)+"-"+schedule.ScheduleID)
+	ctx = mcontext.WithOpUserIDContext(ctx, schedule.SendID)
+	_, err := c.msgRpcClient.SendMsg(ctx, &msg.SendMsgReq{MsgData: &msgData})
+	return err
+}
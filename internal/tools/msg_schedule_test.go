@@ -0,0 +1,161 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tools
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/controller"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+// memScheduleDatabase keeps the claim semantics of the mongo filter in memory.
+type memScheduleDatabase struct {
+	controller.MsgScheduleDatabase
+	mu        sync.Mutex
+	schedules map[string]*relation.MsgScheduleModel
+	finishErr error
+}
+
+func newMemScheduleDatabase(schedules ...*relation.MsgScheduleModel) *memScheduleDatabase {
+	m := &memScheduleDatabase{schedules: make(map[string]*relation.MsgScheduleModel)}
+	for _, schedule := range schedules {
+		m.schedules[schedule.ScheduleID] = schedule
+	}
+	return m
+}
+
+func (m *memScheduleDatabase) claimable(schedule *relation.MsgScheduleModel, claimTimeout time.Duration) bool {
+	return schedule.Status == relation.MsgSchedulePending ||
+		schedule.Status == relation.MsgScheduleSending && schedule.ClaimTime.Before(time.Now().Add(-claimTimeout))
+}
+
+func (m *memScheduleDatabase) FindDue(_ context.Context, before time.Time, claimTimeout time.Duration, _ int64) ([]*relation.MsgScheduleModel, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var res []*relation.MsgScheduleModel
+	for _, schedule := range m.schedules {
+		if !schedule.ScheduleTime.After(before) && m.claimable(schedule, claimTimeout) {
+			s := *schedule
+			res = append(res, &s)
+		}
+	}
+	return res, nil
+}
+
+func (m *memScheduleDatabase) Claim(_ context.Context, scheduleID string, claimTimeout time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	schedule := m.schedules[scheduleID]
+	if !m.claimable(schedule, claimTimeout) {
+		return false, nil
+	}
+	schedule.Status, schedule.ClaimTime = relation.MsgScheduleSending, time.Now()
+	return true, nil
+}
+
+func (m *memScheduleDatabase) Finish(_ context.Context, scheduleID string, sendErr error) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.finishErr != nil {
+		return m.finishErr
+	}
+	schedule := m.schedules[scheduleID]
+	if schedule.Status != relation.MsgScheduleSending {
+		return nil
+	}
+	schedule.Status = relation.MsgScheduleSent
+	if sendErr != nil {
+		schedule.Status, schedule.Error = relation.MsgScheduleFailed, sendErr.Error()
+	}
+	return nil
+}
+
+func (m *memScheduleDatabase) status(scheduleID string) int32 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.schedules[scheduleID].Status
+}
+
+type scheduleSender struct {
+	mu   sync.Mutex
+	sent map[string]int
+	fail map[string]bool
+}
+
+func (s *scheduleSender) send(_ context.Context, schedule *relation.MsgScheduleModel) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent[schedule.ScheduleID]++
+	if s.fail[schedule.ScheduleID] {
+		return errors.New("send failed")
+	}
+	return nil
+}
+
+func TestDispatchScheduledMsgs(t *testing.T) {
+	now := time.Now()
+	db := newMemScheduleDatabase(
+		&relation.MsgScheduleModel{ScheduleID: "s1", Status: relation.MsgSchedulePending, ScheduleTime: now.Add(-time.Second)},
+		&relation.MsgScheduleModel{ScheduleID: "s2", Status: relation.MsgSchedulePending, ScheduleTime: now.Add(-time.Second)},
+		&relation.MsgScheduleModel{ScheduleID: "s3", Status: relation.MsgSchedulePending, ScheduleTime: now.Add(time.Hour)},
+		&relation.MsgScheduleModel{ScheduleID: "s4", Status: relation.MsgScheduleCanceled, ScheduleTime: now.Add(-time.Second)},
+	)
+	sender := &scheduleSender{sent: make(map[string]int), fail: map[string]bool{"s2": true}}
+	c := &MsgTool{scheduleDatabase: db}
+
+	// 多个实例同时发送，每条消息只发送一次
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.dispatchScheduledMsgs(context.Background(), 10, time.Minute, sender.send)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, map[string]int{"s1": 1, "s2": 1}, sender.sent)
+	assert.Equal(t, relation.MsgScheduleSent, db.status("s1"))
+	assert.Equal(t, relation.MsgScheduleFailed, db.status("s2"))
+	assert.Equal(t, relation.MsgSchedulePending, db.status("s3"))
+	assert.Equal(t, relation.MsgScheduleCanceled, db.status("s4"))
+}
+
+func TestDispatchScheduledMsgsReclaim(t *testing.T) {
+	db := newMemScheduleDatabase(&relation.MsgScheduleModel{ScheduleID: "s1", Status: relation.MsgSchedulePending, ScheduleTime: time.Now()})
+	db.finishErr = errors.New("mongo unavailable")
+	sender := &scheduleSender{sent: make(map[string]int)}
+	c := &MsgTool{scheduleDatabase: db}
+	claimTimeout := 50 * time.Millisecond
+
+	// 记录结果失败，消息停留在发送中，超时前不重新发送
+	c.dispatchScheduledMsgs(context.Background(), 10, claimTimeout, sender.send)
+	assert.Equal(t, relation.MsgScheduleSending, db.status("s1"))
+	c.dispatchScheduledMsgs(context.Background(), 10, claimTimeout, sender.send)
+	assert.Equal(t, 1, sender.sent["s1"])
+
+	// 超时后重新抢占发送
+	time.Sleep(2 * claimTimeout)
+	db.finishErr = nil
+	c.dispatchScheduledMsgs(context.Background(), 10, claimTimeout, sender.send)
+	assert.Equal(t, 2, sender.sent["s1"])
+	assert.Equal(t, relation.MsgScheduleSent, db.status("s1"))
+}
@@ -58,6 +58,12 @@ type SendMsg struct {
 
 	// OfflinePushInfo contains information for offline push notifications.
 	OfflinePushInfo *sdkws.OfflinePushInfo `json:"offlinePushInfo"`
+
+	// ThreadRootSeq is the seq of the message replied to in a thread, 0 if not a thread reply.
+	ThreadRootSeq int64 `json:"threadRootSeq"`
+
+	// ScheduleTime is a future timestamp in milliseconds to send the message at, 0 sends it now.
+	ScheduleTime int64 `json:"scheduleTime"`
 }
 
 // SendMsgReq extends SendMsg with the requirement of RecvID when SessionType indicates a one-on-one or notification chat.
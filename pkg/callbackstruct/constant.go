@@ -20,6 +20,8 @@ const CallbackAfterSetGroupInfoCommand = "callbackAfterSetGroupInfoCommand"
 const CallbackBeforeSetGroupInfoCommand = "callbackBeforeSetGroupInfoCommand"
 
 const CallbackAfterRevokeMsgCommand = "callbackBeforeAfterMsgCommand"
+const CallbackBeforeModifyMsgCommand = "callbackBeforeModifyMsgCommand"
+const CallbackAfterModifyMsgCommand = "callbackAfterModifyMsgCommand"
 const CallbackBeforeAddBlackCommand = "callbackBeforeAddBlackCommand"
 const CallbackAfterAddFriendCommand = "callbackAfterAddFriendCommand"
 const CallbackBeforeAddFriendAgreeCommand = "callbackBeforeAddFriendAgreeCommand"
@@ -52,6 +54,7 @@ const (
 	CallbackUserOnlineCommand               = "callbackUserOnlineCommand"
 	CallbackUserOfflineCommand              = "callbackUserOfflineCommand"
 	CallbackUserKickOffCommand              = "callbackUserKickOffCommand"
+	CallbackUserDeviceEvictedCommand        = "callbackUserDeviceEvictedCommand"
 	CallbackOfflinePushCommand              = "callbackOfflinePushCommand"
 	CallbackOnlinePushCommand               = "callbackOnlinePushCommand"
 	CallbackSuperGroupOnlinePushCommand     = "callbackSuperGroupOnlinePushCommand"
@@ -44,3 +44,19 @@ type CallbackUserKickOffReq struct {
 type CallbackUserKickOffResp struct {
 	CommonCallbackResp
 }
+
+type CallbackUserDeviceEvictedReq struct {
+	UserStatusCallbackReq
+	Seq int64 `json:"seq"`
+	// when the evicted session logged in, unix milliseconds
+	LoginTime int64 `json:"loginTime"`
+	// the login that exceeded the limit
+	NewPlatformID int    `json:"newPlatformID"`
+	NewConnID     string `json:"newConnID"`
+	// total or the terminal class whose limit was exceeded
+	Reason string `json:"reason"`
+}
+
+type CallbackUserDeviceEvictedResp struct {
+	CommonCallbackResp
+}
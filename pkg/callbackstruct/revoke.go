@@ -23,3 +23,32 @@ type CallbackAfterRevokeMsgReq struct {
 type CallbackAfterRevokeMsgResp struct {
 	CommonCallbackResp
 }
+
+type CallbackBeforeModifyMsgReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	ConversationID  string `json:"conversationID"`
+	Seq             int64  `json:"seq"`
+	UserID          string `json:"userID"`
+	SendID          string `json:"sendID"`
+	ContentType     int32  `json:"contentType"`
+	OldContent      string `json:"oldContent"`
+	Content         string `json:"content"`
+}
+type CallbackBeforeModifyMsgResp struct {
+	CommonCallbackResp
+	Content *string `json:"content"`
+}
+
+type CallbackAfterModifyMsgReq struct {
+	CallbackCommand `json:"callbackCommand"`
+	ConversationID  string `json:"conversationID"`
+	Seq             int64  `json:"seq"`
+	UserID          string `json:"userID"`
+	SendID          string `json:"sendID"`
+	ContentType     int32  `json:"contentType"`
+	Content         string `json:"content"`
+	ModifyTime      int64  `json:"modifyTime"`
+}
+type CallbackAfterModifyMsgResp struct {
+	CommonCallbackResp
+}
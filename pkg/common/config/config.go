@@ -31,6 +31,85 @@ type CallBackConfig struct {
 	CallbackFailedContinue *bool `yaml:"failedContinue"`
 }
 
+// RateLimitConf is a token bucket refilled at Rate tokens per second, a zero Rate means unlimited.
+type RateLimitConf struct {
+	Rate  float64 `yaml:"rate"`
+	Burst int     `yaml:"burst"`
+}
+
+type WsRateLimit struct {
+	Enable        bool                    `yaml:"enable"`
+	Conn          RateLimitConf           `yaml:"conn"`
+	User          RateLimitConf           `yaml:"user"`
+	ReqIdentifier map[int32]RateLimitConf `yaml:"reqIdentifier"`
+	// Conversation limits the ephemeral signals of each conversation on a gateway node.
+	Conversation RateLimitConf `yaml:"conversation"`
+}
+
+// MsgInterceptor applies the named interceptor to the messages of SessionTypes and ContentTypes, empty matches all.
+type MsgInterceptor struct {
+	Name         string  `yaml:"name"`
+	Enable       bool    `yaml:"enable"`
+	SessionTypes []int32 `yaml:"sessionTypes"`
+	ContentTypes []int32 `yaml:"contentTypes"`
+}
+
+// SensitiveNormalize folds text and sensitive words the same way before they are matched, so that
+// matches survive case, full-width letters, homoglyphs, inserted separators, traditional characters
+// and, with a pinyin table, pinyin spellings; matches are masked at their original positions.
+type SensitiveNormalize struct {
+	FoldCase        bool `yaml:"foldCase"`
+	FoldWidth       bool `yaml:"foldWidth"`
+	FoldHomoglyph   bool `yaml:"foldHomoglyph"`
+	StripSeparators bool `yaml:"stripSeparators"`
+	Traditional     bool `yaml:"traditional"`
+	// extra "traditional simplified" lines added to the built-in table
+	TraditionalDict string `yaml:"traditionalDict"`
+	Pinyin          bool   `yaml:"pinyin"`
+	// "character pinyin" lines, required by Pinyin
+	PinyinDict string `yaml:"pinyinDict"`
+}
+
+// EventBus carries moderation and search side channel events: sensitive word hits, brush limit
+// triggers and messages to index. Topics map an event type to a redis key or kafka topic, the
+// defaults are the redis lists the events used to be pushed to.
+type EventBus struct {
+	// redisList, redisStream or kafka
+	Driver string `yaml:"driver"`
+	// approximate number of events kept per redis list or stream, 0 keeps all
+	MaxLen int64 `yaml:"maxLen"`
+	// redisList only: push bare payloads instead of versioned events for consumers of the old lists
+	LegacyPayload bool `yaml:"legacyPayload"`
+	// redisStream only: events still failing after this many deliveries are moved to the
+	// "<topic>:dead" stream, 0 uses 10
+	MaxDeliveries int64             `yaml:"maxDeliveries"`
+	Topics        map[string]string `yaml:"topics"`
+}
+
+// ServerTLS enables TLS on a listening port, the certificate files are checked for changes
+// every reloadInterval seconds and reloaded without dropping established connections.
+type ServerTLS struct {
+	Enable         bool   `yaml:"enable"`
+	Cert           string `yaml:"cert"`
+	Key            string `yaml:"key"`
+	KeyPwd         string `yaml:"keyPwd"`
+	ReloadInterval int    `yaml:"reloadInterval"`
+}
+
+type SdkVersionConf struct {
+	Min         string `yaml:"min"`
+	Recommended string `yaml:"recommended"`
+}
+
+type WsSdkVersion struct {
+	// Required rejects handshakes without sdkVersion.
+	Required bool `yaml:"required"`
+	// Platforms is keyed by platform name, e.g. IOS, Android, Web.
+	Platforms map[string]SdkVersionConf `yaml:"platforms"`
+	// MetricVersions are the major.minor versions labelled in the connection metrics, the others are labelled other.
+	MetricVersions []string `yaml:"metricVersions"`
+}
+
 type NotificationConf struct {
 	IsSendMsg        bool         `yaml:"isSendMsg"`
 	ReliabilityLevel int          `yaml:"reliabilityLevel"` // 1 online 2 persistent
@@ -124,8 +203,9 @@ type configStruct struct {
 	} `yaml:"rpc"`
 
 	Api struct {
-		OpenImApiPort []int  `yaml:"openImApiPort"`
-		ListenIP      string `yaml:"listenIP"`
+		OpenImApiPort []int     `yaml:"openImApiPort"`
+		ListenIP      string    `yaml:"listenIP"`
+		TLS           ServerTLS `yaml:"tls"`
 	} `yaml:"api"`
 
 	Object struct {
@@ -209,6 +289,24 @@ type configStruct struct {
 		WebsocketMaxMsgLen       int   `yaml:"websocketMaxMsgLen"`
 		WebsocketTimeout         int   `yaml:"websocketTimeout"`
 		WebsocketWriteBufferSize int   `yaml:"websocketWriteBufferSize"`
+		// per connection send queue, slowConsumerPolicy is drop or close
+		WebsocketSendQueueSize      int         `yaml:"websocketSendQueueSize"`
+		WebsocketSendQueueHighWater int         `yaml:"websocketSendQueueHighWater"`
+		WebsocketSlowConsumerPolicy string      `yaml:"websocketSlowConsumerPolicy"`
+		WebsocketUserMapShards      int         `yaml:"websocketUserMapShards"`
+		RateLimit                   WsRateLimit `yaml:"rateLimit"`
+		SessionResume               struct {
+			Enable          bool  `yaml:"enable"`
+			Window          int   `yaml:"window"`
+			MaxBufferedMsgs int64 `yaml:"maxBufferedMsgs"`
+		} `yaml:"sessionResume"`
+		PushAck struct {
+			Enable              bool `yaml:"enable"`
+			Timeout             int  `yaml:"timeout"`
+			DeliveryStateExpire int  `yaml:"deliveryStateExpire"`
+		} `yaml:"pushAck"`
+		SdkVersion WsSdkVersion `yaml:"sdkVersion"`
+		TLS        ServerTLS    `yaml:"tls"`
 	} `yaml:"longConnSvr"`
 
 	Push struct {
@@ -242,6 +340,12 @@ type configStruct struct {
 		Nickname []string `yaml:"nickname"`
 	} `yaml:"im-admin"`
 
+	// caps of the DeviceCountLimit multi login policy, zero is unlimited
+	MultiLoginDeviceLimit struct {
+		Total    int            `yaml:"total"`
+		Terminal map[string]int `yaml:"terminal"`
+	} `yaml:"multiLoginDeviceLimit"`
+
 	MultiLoginPolicy                  int    `yaml:"multiLoginPolicy"`
 	ChatPersistenceMysql              bool   `yaml:"chatPersistenceMysql"`
 	MsgCacheTimeout                   int    `yaml:"msgCacheTimeout"`
@@ -258,6 +362,53 @@ type configStruct struct {
 	MessageVerify struct {
 		FriendVerify *bool `yaml:"friendVerify"`
 	} `yaml:"messageVerify"`
+	// senders may modify their messages of contentTypes within timeWindow seconds, 0 is unlimited;
+	// app managers may modify any message at any time
+	MsgModify struct {
+		Enable       bool    `yaml:"enable"`
+		TimeWindow   int64   `yaml:"timeWindow"`
+		ContentTypes []int32 `yaml:"contentTypes"`
+	} `yaml:"msgModify"`
+	MsgReaction struct {
+		Enable     bool `yaml:"enable"`
+		MaxPerUser int  `yaml:"maxPerUser"`
+		MaxPerMsg  int  `yaml:"maxPerMsg"`
+	} `yaml:"msgReaction"`
+	MsgThread struct {
+		Enable bool `yaml:"enable"`
+	} `yaml:"msgThread"`
+	// scheduled messages are dispatched by the cron task every dispatchTime (cron spec)
+	MsgSchedule struct {
+		Enable       bool   `yaml:"enable"`
+		DispatchTime string `yaml:"dispatchTime"`
+		MaxAhead     int64  `yaml:"maxAhead"`
+		MaxPending   int64  `yaml:"maxPending"`
+		BatchSize    int64  `yaml:"batchSize"`
+		ClaimTimeout int64  `yaml:"claimTimeout"`
+	} `yaml:"msgSchedule"`
+	MsgPin struct {
+		Enable             bool `yaml:"enable"`
+		MaxPerConversation int  `yaml:"maxPerConversation"`
+	} `yaml:"msgPin"`
+	MsgSearch struct {
+		Enable        bool `yaml:"enable"`
+		MaxKeywordLen int  `yaml:"maxKeywordLen"`
+	} `yaml:"msgSearch"`
+	MsgForward struct {
+		Enable     bool `yaml:"enable"`
+		MaxMsgs    int  `yaml:"maxMsgs"`
+		MaxTargets int  `yaml:"maxTargets"`
+	} `yaml:"msgForward"`
+	// run in order by SendMsg, the default chain applies when empty
+	MsgInterceptors []MsgInterceptor `yaml:"msgInterceptors"`
+	// scopes of the brushLimit interceptor: user, group, single
+	BrushLimit struct {
+		Scopes []string `yaml:"scopes"`
+	} `yaml:"brushLimit"`
+	// sensitive word policy, reject or replace, of profile fields, unlisted fields are not filtered
+	SensitiveFields    map[string]string  `yaml:"sensitiveFields"`
+	SensitiveNormalize SensitiveNormalize `yaml:"sensitiveNormalize"`
+	EventBus           EventBus           `yaml:"eventBus"`
 
 	IOSPush struct {
 		PushSound  string `yaml:"pushSound"`
@@ -276,6 +427,7 @@ type configStruct struct {
 		CallbackUserOnline                 CallBackConfig `yaml:"userOnline"`
 		CallbackUserOffline                CallBackConfig `yaml:"userOffline"`
 		CallbackUserKickOff                CallBackConfig `yaml:"userKickOff"`
+		CallbackUserDeviceEvicted          CallBackConfig `yaml:"userDeviceEvicted"`
 		CallbackOfflinePush                CallBackConfig `yaml:"offlinePush"`
 		CallbackOnlinePush                 CallBackConfig `yaml:"onlinePush"`
 		CallbackBeforeSuperGroupOnlinePush CallBackConfig `yaml:"superGroupOnlinePush"`
@@ -303,6 +455,8 @@ type configStruct struct {
 		CallbackAfterSetGroupInfo          CallBackConfig `yaml:"setGroupInfoAfter"`
 		CallbackBeforeSetGroupInfo         CallBackConfig `yaml:"setGroupInfoBefore"`
 		CallbackAfterRevokeMsg             CallBackConfig `yaml:"revokeMsgAfter"`
+		CallbackBeforeModifyMsg            CallBackConfig `yaml:"modifyMsgBefore"`
+		CallbackAfterModifyMsg             CallBackConfig `yaml:"modifyMsgAfter"`
 		CallbackBeforeAddBlack             CallBackConfig `yaml:"addBlackBefore"`
 		CallbackAfterAddFriend             CallBackConfig `yaml:"addFriendAfter"`
 		CallbackBeforeAddFriendAgree       CallBackConfig `yaml:"addFriendAgreeBefore"`
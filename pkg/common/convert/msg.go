@@ -15,10 +15,15 @@
 package convert
 
 import (
+	"encoding/json"
+
 	"github.com/OpenIMSDK/protocol/constant"
 	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/utils"
 
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
 	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+	"github.com/openimsdk/open-im-server/v3/sdk_struct"
 )
 
 func MsgPb2DB(msg *sdkws.MsgData) *unrelation.MsgDataModel {
@@ -95,5 +100,90 @@ func MsgDB2Pb(msgModel *unrelation.MsgDataModel) *sdkws.MsgData {
 	msg.AtUserIDList = msgModel.AtUserIDList
 	msg.AttachedInfo = msgModel.AttachedInfo
 	msg.Ex = msgModel.Ex
+	msg.ThreadRootSeq = msgModel.ThreadRootSeq
 	return &msg
 }
+
+func MsgPinsDB2Pb(pins []*relation.MsgPinModel) []*sdkws.PinnedMsg {
+	res := make([]*sdkws.PinnedMsg, 0, len(pins))
+	for _, pin := range pins {
+		res = append(res, &sdkws.PinnedMsg{
+			Seq:       pin.Seq,
+			PinUserID: pin.PinUserID,
+			PinTime:   pin.PinTime.UnixMilli(),
+		})
+	}
+	return res
+}
+
+// MsgPb2SdkStruct 转为客户端的消息结构，用于合并转发的聊天记录，content 按消息类型解析到对应的 elem
+func MsgPb2SdkStruct(msg *sdkws.MsgData) (*sdk_struct.MsgStruct, error) {
+	res := &sdk_struct.MsgStruct{
+		ClientMsgID:      msg.ClientMsgID,
+		ServerMsgID:      msg.ServerMsgID,
+		CreateTime:       msg.CreateTime,
+		SendTime:         msg.SendTime,
+		SessionType:      msg.SessionType,
+		SendID:           msg.SendID,
+		RecvID:           msg.RecvID,
+		MsgFrom:          msg.MsgFrom,
+		ContentType:      msg.ContentType,
+		SenderPlatformID: msg.SenderPlatformID,
+		SenderNickname:   msg.SenderNickname,
+		SenderFaceURL:    msg.SenderFaceURL,
+		GroupID:          msg.GroupID,
+		Content:          string(msg.Content),
+		Seq:              msg.Seq,
+		Status:           msg.Status,
+		AttachedInfo:     msg.AttachedInfo,
+		Ex:               msg.Ex,
+	}
+	var elem any
+	switch msg.ContentType {
+	case constant.Text:
+		res.TextElem = &sdk_struct.TextElem{}
+		elem = res.TextElem
+	case constant.Picture:
+		res.PictureElem = &sdk_struct.PictureElem{}
+		elem = res.PictureElem
+	case constant.Voice:
+		res.SoundElem = &sdk_struct.SoundElem{}
+		elem = res.SoundElem
+	case constant.Video:
+		res.VideoElem = &sdk_struct.VideoElem{}
+		elem = res.VideoElem
+	case constant.File:
+		res.FileElem = &sdk_struct.FileElem{}
+		elem = res.FileElem
+	case constant.AtText:
+		res.AtTextElem = &sdk_struct.AtTextElem{}
+		elem = res.AtTextElem
+	case constant.Merger:
+		res.MergeElem = &sdk_struct.MergeElem{}
+		elem = res.MergeElem
+	case constant.Card:
+		res.CardElem = &sdk_struct.CardElem{}
+		elem = res.CardElem
+	case constant.Location:
+		res.LocationElem = &sdk_struct.LocationElem{}
+		elem = res.LocationElem
+	case constant.Custom:
+		res.CustomElem = &sdk_struct.CustomElem{}
+		elem = res.CustomElem
+	case constant.Quote:
+		res.QuoteElem = &sdk_struct.QuoteElem{}
+		elem = res.QuoteElem
+	case constant.Face:
+		res.FaceElem = &sdk_struct.FaceElem{}
+		elem = res.FaceElem
+	case constant.AdvancedText:
+		res.AdvancedTextElem = &sdk_struct.AdvancedTextElem{}
+		elem = res.AdvancedTextElem
+	default:
+		return res, nil
+	}
+	if err := json.Unmarshal(msg.Content, elem); err != nil {
+		return nil, utils.Wrap(err, "unmarshal msg content")
+	}
+	return res, nil
+}
@@ -53,7 +53,7 @@ func NewRedis() (redis.UniversalClient, error) {
 	}
 	specialerror.AddReplace(redis.Nil, errs.ErrRecordNotFound)
 	var rdb redis.UniversalClient
-	if len(config.Config.Redis.Address) > 1 || config.Config.Redis.ClusterMode {
+	if isRedisCluster() {
 		rdb = redis.NewClusterClient(&redis.ClusterOptions{
 			Addrs:      config.Config.Redis.Address,
 			Username:   config.Config.Redis.Username,
@@ -83,6 +83,11 @@ func NewRedis() (redis.UniversalClient, error) {
 	return rdb, err
 }
 
+// isRedisCluster reports whether NewRedis connects to a redis cluster.
+func isRedisCluster() bool {
+	return len(config.Config.Redis.Address) > 1 || config.Config.Redis.ClusterMode
+}
+
 // overrideConfigFromEnv overrides configuration fields with environment variables if present.
 func overrideConfigFromEnv() {
 	if envAddr := os.Getenv("REDIS_ADDRESS"); envAddr != "" {
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"github.com/OpenIMSDK/tools/mw/specialerror"
 	"golang.org/x/sync/errgroup"
+	"strings"
 	"sync"
 	"time"
 
@@ -227,6 +228,123 @@ func batchGetCache2[T any, K comparable](
 	return res, nil
 }
 
+// batchGetCacheMap reads the keys with one redis round trip and loads all missed keys with one fn call,
+// keys missing from the fn result are cached as empty and left out of the result. In cluster mode the
+// keys are read per hash tag, keys sharing a hash tag are still read together.
+func batchGetCacheMap[K comparable, V any](
+	ctx context.Context,
+	rcClient *rockscache.Client,
+	expire time.Duration,
+	keys []K,
+	keyFn func(key K) string,
+	fn func(ctx context.Context, keys []K) (map[K]V, error),
+) (map[K]V, error) {
+	keys = utils.Distinct(keys)
+	res := make(map[K]V, len(keys))
+	if len(keys) == 0 {
+		return res, nil
+	}
+	groups := map[string][]K{"": keys}
+	if isRedisCluster() {
+		groups = make(map[string][]K)
+		for _, key := range keys {
+			tag := redisHashTag(keyFn(key))
+			groups[tag] = append(groups[tag], key)
+		}
+	}
+	var mu sync.Mutex
+	wg := errgroup.Group{}
+	wg.SetLimit(utils.WaitGroupSetLimit(len(groups)))
+	for _, group := range groups {
+		group := group
+		wg.Go(func() error {
+			values, err := fetchBatchCache(ctx, rcClient, expire, group, keyFn, fn)
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			for k, v := range values {
+				res[k] = v
+			}
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := wg.Wait(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func fetchBatchCache[K comparable, V any](
+	ctx context.Context,
+	rcClient *rockscache.Client,
+	expire time.Duration,
+	keys []K,
+	keyFn func(key K) string,
+	fn func(ctx context.Context, keys []K) (map[K]V, error),
+) (map[K]V, error) {
+	cacheKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		cacheKeys = append(cacheKeys, keyFn(key))
+	}
+	var loaded map[K]V
+	values, err := rcClient.FetchBatch2(ctx, cacheKeys, expire, func(idxs []int) (map[int]string, error) {
+		missed := make([]K, 0, len(idxs))
+		for _, idx := range idxs {
+			missed = append(missed, keys[idx])
+		}
+		var err error
+		loaded, err = fn(ctx, missed)
+		if err != nil {
+			return nil, err
+		}
+		m := make(map[int]string, len(idxs))
+		for _, idx := range idxs {
+			v, ok := loaded[keys[idx]]
+			if !ok {
+				continue
+			}
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return nil, utils.Wrap(err, "")
+			}
+			m[idx] = string(bs)
+		}
+		return m, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[K]V, len(values))
+	for idx, value := range values {
+		if v, ok := loaded[keys[idx]]; ok {
+			res[keys[idx]] = v
+			continue
+		}
+		if value == "" {
+			continue
+		}
+		var v V
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			log.ZError(ctx, "cache json.Unmarshal failed", err, "key", cacheKeys[idx], "value", value, "expire", expire)
+			return nil, utils.Wrap(err, "")
+		}
+		res[keys[idx]] = v
+	}
+	return res, nil
+}
+
+// redisHashTag returns the part of the key that decides its cluster slot.
+func redisHashTag(key string) string {
+	if start := strings.IndexByte(key, '{'); start >= 0 {
+		if end := strings.IndexByte(key[start+1:], '}'); end > 0 {
+			return key[start+1 : start+1+end]
+		}
+	}
+	return key
+}
+
 func batchGetCacheWaitGroup[T any, K comparable](
 	ctx context.Context,
 	rcClient *rockscache.Client,
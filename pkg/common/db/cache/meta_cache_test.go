@@ -0,0 +1,76 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisHashTag(t *testing.T) {
+	assert.Equal(t, "sg_g1", redisHashTag("MSG_REACTION_COUNT:{sg_g1}:1"))
+	assert.Equal(t, "MSG_PIN:sg_g1", redisHashTag("MSG_PIN:sg_g1"))
+	assert.Equal(t, "a{}b", redisHashTag("a{}b"))
+	m := &MsgReactionCacheRedis{}
+	assert.Equal(t, redisHashTag(m.getReactionCountKey("sg_g1", 1)), redisHashTag(m.getReactionCountKey("sg_g1", 2)))
+	th := &MsgThreadCacheRedis{}
+	assert.Equal(t, redisHashTag(th.getThreadKey("sg_g1", 1)), redisHashTag(th.getThreadKey("sg_g1", 2)))
+}
+
+func TestBatchGetCacheMap(t *testing.T) {
+	rdb := redis.NewClient(&redis.Options{DB: 15, DialTimeout: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		t.Skip("redis is not available:", err)
+	}
+	keyFn := func(key int64) string { return "TEST_BATCH_CACHE:{test}:" + strconv.FormatInt(key, 10) }
+	t.Cleanup(func() {
+		rdb.Del(context.Background(), keyFn(1), keyFn(2), keyFn(3))
+		rdb.Close()
+	})
+	rcClient := rockscache.NewClient(rdb, GetDefaultOpt())
+
+	var calls [][]int64
+	fn := func(_ context.Context, keys []int64) (map[int64]string, error) {
+		calls = append(calls, keys)
+		res := make(map[int64]string)
+		for _, key := range keys {
+			if key != 3 {
+				res[key] = "v" + strconv.FormatInt(key, 10)
+			}
+		}
+		return res, nil
+	}
+	res, err := batchGetCacheMap(context.Background(), rcClient, time.Minute, []int64{1, 2, 3, 1}, keyFn, fn)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{1: "v1", 2: "v2"}, res)
+	require.Len(t, calls, 1)
+	assert.ElementsMatch(t, []int64{1, 2, 3}, calls[0])
+
+	// 命中缓存不再回源
+	res, err = batchGetCacheMap(context.Background(), rcClient, time.Minute, []int64{1, 2, 3}, keyFn, fn)
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]string{1: "v1", 2: "v2"}, res)
+	assert.Len(t, calls, 1)
+}
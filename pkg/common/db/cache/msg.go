@@ -16,7 +16,6 @@ package cache
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"github.com/OpenIMSDK/tools/mcontext"
 	"github.com/openimsdk/open-im-server/v3/pkg/msgprocessor"
@@ -62,9 +61,6 @@ const (
 	uidPidToken             = "UID_PID_TOKEN_STATUS:"
 
 	revokeMsgConversationId = "REVOKE_CONVERSATION_ID:"
-
-	msgToEsMQKey       = "live_admin:es:msg"  // 消息入es消费
-	revokeMsgToEsMQKey = "openIm:revoke:list" // 撤回消息入es消费
 )
 
 type SeqCache interface {
@@ -82,6 +78,7 @@ type SeqCache interface {
 	SetConversationUserMinSeqs(ctx context.Context, conversationID string, seqs map[string]int64) (err error)
 	// seqs map: key conversationID value minSeq
 	SetUserConversationsMinSeqs(ctx context.Context, userID string, seqs map[string]int64) error
+	GetUserConversationsMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	// has read seq
 	SetHasReadSeq(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error
 	// k: user, v: seq
@@ -108,11 +105,13 @@ type thirdCache interface {
 type MsgModel interface {
 	SeqCache
 	thirdCache
+	SessionResumeCache
+	MsgDeliveryCache
 	GetReds() redis.UniversalClient // 获取Redis实例
-	MsgToEsMQ(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
-	RevokeMsgToEsMQ(ctx context.Context, _id string) error
 	AddTokenFlag(ctx context.Context, userID string, platformID int, token string, flag int) error
 	GetTokensWithoutError(ctx context.Context, userID string, platformID int) (map[string]int, error)
+	// GetAllPlatformTokens returns the tokens of every platform of the user, keyed by platformID
+	GetAllPlatformTokens(ctx context.Context, userID string) (map[int]map[string]int, error)
 	SetTokenMapByUidPid(ctx context.Context, userID string, platformID int, m map[string]int) error
 	DeleteTokenByUidPid(ctx context.Context, userID string, platformID int, fields []string) error
 	GetMessagesBySeq(ctx context.Context, conversationID string, seqs []int64) (seqMsg []*sdkws.MsgData, failedSeqList []int64, err error)
@@ -187,20 +186,6 @@ func (c *msgCache) GetReds() redis.UniversalClient {
 	return c.rdb
 }
 
-// MsgToEsMQ 消息推送至ES队列
-func (c *msgCache) MsgToEsMQ(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
-	var esMq = make(map[string]interface{})
-	esMq["conversationId"] = conversationID
-	esMq["msgs"] = msgs
-	byteMsg, _ := json.Marshal(esMq)
-	return c.rdb.LPush(ctx, msgToEsMQKey, string(byteMsg)).Err()
-}
-
-// RevokeMsgToEsMQ 撤回消息推送至ES队列
-func (c *msgCache) RevokeMsgToEsMQ(ctx context.Context, _id string) error {
-	return c.rdb.LPush(ctx, revokeMsgToEsMQKey, _id).Err()
-}
-
 func (c *msgCache) SetMaxSeq(ctx context.Context, conversationID string, maxSeq int64) error {
 	return c.setSeq(ctx, conversationID, maxSeq, c.getMaxSeqKey)
 }
@@ -272,6 +257,12 @@ func (c *msgCache) SetUserConversationsMinSeqs(ctx context.Context, userID strin
 	})
 }
 
+func (c *msgCache) GetUserConversationsMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	return c.getSeqs(ctx, conversationIDs, func(conversationID string) string {
+		return c.getConversationUserMinSeqKey(conversationID, userID)
+	})
+}
+
 func (c *msgCache) SetHasReadSeq(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error {
 	return utils.Wrap1(c.rdb.Set(ctx, c.getHasReadSeqKey(conversationID, userID), hasReadSeq, 0).Err())
 }
@@ -318,6 +309,26 @@ func (c *msgCache) GetTokensWithoutError(ctx context.Context, userID string, pla
 	return mm, nil
 }
 
+func (c *msgCache) GetAllPlatformTokens(ctx context.Context, userID string) (map[int]map[string]int, error) {
+	pipe := c.rdb.Pipeline()
+	cmds := make(map[int]*redis.MapStringStringCmd, len(constant.PlatformID2Name))
+	for platformID, platform := range constant.PlatformID2Name {
+		cmds[platformID] = pipe.HGetAll(ctx, uidPidToken+userID+":"+platform)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	res := make(map[int]map[string]int, len(cmds))
+	for platformID, cmd := range cmds {
+		m := make(map[string]int)
+		for k, v := range cmd.Val() {
+			m[k] = utils.StringToInt(v)
+		}
+		res[platformID] = m
+	}
+	return res, nil
+}
+
 func (c *msgCache) SetTokenMapByUidPid(ctx context.Context, userID string, platform int, m map[string]int) error {
 	key := uidPidToken + userID + ":" + constant.PlatformIDToName(platform)
 	mm := make(map[string]any)
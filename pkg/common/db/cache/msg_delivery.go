@@ -0,0 +1,95 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/OpenIMSDK/tools/errs"
+)
+
+const msgDeliveryState = "MSG_DELIVERY_STATE:"
+
+const (
+	// Per-recipient delivery states of a pushed message.
+	MsgDeliveryPushed        = 1
+	MsgDeliveryAcked         = 2
+	MsgDeliveryOfflinePushed = 3
+)
+
+type MsgDeliveryState struct {
+	ConversationID string
+	Seq            int64
+	UserID         string
+	State          int32
+	UpdateTime     int64
+}
+
+// MsgDeliveryCache records whether the recipients acked the messages pushed to them.
+type MsgDeliveryCache interface {
+	SetMsgDeliveryStates(ctx context.Context, states []*MsgDeliveryState, expire time.Duration) error
+	GetMsgDeliveryStates(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*MsgDeliveryState, error)
+}
+
+func (c *msgCache) getMsgDeliveryStateKey(conversationID string, seq int64) string {
+	return msgDeliveryState + conversationID + ":" + strconv.Itoa(int(seq))
+}
+
+func (c *msgCache) SetMsgDeliveryStates(ctx context.Context, states []*MsgDeliveryState, expire time.Duration) error {
+	if len(states) == 0 {
+		return nil
+	}
+	pipe := c.rdb.Pipeline()
+	for _, state := range states {
+		key := c.getMsgDeliveryStateKey(state.ConversationID, state.Seq)
+		value := strconv.Itoa(int(state.State)) + ":" + strconv.FormatInt(state.UpdateTime, 10)
+		pipe.HSet(ctx, key, state.UserID, value)
+		pipe.Expire(ctx, key, expire)
+	}
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) GetMsgDeliveryStates(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*MsgDeliveryState, error) {
+	pipe := c.rdb.Pipeline()
+	cmds := make([]*redis.MapStringStringCmd, len(seqs))
+	for i, seq := range seqs {
+		cmds[i] = pipe.HGetAll(ctx, c.getMsgDeliveryStateKey(conversationID, seq))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, errs.Wrap(err)
+	}
+	states := make(map[int64][]*MsgDeliveryState, len(seqs))
+	for i, seq := range seqs {
+		for userID, value := range cmds[i].Val() {
+			stateStr, updateTimeStr, _ := strings.Cut(value, ":")
+			state, _ := strconv.Atoi(stateStr)
+			updateTime, _ := strconv.ParseInt(updateTimeStr, 10, 64)
+			states[seq] = append(states[seq], &MsgDeliveryState{
+				ConversationID: conversationID,
+				Seq:            seq,
+				UserID:         userID,
+				State:          int32(state),
+				UpdateTime:     updateTime,
+			})
+		}
+	}
+	return states, nil
+}
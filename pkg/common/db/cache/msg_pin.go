@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/redis/go-redis/v9"
+
+	relationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+const (
+	msgPinKey    = "MSG_PIN:"
+	msgPinExpire = time.Second * 60 * 60 * 12
+)
+
+// MsgPinCache 缓存会话的置顶消息列表，置顶变更时删除.
+type MsgPinCache interface {
+	metaCache
+	NewCache() MsgPinCache
+	GetPins(ctx context.Context, conversationID string) (pins []*relationtb.MsgPinModel, err error)
+	// GetConversationsPins 批量获取，没有置顶的会话不在结果中
+	GetConversationsPins(ctx context.Context, conversationIDs []string) (pins map[string][]*relationtb.MsgPinModel, err error)
+	DelPins(conversationIDs ...string) MsgPinCache
+}
+
+type MsgPinCacheRedis struct {
+	metaCache
+	expireTime time.Duration
+	rcClient   *rockscache.Client
+	msgPinDB   relationtb.MsgPinModelInterface
+}
+
+func NewMsgPinCacheRedis(
+	rdb redis.UniversalClient,
+	msgPinDB relationtb.MsgPinModelInterface,
+	options rockscache.Options,
+) MsgPinCache {
+	rcClient := rockscache.NewClient(rdb, options)
+
+	return &MsgPinCacheRedis{
+		expireTime: msgPinExpire,
+		rcClient:   rcClient,
+		metaCache:  NewMetaCacheRedis(rcClient),
+		msgPinDB:   msgPinDB,
+	}
+}
+
+func (m *MsgPinCacheRedis) NewCache() MsgPinCache {
+	return &MsgPinCacheRedis{
+		expireTime: m.expireTime,
+		rcClient:   m.rcClient,
+		msgPinDB:   m.msgPinDB,
+		metaCache:  NewMetaCacheRedis(m.rcClient, m.metaCache.GetPreDelKeys()...),
+	}
+}
+
+func (m *MsgPinCacheRedis) getPinKey(conversationID string) string {
+	return msgPinKey + conversationID
+}
+
+func (m *MsgPinCacheRedis) GetPins(ctx context.Context, conversationID string) ([]*relationtb.MsgPinModel, error) {
+	return getCache(
+		ctx,
+		m.rcClient,
+		m.getPinKey(conversationID),
+		m.expireTime,
+		func(ctx context.Context) ([]*relationtb.MsgPinModel, error) {
+			return m.msgPinDB.FindByConversationID(ctx, conversationID)
+		},
+	)
+}
+
+func (m *MsgPinCacheRedis) GetConversationsPins(ctx context.Context, conversationIDs []string) (map[string][]*relationtb.MsgPinModel, error) {
+	pins, err := batchGetCacheMap(
+		ctx,
+		m.rcClient,
+		m.expireTime,
+		conversationIDs,
+		m.getPinKey,
+		func(ctx context.Context, conversationIDs []string) (map[string][]*relationtb.MsgPinModel, error) {
+			pins, err := m.msgPinDB.FindByConversationIDs(ctx, conversationIDs)
+			if err != nil {
+				return nil, err
+			}
+			// 没有置顶的会话同样缓存
+			for _, conversationID := range conversationIDs {
+				if _, ok := pins[conversationID]; !ok {
+					pins[conversationID] = []*relationtb.MsgPinModel{}
+				}
+			}
+			return pins, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for conversationID, ps := range pins {
+		if len(ps) == 0 {
+			delete(pins, conversationID)
+		}
+	}
+	return pins, nil
+}
+
+func (m *MsgPinCacheRedis) DelPins(conversationIDs ...string) MsgPinCache {
+	cache := m.NewCache()
+	for _, conversationID := range conversationIDs {
+		cache.AddKeys(m.getPinKey(conversationID))
+	}
+
+	return cache
+}
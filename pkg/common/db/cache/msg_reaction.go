@@ -0,0 +1,132 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/redis/go-redis/v9"
+
+	relationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+const (
+	msgReactionCountKey    = "MSG_REACTION_COUNT:"
+	msgReactionCountExpire = time.Second * 60 * 60 * 12
+)
+
+// MsgReactionCache 缓存每条消息的回应数，回应变更时删除.
+type MsgReactionCache interface {
+	metaCache
+	NewCache() MsgReactionCache
+	GetReactionCounts(ctx context.Context, conversationID string, seq int64) (counts []*relationtb.MsgReactionCountModel, err error)
+	// GetReactionCountsBySeqs 批量获取，没有回应的消息不在结果中
+	GetReactionCountsBySeqs(ctx context.Context, conversationID string, seqs []int64) (counts map[int64][]*relationtb.MsgReactionCountModel, err error)
+	DelReactionCounts(conversationID string, seqs ...int64) MsgReactionCache
+}
+
+type MsgReactionCacheRedis struct {
+	metaCache
+	expireTime    time.Duration
+	rcClient      *rockscache.Client
+	msgReactionDB relationtb.MsgReactionModelInterface
+}
+
+func NewMsgReactionCacheRedis(
+	rdb redis.UniversalClient,
+	msgReactionDB relationtb.MsgReactionModelInterface,
+	options rockscache.Options,
+) MsgReactionCache {
+	rcClient := rockscache.NewClient(rdb, options)
+
+	return &MsgReactionCacheRedis{
+		expireTime:    msgReactionCountExpire,
+		rcClient:      rcClient,
+		metaCache:     NewMetaCacheRedis(rcClient),
+		msgReactionDB: msgReactionDB,
+	}
+}
+
+func (m *MsgReactionCacheRedis) NewCache() MsgReactionCache {
+	return &MsgReactionCacheRedis{
+		expireTime:    m.expireTime,
+		rcClient:      m.rcClient,
+		msgReactionDB: m.msgReactionDB,
+		metaCache:     NewMetaCacheRedis(m.rcClient, m.metaCache.GetPreDelKeys()...),
+	}
+}
+
+// getReactionCountKey 同一会话的 key 使用相同的 hash tag，集群模式下可以批量读取
+func (m *MsgReactionCacheRedis) getReactionCountKey(conversationID string, seq int64) string {
+	return msgReactionCountKey + "{" + conversationID + "}:" + strconv.FormatInt(seq, 10)
+}
+
+func (m *MsgReactionCacheRedis) GetReactionCounts(ctx context.Context, conversationID string, seq int64) ([]*relationtb.MsgReactionCountModel, error) {
+	return getCache(
+		ctx,
+		m.rcClient,
+		m.getReactionCountKey(conversationID, seq),
+		m.expireTime,
+		func(ctx context.Context) ([]*relationtb.MsgReactionCountModel, error) {
+			return m.msgReactionDB.CountReactions(ctx, conversationID, seq)
+		},
+	)
+}
+
+func (m *MsgReactionCacheRedis) GetReactionCountsBySeqs(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*relationtb.MsgReactionCountModel, error) {
+	counts, err := batchGetCacheMap(
+		ctx,
+		m.rcClient,
+		m.expireTime,
+		seqs,
+		func(seq int64) string {
+			return m.getReactionCountKey(conversationID, seq)
+		},
+		func(ctx context.Context, seqs []int64) (map[int64][]*relationtb.MsgReactionCountModel, error) {
+			counts, err := m.msgReactionDB.CountReactionsBySeqs(ctx, conversationID, seqs)
+			if err != nil {
+				return nil, err
+			}
+			// 没有回应的消息同样缓存
+			for _, seq := range seqs {
+				if _, ok := counts[seq]; !ok {
+					counts[seq] = []*relationtb.MsgReactionCountModel{}
+				}
+			}
+			return counts, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	for seq, c := range counts {
+		if len(c) == 0 {
+			delete(counts, seq)
+		}
+	}
+	return counts, nil
+}
+
+func (m *MsgReactionCacheRedis) DelReactionCounts(conversationID string, seqs ...int64) MsgReactionCache {
+	cache := m.NewCache()
+	for _, seq := range seqs {
+		cache.AddKeys(m.getReactionCountKey(conversationID, seq))
+	}
+
+	return cache
+}
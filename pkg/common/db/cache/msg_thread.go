@@ -0,0 +1,127 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dtm-labs/rockscache"
+	"github.com/redis/go-redis/v9"
+
+	relationtb "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+const (
+	msgThreadKey    = "MSG_THREAD:"
+	msgThreadExpire = time.Second * 60 * 60 * 12
+)
+
+// MsgThreadCache 缓存话题汇总，有新回复时删除.
+type MsgThreadCache interface {
+	metaCache
+	NewCache() MsgThreadCache
+	GetThread(ctx context.Context, conversationID string, rootSeq int64) (thread *relationtb.MsgThreadModel, err error)
+	// GetThreads 批量获取，没有回复的根消息 ReplyCount 为 0
+	GetThreads(ctx context.Context, conversationID string, rootSeqs []int64) (threads map[int64]*relationtb.MsgThreadModel, err error)
+	DelThreads(conversationID string, rootSeqs ...int64) MsgThreadCache
+}
+
+type MsgThreadCacheRedis struct {
+	metaCache
+	expireTime  time.Duration
+	rcClient    *rockscache.Client
+	msgThreadDB relationtb.MsgThreadModelInterface
+}
+
+func NewMsgThreadCacheRedis(
+	rdb redis.UniversalClient,
+	msgThreadDB relationtb.MsgThreadModelInterface,
+	options rockscache.Options,
+) MsgThreadCache {
+	rcClient := rockscache.NewClient(rdb, options)
+
+	return &MsgThreadCacheRedis{
+		expireTime:  msgThreadExpire,
+		rcClient:    rcClient,
+		metaCache:   NewMetaCacheRedis(rcClient),
+		msgThreadDB: msgThreadDB,
+	}
+}
+
+func (m *MsgThreadCacheRedis) NewCache() MsgThreadCache {
+	return &MsgThreadCacheRedis{
+		expireTime:  m.expireTime,
+		rcClient:    m.rcClient,
+		msgThreadDB: m.msgThreadDB,
+		metaCache:   NewMetaCacheRedis(m.rcClient, m.metaCache.GetPreDelKeys()...),
+	}
+}
+
+// getThreadKey 同一会话的 key 使用相同的 hash tag，集群模式下可以批量读取
+func (m *MsgThreadCacheRedis) getThreadKey(conversationID string, rootSeq int64) string {
+	return msgThreadKey + "{" + conversationID + "}:" + strconv.FormatInt(rootSeq, 10)
+}
+
+func (m *MsgThreadCacheRedis) GetThread(ctx context.Context, conversationID string, rootSeq int64) (*relationtb.MsgThreadModel, error) {
+	return getCache(
+		ctx,
+		m.rcClient,
+		m.getThreadKey(conversationID, rootSeq),
+		m.expireTime,
+		func(ctx context.Context) (*relationtb.MsgThreadModel, error) {
+			return m.msgThreadDB.Take(ctx, conversationID, rootSeq)
+		},
+	)
+}
+
+func (m *MsgThreadCacheRedis) GetThreads(ctx context.Context, conversationID string, rootSeqs []int64) (map[int64]*relationtb.MsgThreadModel, error) {
+	return batchGetCacheMap(
+		ctx,
+		m.rcClient,
+		m.expireTime,
+		rootSeqs,
+		func(rootSeq int64) string {
+			return m.getThreadKey(conversationID, rootSeq)
+		},
+		func(ctx context.Context, rootSeqs []int64) (map[int64]*relationtb.MsgThreadModel, error) {
+			threads, err := m.msgThreadDB.Find(ctx, conversationID, rootSeqs)
+			if err != nil {
+				return nil, err
+			}
+			res := make(map[int64]*relationtb.MsgThreadModel, len(rootSeqs))
+			for _, thread := range threads {
+				res[thread.RootSeq] = thread
+			}
+			// 与 Take 一致，没有回复的根消息同样缓存
+			for _, rootSeq := range rootSeqs {
+				if _, ok := res[rootSeq]; !ok {
+					res[rootSeq] = &relationtb.MsgThreadModel{ConversationID: conversationID, RootSeq: rootSeq}
+				}
+			}
+			return res, nil
+		},
+	)
+}
+
+func (m *MsgThreadCacheRedis) DelThreads(conversationID string, rootSeqs ...int64) MsgThreadCache {
+	cache := m.NewCache()
+	for _, rootSeq := range rootSeqs {
+		cache.AddKeys(m.getThreadKey(conversationID, rootSeq))
+	}
+
+	return cache
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+)
+
+const (
+	sessionResume       = "SESSION_RESUME:"
+	sessionResumeBuffer = "SESSION_RESUME_BUFFER:"
+
+	sessionResumeUserID     = "userID"
+	sessionResumePlatformID = "platformID"
+	sessionResumeOverflow   = "overflow"
+)
+
+// SessionResumeCache keeps the pushes a disconnected websocket session missed,
+// keyed by the resume token the gateway issued to it.
+type SessionResumeCache interface {
+	SetResumeSession(ctx context.Context, token string, userID string, platformID int, expire time.Duration) error
+	// AppendResumeMsg buffers msg unless the session has expired, been taken or overflowed.
+	AppendResumeMsg(ctx context.Context, token string, msg *sdkws.MsgData, maxLen int64, expire time.Duration) error
+	// TakeResumeSession returns and deletes the session, ok is false if it does not exist.
+	TakeResumeSession(ctx context.Context, token string) (userID string, platformID int, msgs []*sdkws.MsgData, overflow bool, ok bool, err error)
+}
+
+// the token is the hash tag of both keys so that they are in the same slot of a redis cluster
+func (c *msgCache) getSessionResumeKey(token string) string {
+	return sessionResume + "{" + token + "}"
+}
+
+func (c *msgCache) getSessionResumeBufferKey(token string) string {
+	return sessionResumeBuffer + "{" + token + "}"
+}
+
+// appendResumeMsgScript buffers ARGV[1] while the session exists and has not overflowed,
+// the buffer is dropped and the session marked as overflowed past ARGV[2] (unlimited if not positive) msgs.
+// Returns 0 if the msg is not buffered, 1 if buffered, 2 on overflow.
+var appendResumeMsgScript = redis.NewScript(`
+local overflow = redis.call("HGET", KEYS[1], "overflow")
+if not overflow or overflow == "1" then
+	return 0
+end
+local n = redis.call("RPUSH", KEYS[2], ARGV[1])
+if n == 1 then
+	redis.call("PEXPIRE", KEYS[2], ARGV[3])
+end
+local maxLen = tonumber(ARGV[2])
+if maxLen > 0 and n > maxLen then
+	redis.call("HSET", KEYS[1], "overflow", 1)
+	redis.call("DEL", KEYS[2])
+	return 2
+end
+return 1
+`)
+
+func (c *msgCache) SetResumeSession(ctx context.Context, token string, userID string, platformID int, expire time.Duration) error {
+	key := c.getSessionResumeKey(token)
+	pipe := c.rdb.TxPipeline()
+	pipe.HSet(ctx, key, sessionResumeUserID, userID, sessionResumePlatformID, platformID, sessionResumeOverflow, 0)
+	pipe.Expire(ctx, key, expire)
+	_, err := pipe.Exec(ctx)
+	return errs.Wrap(err)
+}
+
+func (c *msgCache) AppendResumeMsg(ctx context.Context, token string, msg *sdkws.MsgData, maxLen int64, expire time.Duration) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	keys := []string{c.getSessionResumeKey(token), c.getSessionResumeBufferKey(token)}
+	res, err := appendResumeMsgScript.Run(ctx, c.rdb, keys, data, maxLen, expire.Milliseconds()).Int()
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	if res == 2 {
+		// past this point the client has to fall back to a full seq sync anyway
+		log.ZDebug(ctx, "session resume buffer overflow", "token", token, "maxLen", maxLen)
+	}
+	return nil
+}
+
+func (c *msgCache) TakeResumeSession(ctx context.Context, token string) (userID string, platformID int, msgs []*sdkws.MsgData, overflow bool, ok bool, err error) {
+	key, bufferKey := c.getSessionResumeKey(token), c.getSessionResumeBufferKey(token)
+	pipe := c.rdb.TxPipeline()
+	sessionCmd := pipe.HGetAll(ctx, key)
+	bufferCmd := pipe.LRange(ctx, bufferKey, 0, -1)
+	pipe.Del(ctx, key, bufferKey)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return "", 0, nil, false, false, errs.Wrap(err)
+	}
+	session := sessionCmd.Val()
+	if len(session) == 0 {
+		return "", 0, nil, false, false, nil
+	}
+	platformID, _ = strconv.Atoi(session[sessionResumePlatformID])
+	for _, v := range bufferCmd.Val() {
+		var msg sdkws.MsgData
+		if err := proto.Unmarshal([]byte(v), &msg); err != nil {
+			log.ZWarn(ctx, "session resume msg unmarshal failed", err, "token", token)
+			continue
+		}
+		msgs = append(msgs, &msg)
+	}
+	return session[sessionResumeUserID], platformID, msgs, session[sessionResumeOverflow] == "1", true, nil
+}
@@ -0,0 +1,103 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSessionResumeTestCache connects to the local redis, the test is skipped without one.
+func newSessionResumeTestCache(t *testing.T) *msgCache {
+	rdb := redis.NewClient(&redis.Options{DialTimeout: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		t.Skip("redis is not available:", err)
+	}
+	t.Cleanup(func() { rdb.Close() })
+	return &msgCache{rdb: rdb}
+}
+
+func TestSessionResumeKeysShareSlot(t *testing.T) {
+	c := &msgCache{}
+	tag := "{token}"
+	assert.True(t, strings.Contains(c.getSessionResumeKey("token"), tag))
+	assert.True(t, strings.Contains(c.getSessionResumeBufferKey("token"), tag))
+}
+
+func TestSessionResume(t *testing.T) {
+	c := newSessionResumeTestCache(t)
+	ctx := context.Background()
+	token := fmt.Sprintf("token-%d", rand.Int63())
+
+	// no session, nothing is buffered
+	assert.NoError(t, c.AppendResumeMsg(ctx, token, &sdkws.MsgData{Seq: 1}, 2, time.Minute))
+	_, _, _, _, ok, err := c.TakeResumeSession(ctx, token)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+
+	assert.NoError(t, c.SetResumeSession(ctx, token, "u1", 1, time.Minute))
+	for seq := int64(1); seq <= 2; seq++ {
+		assert.NoError(t, c.AppendResumeMsg(ctx, token, &sdkws.MsgData{Seq: seq}, 2, time.Minute))
+	}
+	userID, platformID, msgs, overflow, ok, err := c.TakeResumeSession(ctx, token)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, overflow)
+	assert.Equal(t, "u1", userID)
+	assert.Equal(t, 1, platformID)
+	assert.Len(t, msgs, 2)
+
+	// taken sessions can not be taken again
+	_, _, _, _, ok, err = c.TakeResumeSession(ctx, token)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestSessionResumeOverflow(t *testing.T) {
+	c := newSessionResumeTestCache(t)
+	ctx := context.Background()
+	token := fmt.Sprintf("token-%d", rand.Int63())
+
+	assert.NoError(t, c.SetResumeSession(ctx, token, "u1", 1, time.Minute))
+	for seq := int64(1); seq <= 3; seq++ {
+		assert.NoError(t, c.AppendResumeMsg(ctx, token, &sdkws.MsgData{Seq: seq}, 2, time.Minute))
+	}
+	_, _, msgs, overflow, ok, err := c.TakeResumeSession(ctx, token)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.True(t, overflow)
+	assert.Empty(t, msgs)
+
+	// a non positive max length does not overflow
+	assert.NoError(t, c.SetResumeSession(ctx, token, "u1", 1, time.Minute))
+	for seq := int64(1); seq <= 3; seq++ {
+		assert.NoError(t, c.AppendResumeMsg(ctx, token, &sdkws.MsgData{Seq: seq}, 0, time.Minute))
+	}
+	_, _, msgs, overflow, _, err = c.TakeResumeSession(ctx, token)
+	assert.NoError(t, err)
+	assert.False(t, overflow)
+	assert.Len(t, msgs, 3)
+}
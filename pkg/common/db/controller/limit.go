@@ -0,0 +1,42 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// createWithLimit 先写入再按写入后的数据校验上限，超过上限时撤回本次写入，并发写入时不会超过上限。
+// 已存在时 created 为 false。撤回后同样删除缓存，写入与撤回之间读到的数据不会留在缓存中
+func createWithLimit(ctx context.Context, create, checkLimit, rollback, delCache func(ctx context.Context) error) (created bool, err error) {
+	if err := create(ctx); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if err := checkLimit(ctx); err != nil {
+		if rollbackErr := rollback(ctx); rollbackErr != nil {
+			return false, rollbackErr
+		}
+		if delErr := delCache(ctx); delErr != nil {
+			return false, delErr
+		}
+		return false, err
+	}
+	return true, delCache(ctx)
+}
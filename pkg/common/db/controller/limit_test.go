@@ -0,0 +1,107 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestCreateWithLimit(t *testing.T) {
+	ctx := context.Background()
+	errLimit := errors.New("limit")
+	var calls []string
+	call := func(name string, err error) func(context.Context) error {
+		return func(context.Context) error {
+			calls = append(calls, name)
+			return err
+		}
+	}
+	duplicate := mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}
+
+	created, err := createWithLimit(ctx, call("create", nil), call("check", nil), call("rollback", nil), call("del", nil))
+	assert.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, []string{"create", "check", "del"}, calls)
+
+	calls = nil
+	created, err = createWithLimit(ctx, call("create", duplicate), call("check", nil), call("rollback", nil), call("del", nil))
+	assert.NoError(t, err)
+	assert.False(t, created)
+	assert.Equal(t, []string{"create"}, calls)
+
+	// 撤回后同样删除缓存
+	calls = nil
+	_, err = createWithLimit(ctx, call("create", nil), call("check", errLimit), call("rollback", nil), call("del", nil))
+	assert.Equal(t, errLimit, err)
+	assert.Equal(t, []string{"create", "check", "rollback", "del"}, calls)
+
+	errRollback := errors.New("rollback")
+	_, err = createWithLimit(ctx, call("create", nil), call("check", errLimit), call("rollback", errRollback), call("del", nil))
+	assert.Equal(t, errRollback, err)
+}
+
+func TestCreateWithLimitConcurrent(t *testing.T) {
+	ctx := context.Background()
+	const limit = 2
+	var (
+		mu    sync.Mutex
+		items []int
+		wg    sync.WaitGroup
+	)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, _ = createWithLimit(ctx,
+				func(context.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					items = append(items, i)
+					return nil
+				},
+				func(context.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					for j, item := range items {
+						if item == i && j >= limit {
+							return errors.New("limit")
+						}
+					}
+					return nil
+				},
+				func(context.Context) error {
+					mu.Lock()
+					defer mu.Unlock()
+					for j, item := range items {
+						if item == i {
+							items = append(items[:j], items[j+1:]...)
+							break
+						}
+					}
+					return nil
+				},
+				func(context.Context) error { return nil },
+			)
+		}(i)
+	}
+	wg.Wait()
+	assert.Len(t, items, limit)
+}
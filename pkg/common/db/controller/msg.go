@@ -47,6 +47,7 @@ import (
 const (
 	updateKeyMsg = iota
 	updateKeyRevoke
+	updateKeyModify
 )
 
 // 每次拉取消息数
@@ -60,6 +61,8 @@ type CommonMsgDatabase interface {
 	BatchInsertChat2DB(ctx context.Context, conversationID string, msgs []*sdkws.MsgData, currentMaxSeq int64) error
 	// 撤回消息
 	RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *unrelationtb.RevokeModel) error
+	// 修改消息，记录修改前的版本
+	ModifyMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, edit *unrelationtb.MsgEditModel) error
 	// mark as read
 	MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, seqs []int64) error
 	// 刪除redis中消息缓存
@@ -98,6 +101,7 @@ type CommonMsgDatabase interface {
 	SetConversationUserMinSeq(ctx context.Context, conversationID string, userID string, minSeq int64) error
 	SetConversationUserMinSeqs(ctx context.Context, conversationID string, seqs map[string]int64) (err error)
 	SetUserConversationsMinSeqs(ctx context.Context, userID string, seqs map[string]int64) (err error)
+	GetUserConversationsMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	SetHasReadSeq(ctx context.Context, userID string, conversationID string, hasReadSeq int64) error
 	GetHasReadSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error)
 	GetHasReadSeq(ctx context.Context, userID string, conversationID string) (int64, error)
@@ -107,12 +111,12 @@ type CommonMsgDatabase interface {
 	GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error)
 	SetSendMsgStatus(ctx context.Context, id string, status int32) error
 	GetSendMsgStatus(ctx context.Context, id string) (int32, error)
+	// 获取消息的投递状态
+	GetMsgDeliveryStates(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*cache.MsgDeliveryState, error)
 	SearchMessage(ctx context.Context, req *pbmsg.SearchMessageReq) (total int32, msgData []*sdkws.MsgData, err error)
 	FindOneByDocIDs(ctx context.Context, docIDs []string, seqs map[string]int64) (map[string]*sdkws.MsgData, error)
 
 	GetRedis() redis.UniversalClient // 获取Redis实例
-	MsgToEsMQ(ctx context.Context, conversationID string, esMsg []*sdkws.MsgData) error
-	RevokeMsgToEsMQ(ctx context.Context, _id string) error
 	// to mq
 	MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error
 	MsgToModifyMQ(ctx context.Context, key, conversarionID string, msgs []*sdkws.MsgData) error
@@ -190,14 +194,6 @@ func (db *commonMsgDatabase) GetRedis() redis.UniversalClient {
 	return db.cache.GetReds()
 }
 
-func (db *commonMsgDatabase) MsgToEsMQ(ctx context.Context, conversationID string, esMsg []*sdkws.MsgData) error {
-	return db.cache.MsgToEsMQ(ctx, conversationID, esMsg)
-}
-
-func (db *commonMsgDatabase) RevokeMsgToEsMQ(ctx context.Context, _id string) error {
-	return db.cache.RevokeMsgToEsMQ(ctx, _id)
-}
-
 func (db *commonMsgDatabase) MsgToMQ(ctx context.Context, key string, msg2mq *sdkws.MsgData) error {
 	_, _, err := db.producer.SendMessage(ctx, key, msg2mq)
 	return err
@@ -245,6 +241,8 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			}
 		case updateKeyRevoke:
 			_, ok = field.(*unrelationtb.RevokeModel)
+		case updateKeyModify:
+			_, ok = field.(*unrelationtb.MsgEditModel)
 		default:
 			return errs.ErrInternalServer.Wrap("key is invalid")
 		}
@@ -266,6 +264,8 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "msg", field)
 		case updateKeyRevoke:
 			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "revoke", field)
+		case updateKeyModify:
+			res, err = db.msgDocDatabase.UpdateMsg(ctx, docID, index, "modify", field)
 		}
 		if err != nil {
 			return false, err
@@ -304,6 +304,10 @@ func (db *commonMsgDatabase) BatchInsertBlock(ctx context.Context, conversationI
 				doc.Msg[db.msg.GetMsgIndex(seq)] = &unrelationtb.MsgInfoModel{
 					Revoke: fields[j].(*unrelationtb.RevokeModel),
 				}
+			case updateKeyModify:
+				doc.Msg[db.msg.GetMsgIndex(seq)] = &unrelationtb.MsgInfoModel{
+					Modify: fields[j].(*unrelationtb.MsgEditModel),
+				}
 			}
 		}
 		for i, model := range doc.Msg {
@@ -338,47 +342,82 @@ func (db *commonMsgDatabase) BatchInsertChat2DB(ctx context.Context, conversatio
 		if msg == nil {
 			continue
 		}
-		var offlinePushModel *unrelationtb.OfflinePushModel
-		if msg.OfflinePushInfo != nil {
-			offlinePushModel = &unrelationtb.OfflinePushModel{
-				Title:         msg.OfflinePushInfo.Title,
-				Desc:          msg.OfflinePushInfo.Desc,
-				Ex:            msg.OfflinePushInfo.Ex,
-				IOSPushSound:  msg.OfflinePushInfo.IOSPushSound,
-				IOSBadgeCount: msg.OfflinePushInfo.IOSBadgeCount,
-			}
-		}
-		msgs[i] = &unrelationtb.MsgDataModel{
-			SendID:           msg.SendID,
-			RecvID:           msg.RecvID,
-			GroupID:          msg.GroupID,
-			ClientMsgID:      msg.ClientMsgID,
-			ServerMsgID:      msg.ServerMsgID,
-			SenderPlatformID: msg.SenderPlatformID,
-			SenderNickname:   msg.SenderNickname,
-			SenderFaceURL:    msg.SenderFaceURL,
-			SessionType:      msg.SessionType,
-			MsgFrom:          msg.MsgFrom,
-			ContentType:      msg.ContentType,
-			Content:          string(msg.Content),
-			Seq:              msg.Seq,
-			SendTime:         msg.SendTime,
-			CreateTime:       msg.CreateTime,
-			Status:           msg.Status,
-			Options:          msg.Options,
-			OfflinePush:      offlinePushModel,
-			AtUserIDList:     msg.AtUserIDList,
-			AttachedInfo:     msg.AttachedInfo,
-			Ex:               msg.Ex,
-		}
+		msgs[i] = msgDataToModel(msg)
 	}
 	return db.BatchInsertBlock(ctx, conversationID, msgs, updateKeyMsg, msgList[0].Seq)
 }
 
+func msgDataToModel(msg *sdkws.MsgData) *unrelationtb.MsgDataModel {
+	var offlinePushModel *unrelationtb.OfflinePushModel
+	if msg.OfflinePushInfo != nil {
+		offlinePushModel = &unrelationtb.OfflinePushModel{
+			Title:         msg.OfflinePushInfo.Title,
+			Desc:          msg.OfflinePushInfo.Desc,
+			Ex:            msg.OfflinePushInfo.Ex,
+			IOSPushSound:  msg.OfflinePushInfo.IOSPushSound,
+			IOSBadgeCount: msg.OfflinePushInfo.IOSBadgeCount,
+		}
+	}
+	return &unrelationtb.MsgDataModel{
+		SendID:           msg.SendID,
+		RecvID:           msg.RecvID,
+		GroupID:          msg.GroupID,
+		ClientMsgID:      msg.ClientMsgID,
+		ServerMsgID:      msg.ServerMsgID,
+		SenderPlatformID: msg.SenderPlatformID,
+		SenderNickname:   msg.SenderNickname,
+		SenderFaceURL:    msg.SenderFaceURL,
+		SessionType:      msg.SessionType,
+		MsgFrom:          msg.MsgFrom,
+		ContentType:      msg.ContentType,
+		Content:          string(msg.Content),
+		Seq:              msg.Seq,
+		SendTime:         msg.SendTime,
+		CreateTime:       msg.CreateTime,
+		Status:           msg.Status,
+		Options:          msg.Options,
+		OfflinePush:      offlinePushModel,
+		AtUserIDList:     msg.AtUserIDList,
+		AttachedInfo:     msg.AttachedInfo,
+		Ex:               msg.Ex,
+		ThreadRootSeq:    msg.ThreadRootSeq,
+	}
+}
+
 func (db *commonMsgDatabase) RevokeMsg(ctx context.Context, conversationID string, seq int64, revoke *unrelationtb.RevokeModel) error {
 	return db.BatchInsertBlock(ctx, conversationID, []any{revoke}, updateKeyRevoke, seq)
 }
 
+// ModifyMsg 修改后的内容写入 modify 字段，读取时覆盖 msg，转存 mongo 的消息晚于修改写入时不会覆盖修改
+func (db *commonMsgDatabase) ModifyMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData, edit *unrelationtb.MsgEditModel) error {
+	modify := &unrelationtb.MsgEditModel{
+		UserID:      edit.UserID,
+		ContentType: msg.ContentType,
+		Content:     string(msg.Content),
+		Ex:          msg.Ex,
+		Time:        edit.Time,
+	}
+	if err := db.BatchInsertBlock(ctx, conversationID, []any{modify}, updateKeyModify, msg.Seq); err != nil {
+		return err
+	}
+	docID := db.msg.GetDocID(conversationID, msg.Seq)
+	if _, err := db.msgDocDatabase.PushUnique(ctx, docID, db.msg.GetMsgIndex(msg.Seq), "edits", []*unrelationtb.MsgEditModel{edit}); err != nil {
+		return err
+	}
+	// 消息还在缓存中时同时更新缓存
+	cachedMsgs, _, err := db.cache.GetMessagesBySeq(ctx, conversationID, []int64{msg.Seq})
+	if err != nil && errs.Unwrap(err) != redis.Nil {
+		log.ZWarn(ctx, "get modified msg from cache failed", err, "conversationID", conversationID, "seq", msg.Seq)
+		return db.cache.DeleteMessages(ctx, conversationID, []int64{msg.Seq})
+	}
+	if len(cachedMsgs) > 0 {
+		if _, err := db.cache.SetMessageToCache(ctx, conversationID, []*sdkws.MsgData{msg}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (db *commonMsgDatabase) MarkSingleChatMsgsAsRead(ctx context.Context, userID string, conversationID string, totalSeqs []int64) error {
 	for docID, seqs := range db.msg.GetDocIDSeqsMap(conversationID, totalSeqs) {
 		var indexes []int64
@@ -1235,6 +1274,10 @@ func (db *commonMsgDatabase) SetUserConversationsMinSeqs(ctx context.Context, us
 	return db.cache.SetUserConversationsMinSeqs(ctx, userID, seqs)
 }
 
+func (db *commonMsgDatabase) GetUserConversationsMinSeqs(ctx context.Context, userID string, conversationIDs []string) (map[string]int64, error) {
+	return db.cache.GetUserConversationsMinSeqs(ctx, userID, conversationIDs)
+}
+
 func (db *commonMsgDatabase) UserSetHasReadSeqs(ctx context.Context, userID string, hasReadSeqs map[string]int64) error {
 	return db.cache.UserSetHasReadSeqs(ctx, userID, hasReadSeqs)
 }
@@ -1259,6 +1302,10 @@ func (db *commonMsgDatabase) GetSendMsgStatus(ctx context.Context, id string) (i
 	return db.cache.GetSendMsgStatus(ctx, id)
 }
 
+func (db *commonMsgDatabase) GetMsgDeliveryStates(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*cache.MsgDeliveryState, error) {
+	return db.cache.GetMsgDeliveryStates(ctx, conversationID, seqs)
+}
+
 func (db *commonMsgDatabase) GetConversationMinMaxSeqInMongoAndCache(ctx context.Context, conversationID string) (minSeqMongo, maxSeqMongo, minSeqCache, maxSeqCache int64, err error) {
 	minSeqMongo, maxSeqMongo, err = db.GetMinMaxSeqMongo(ctx, conversationID)
 	if err != nil {
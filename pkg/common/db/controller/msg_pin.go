@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type MsgPinDatabase interface {
+	// Pin 置顶消息，已置顶时 pinned 为 false；maxPerConversation 大于 0 时限制会话的置顶数
+	Pin(ctx context.Context, pin *relation.MsgPinModel, maxPerConversation int) (pinned bool, err error)
+	// Unpin 取消置顶，未置顶时 unpinned 为 false
+	Unpin(ctx context.Context, conversationID string, seq int64) (unpinned bool, err error)
+	GetPins(ctx context.Context, conversationID string) ([]*relation.MsgPinModel, error)
+	GetConversationsPins(ctx context.Context, conversationIDs []string) (map[string][]*relation.MsgPinModel, error)
+}
+
+type msgPinDatabase struct {
+	pin   relation.MsgPinModelInterface
+	cache cache.MsgPinCache
+}
+
+func NewMsgPinDatabase(pin relation.MsgPinModelInterface, cache cache.MsgPinCache) MsgPinDatabase {
+	return &msgPinDatabase{pin: pin, cache: cache}
+}
+
+func (m *msgPinDatabase) Pin(ctx context.Context, pin *relation.MsgPinModel, maxPerConversation int) (bool, error) {
+	return createWithLimit(ctx,
+		func(ctx context.Context) error {
+			return m.pin.Create(ctx, []*relation.MsgPinModel{pin})
+		},
+		func(ctx context.Context) error {
+			return m.checkPinLimit(ctx, pin, maxPerConversation)
+		},
+		func(ctx context.Context) error {
+			_, err := m.pin.Delete(ctx, pin.ConversationID, pin.Seq)
+			return err
+		},
+		func(ctx context.Context) error {
+			return m.cache.DelPins(pin.ConversationID).ExecDel(ctx)
+		},
+	)
+}
+
+// checkPinLimit 超过上限时最后置顶的几条超限
+func (m *msgPinDatabase) checkPinLimit(ctx context.Context, pin *relation.MsgPinModel, maxPerConversation int) error {
+	if maxPerConversation <= 0 {
+		return nil
+	}
+	pins, err := m.pin.FindByConversationID(ctx, pin.ConversationID)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(pins)-maxPerConversation; i++ {
+		if pins[i].Seq == pin.Seq {
+			return errs.ErrNoPermission.Wrap("too many pinned msgs in the conversation")
+		}
+	}
+	return nil
+}
+
+func (m *msgPinDatabase) Unpin(ctx context.Context, conversationID string, seq int64) (bool, error) {
+	unpinned, err := m.pin.Delete(ctx, conversationID, seq)
+	if err != nil || !unpinned {
+		return false, err
+	}
+	return true, m.cache.DelPins(conversationID).ExecDel(ctx)
+}
+
+func (m *msgPinDatabase) GetPins(ctx context.Context, conversationID string) ([]*relation.MsgPinModel, error) {
+	return m.cache.GetPins(ctx, conversationID)
+}
+
+func (m *msgPinDatabase) GetConversationsPins(ctx context.Context, conversationIDs []string) (map[string][]*relation.MsgPinModel, error) {
+	return m.cache.GetConversationsPins(ctx, conversationIDs)
+}
@@ -0,0 +1,217 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+// pinStore sorts like the mongo query: newest pin time first, then the larger seq.
+type pinStore struct {
+	relation.MsgPinModelInterface
+	pins        []*relation.MsgPinModel
+	afterCreate func()
+}
+
+func (s *pinStore) Create(_ context.Context, pins []*relation.MsgPinModel) error {
+	for _, pin := range pins {
+		for _, p := range s.pins {
+			if p.ConversationID == pin.ConversationID && p.Seq == pin.Seq {
+				return mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}
+			}
+		}
+		s.pins = append(s.pins, pin)
+	}
+	if s.afterCreate != nil {
+		s.afterCreate()
+	}
+	return nil
+}
+
+func (s *pinStore) Delete(_ context.Context, conversationID string, seq int64) (bool, error) {
+	for i, p := range s.pins {
+		if p.ConversationID == conversationID && p.Seq == seq {
+			s.pins = append(s.pins[:i], s.pins[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *pinStore) FindByConversationID(ctx context.Context, conversationID string) ([]*relation.MsgPinModel, error) {
+	pins, err := s.FindByConversationIDs(ctx, []string{conversationID})
+	return pins[conversationID], err
+}
+
+func (s *pinStore) FindByConversationIDs(_ context.Context, conversationIDs []string) (map[string][]*relation.MsgPinModel, error) {
+	res := make(map[string][]*relation.MsgPinModel)
+	for _, p := range s.pins {
+		for _, conversationID := range conversationIDs {
+			if p.ConversationID == conversationID {
+				res[conversationID] = append(res[conversationID], p)
+			}
+		}
+	}
+	for _, pins := range res {
+		sort.Slice(pins, func(i, j int) bool {
+			if !pins[i].PinTime.Equal(pins[j].PinTime) {
+				return pins[i].PinTime.After(pins[j].PinTime)
+			}
+			return pins[i].Seq > pins[j].Seq
+		})
+	}
+	return res, nil
+}
+
+// pinListCache keeps the pin lists it read until they are deleted.
+type pinListCache struct {
+	cache.MsgPinCache
+	store *pinStore
+	pins  map[string][]*relation.MsgPinModel
+	del   []string
+}
+
+func (c *pinListCache) GetPins(ctx context.Context, conversationID string) ([]*relation.MsgPinModel, error) {
+	if pins, ok := c.pins[conversationID]; ok {
+		return pins, nil
+	}
+	pins, err := c.store.FindByConversationID(ctx, conversationID)
+	c.pins[conversationID] = pins
+	return pins, err
+}
+
+func (c *pinListCache) GetConversationsPins(ctx context.Context, conversationIDs []string) (map[string][]*relation.MsgPinModel, error) {
+	res := make(map[string][]*relation.MsgPinModel)
+	for _, conversationID := range conversationIDs {
+		pins, err := c.GetPins(ctx, conversationID)
+		if err != nil {
+			return nil, err
+		}
+		if len(pins) > 0 {
+			res[conversationID] = pins
+		}
+	}
+	return res, nil
+}
+
+func (c *pinListCache) DelPins(conversationIDs ...string) cache.MsgPinCache {
+	c.del = append(c.del, conversationIDs...)
+	return c
+}
+
+func (c *pinListCache) ExecDel(context.Context, ...bool) error {
+	for _, conversationID := range c.del {
+		delete(c.pins, conversationID)
+	}
+	c.del = nil
+	return nil
+}
+
+func newPinDatabase() (*msgPinDatabase, *pinStore) {
+	store := &pinStore{}
+	return &msgPinDatabase{pin: store, cache: &pinListCache{store: store, pins: make(map[string][]*relation.MsgPinModel)}}, store
+}
+
+func TestPinLimitKeepsEarliestPins(t *testing.T) {
+	database, _ := newPinDatabase()
+	ctx := context.Background()
+	now := time.Now()
+	pin := func(seq int64, pinTime time.Time) error {
+		_, err := database.Pin(ctx, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: seq, PinTime: pinTime}, 2)
+		return err
+	}
+	require.NoError(t, pin(1, now))
+	require.NoError(t, pin(2, now.Add(time.Second)))
+	assert.True(t, errs.ErrNoPermission.Is(pin(3, now.Add(2*time.Second))))
+
+	// 取消置顶后可以再置顶
+	unpinned, err := database.Unpin(ctx, "sg_g1", 1)
+	require.NoError(t, err)
+	assert.True(t, unpinned)
+	require.NoError(t, pin(3, now.Add(2*time.Second)))
+
+	pins, err := database.GetPins(ctx, "sg_g1")
+	require.NoError(t, err)
+	require.Len(t, pins, 2)
+	assert.Equal(t, int64(3), pins[0].Seq)
+	assert.Equal(t, int64(2), pins[1].Seq)
+}
+
+func TestPinSameTimeRejectsLargerSeq(t *testing.T) {
+	database, store := newPinDatabase()
+	ctx := context.Background()
+	now := time.Now()
+	_, err := database.Pin(ctx, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: 5, PinTime: now}, 1)
+	require.NoError(t, err)
+
+	// 并发置顶的时间相同时按 seq 决定保留哪一条
+	store.pins = append(store.pins, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: 3, PinTime: now.Add(time.Second)})
+	_, err = database.Pin(ctx, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: 9, PinTime: now.Add(time.Second)}, 2)
+	assert.True(t, errs.ErrNoPermission.Is(err))
+	assert.Len(t, store.pins, 2)
+}
+
+func TestPinRollbackClearsCache(t *testing.T) {
+	database, store := newPinDatabase()
+	ctx := context.Background()
+	_, err := database.Pin(ctx, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: 1, PinTime: time.Now()}, 1)
+	require.NoError(t, err)
+
+	store.afterCreate = func() { _, _ = database.GetPins(ctx, "sg_g1") }
+	_, err = database.Pin(ctx, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: 2, PinTime: time.Now().Add(time.Second)}, 1)
+	assert.True(t, errs.ErrNoPermission.Is(err))
+	store.afterCreate = nil
+
+	pins, err := database.GetPins(ctx, "sg_g1")
+	require.NoError(t, err)
+	require.Len(t, pins, 1)
+	assert.Equal(t, int64(1), pins[0].Seq)
+}
+
+func TestGetConversationsPins(t *testing.T) {
+	database, _ := newPinDatabase()
+	ctx := context.Background()
+	now := time.Now()
+	for _, p := range []*relation.MsgPinModel{
+		{ConversationID: "sg_g1", Seq: 1, PinTime: now},
+		{ConversationID: "sg_g1", Seq: 2, PinTime: now.Add(time.Second)},
+		{ConversationID: "si_u1_u2", Seq: 1, PinTime: now},
+	} {
+		pinned, err := database.Pin(ctx, p, 0)
+		require.NoError(t, err)
+		assert.True(t, pinned)
+	}
+	pinned, err := database.Pin(ctx, &relation.MsgPinModel{ConversationID: "sg_g1", Seq: 1, PinTime: now.Add(time.Hour)}, 0)
+	require.NoError(t, err)
+	assert.False(t, pinned)
+
+	pins, err := database.GetConversationsPins(ctx, []string{"sg_g1", "si_u1_u2", "sg_g3"})
+	require.NoError(t, err)
+	assert.Len(t, pins, 2)
+	require.Len(t, pins["sg_g1"], 2)
+	assert.Equal(t, int64(2), pins["sg_g1"][0].Seq)
+	assert.Len(t, pins["si_u1_u2"], 1)
+}
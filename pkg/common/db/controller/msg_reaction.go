@@ -0,0 +1,143 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/pagination"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type MsgReactionDatabase interface {
+	// AddReaction 增加回应，已回应时 added 为 false；maxPerMsg、maxPerUser 大于 0 时限制消息的回应种类数和用户在消息上的回应数
+	AddReaction(ctx context.Context, reaction *relation.MsgReactionModel, maxPerMsg, maxPerUser int) (added bool, err error)
+	// RemoveReaction 取消回应，未回应时 removed 为 false
+	RemoveReaction(ctx context.Context, conversationID string, seq int64, userID, reaction string) (removed bool, err error)
+	CountUserReactions(ctx context.Context, conversationID string, seq int64, userID string) (count int64, err error)
+	GetReactionCounts(ctx context.Context, conversationID string, seq int64) (counts []*relation.MsgReactionCountModel, err error)
+	// GetMsgsReactions 获取多条消息的回应数以及 userID 是否回应
+	GetMsgsReactions(ctx context.Context, conversationID string, seqs []int64, userID string) (map[int64][]*sdkws.MsgReaction, error)
+	FindReactions(ctx context.Context, conversationID string, seq int64, reaction string, pagination pagination.Pagination) (total int64, reactions []*relation.MsgReactionModel, err error)
+}
+
+type msgReactionDatabase struct {
+	reaction relation.MsgReactionModelInterface
+	cache    cache.MsgReactionCache
+}
+
+func NewMsgReactionDatabase(reaction relation.MsgReactionModelInterface, cache cache.MsgReactionCache) MsgReactionDatabase {
+	return &msgReactionDatabase{reaction: reaction, cache: cache}
+}
+
+func (m *msgReactionDatabase) AddReaction(ctx context.Context, reaction *relation.MsgReactionModel, maxPerMsg, maxPerUser int) (bool, error) {
+	return createWithLimit(ctx,
+		func(ctx context.Context) error {
+			return m.reaction.Create(ctx, reaction)
+		},
+		func(ctx context.Context) error {
+			return m.checkReactionLimit(ctx, reaction, maxPerMsg, maxPerUser)
+		},
+		func(ctx context.Context) error {
+			_, err := m.reaction.Delete(ctx, reaction.ConversationID, reaction.Seq, reaction.UserID, reaction.Reaction)
+			return err
+		},
+		func(ctx context.Context) error {
+			return m.cache.DelReactionCounts(reaction.ConversationID, reaction.Seq).ExecDel(ctx)
+		},
+	)
+}
+
+// checkReactionLimit 回应种类按首次回应时间排序，排在上限之后的种类超限
+func (m *msgReactionDatabase) checkReactionLimit(ctx context.Context, reaction *relation.MsgReactionModel, maxPerMsg, maxPerUser int) error {
+	if maxPerMsg > 0 {
+		counts, err := m.reaction.CountReactions(ctx, reaction.ConversationID, reaction.Seq)
+		if err != nil {
+			return err
+		}
+		for i, count := range counts {
+			if count.Reaction == reaction.Reaction && i >= maxPerMsg {
+				return errs.ErrNoPermission.Wrap("too many reactions on the msg")
+			}
+		}
+	}
+	if maxPerUser > 0 {
+		count, err := m.reaction.CountUserReactions(ctx, reaction.ConversationID, reaction.Seq, reaction.UserID)
+		if err != nil {
+			return err
+		}
+		if count > int64(maxPerUser) {
+			return errs.ErrNoPermission.Wrap("too many reactions by the user")
+		}
+	}
+	return nil
+}
+
+func (m *msgReactionDatabase) RemoveReaction(ctx context.Context, conversationID string, seq int64, userID, reaction string) (bool, error) {
+	removed, err := m.reaction.Delete(ctx, conversationID, seq, userID, reaction)
+	if err != nil || !removed {
+		return false, err
+	}
+	return true, m.cache.DelReactionCounts(conversationID, seq).ExecDel(ctx)
+}
+
+func (m *msgReactionDatabase) CountUserReactions(ctx context.Context, conversationID string, seq int64, userID string) (int64, error) {
+	return m.reaction.CountUserReactions(ctx, conversationID, seq, userID)
+}
+
+func (m *msgReactionDatabase) GetReactionCounts(ctx context.Context, conversationID string, seq int64) ([]*relation.MsgReactionCountModel, error) {
+	return m.cache.GetReactionCounts(ctx, conversationID, seq)
+}
+
+func (m *msgReactionDatabase) GetMsgsReactions(ctx context.Context, conversationID string, seqs []int64, userID string) (map[int64][]*sdkws.MsgReaction, error) {
+	seqCounts, err := m.cache.GetReactionCountsBySeqs(ctx, conversationID, seqs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64][]*sdkws.MsgReaction, len(seqCounts))
+	reactedSeqs := make([]int64, 0, len(seqCounts))
+	for seq, counts := range seqCounts {
+		reactions := make([]*sdkws.MsgReaction, 0, len(counts))
+		for _, count := range counts {
+			reactions = append(reactions, &sdkws.MsgReaction{Reaction: count.Reaction, Count: count.Count})
+		}
+		res[seq] = reactions
+		reactedSeqs = append(reactedSeqs, seq)
+	}
+	// 只查询有回应的消息
+	if len(reactedSeqs) == 0 || userID == "" {
+		return res, nil
+	}
+	userReactions, err := m.reaction.FindUserReactions(ctx, conversationID, reactedSeqs, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, userReaction := range userReactions {
+		for _, reaction := range res[userReaction.Seq] {
+			if reaction.Reaction == userReaction.Reaction {
+				reaction.IsReacted = true
+			}
+		}
+	}
+	return res, nil
+}
+
+func (m *msgReactionDatabase) FindReactions(ctx context.Context, conversationID string, seq int64, reaction string, pagination pagination.Pagination) (int64, []*relation.MsgReactionModel, error) {
+	return m.reaction.FindReactions(ctx, conversationID, seq, reaction, pagination)
+}
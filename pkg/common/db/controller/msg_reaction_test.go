@@ -0,0 +1,234 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+// reactionStore orders the reactions by insertion, the first reaction of a kind decides its rank.
+type reactionStore struct {
+	relation.MsgReactionModelInterface
+	reactions   []*relation.MsgReactionModel
+	afterCreate func()
+}
+
+func sameReaction(a, b *relation.MsgReactionModel) bool {
+	return a.ConversationID == b.ConversationID && a.Seq == b.Seq && a.UserID == b.UserID && a.Reaction == b.Reaction
+}
+
+func (s *reactionStore) Create(_ context.Context, reaction *relation.MsgReactionModel) error {
+	for _, r := range s.reactions {
+		if sameReaction(r, reaction) {
+			return mongo.WriteException{WriteErrors: mongo.WriteErrors{{Code: 11000}}}
+		}
+	}
+	s.reactions = append(s.reactions, reaction)
+	if s.afterCreate != nil {
+		s.afterCreate()
+	}
+	return nil
+}
+
+func (s *reactionStore) Delete(_ context.Context, conversationID string, seq int64, userID, reaction string) (bool, error) {
+	key := &relation.MsgReactionModel{ConversationID: conversationID, Seq: seq, UserID: userID, Reaction: reaction}
+	for i, r := range s.reactions {
+		if sameReaction(r, key) {
+			s.reactions = append(s.reactions[:i], s.reactions[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *reactionStore) CountUserReactions(_ context.Context, conversationID string, seq int64, userID string) (int64, error) {
+	var count int64
+	for _, r := range s.reactions {
+		if r.ConversationID == conversationID && r.Seq == seq && r.UserID == userID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *reactionStore) CountReactions(_ context.Context, conversationID string, seq int64) ([]*relation.MsgReactionCountModel, error) {
+	var counts []*relation.MsgReactionCountModel
+	index := make(map[string]*relation.MsgReactionCountModel)
+	for _, r := range s.reactions {
+		if r.ConversationID != conversationID || r.Seq != seq {
+			continue
+		}
+		if count, ok := index[r.Reaction]; ok {
+			count.Count++
+			continue
+		}
+		index[r.Reaction] = &relation.MsgReactionCountModel{Reaction: r.Reaction, Count: 1}
+		counts = append(counts, index[r.Reaction])
+	}
+	return counts, nil
+}
+
+func (s *reactionStore) FindUserReactions(_ context.Context, conversationID string, seqs []int64, userID string) ([]*relation.MsgReactionModel, error) {
+	var res []*relation.MsgReactionModel
+	for _, r := range s.reactions {
+		for _, seq := range seqs {
+			if r.ConversationID == conversationID && r.Seq == seq && r.UserID == userID {
+				res = append(res, r)
+			}
+		}
+	}
+	return res, nil
+}
+
+// reactionCountCache keeps the counts it read until they are deleted, like the redis cache.
+type reactionCountCache struct {
+	cache.MsgReactionCache
+	store  *reactionStore
+	counts map[int64][]*relation.MsgReactionCountModel
+	del    []int64
+}
+
+func (c *reactionCountCache) GetReactionCounts(ctx context.Context, conversationID string, seq int64) ([]*relation.MsgReactionCountModel, error) {
+	if counts, ok := c.counts[seq]; ok {
+		return counts, nil
+	}
+	counts, err := c.store.CountReactions(ctx, conversationID, seq)
+	c.counts[seq] = counts
+	return counts, err
+}
+
+func (c *reactionCountCache) GetReactionCountsBySeqs(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*relation.MsgReactionCountModel, error) {
+	res := make(map[int64][]*relation.MsgReactionCountModel)
+	for _, seq := range seqs {
+		counts, err := c.GetReactionCounts(ctx, conversationID, seq)
+		if err != nil {
+			return nil, err
+		}
+		if len(counts) > 0 {
+			res[seq] = counts
+		}
+	}
+	return res, nil
+}
+
+func (c *reactionCountCache) DelReactionCounts(_ string, seqs ...int64) cache.MsgReactionCache {
+	c.del = append(c.del, seqs...)
+	return c
+}
+
+func (c *reactionCountCache) ExecDel(context.Context, ...bool) error {
+	for _, seq := range c.del {
+		delete(c.counts, seq)
+	}
+	c.del = nil
+	return nil
+}
+
+func newReactionDatabase() (*msgReactionDatabase, *reactionStore) {
+	store := &reactionStore{}
+	return &msgReactionDatabase{
+		reaction: store,
+		cache:    &reactionCountCache{store: store, counts: make(map[int64][]*relation.MsgReactionCountModel)},
+	}, store
+}
+
+func TestAddReactionDuplicate(t *testing.T) {
+	database, store := newReactionDatabase()
+	ctx := context.Background()
+	reaction := &relation.MsgReactionModel{ConversationID: "sg_g1", Seq: 1, UserID: "u1", Reaction: "a", CreateTime: time.Now()}
+	added, err := database.AddReaction(ctx, reaction, 0, 0)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// 唯一索引不包含回应时间
+	again := *reaction
+	again.CreateTime = reaction.CreateTime.Add(time.Second)
+	added, err = database.AddReaction(ctx, &again, 0, 0)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.Len(t, store.reactions, 1)
+}
+
+func TestAddReactionLimit(t *testing.T) {
+	database, store := newReactionDatabase()
+	ctx := context.Background()
+	add := func(userID, reaction string) error {
+		_, err := database.AddReaction(ctx, &relation.MsgReactionModel{ConversationID: "sg_g1", Seq: 1, UserID: userID, Reaction: reaction}, 3, 2)
+		return err
+	}
+	require.NoError(t, add("u1", "a"))
+	require.NoError(t, add("u2", "b"))
+	require.NoError(t, add("u3", "c"))
+
+	// 新的回应种类超过消息上限，已有的种类不受限制
+	assert.True(t, errs.ErrNoPermission.Is(add("u4", "d")))
+	require.NoError(t, add("u4", "a"))
+	// 用户在消息上的回应数超过上限
+	require.NoError(t, add("u1", "b"))
+	assert.True(t, errs.ErrNoPermission.Is(add("u1", "c")))
+	// 其他消息的回应不计入
+	_, err := database.AddReaction(ctx, &relation.MsgReactionModel{ConversationID: "sg_g1", Seq: 2, UserID: "u1", Reaction: "d"}, 3, 2)
+	require.NoError(t, err)
+	assert.Len(t, store.reactions, 6)
+}
+
+func TestAddReactionRollbackClearsCache(t *testing.T) {
+	database, store := newReactionDatabase()
+	ctx := context.Background()
+	_, err := database.AddReaction(ctx, &relation.MsgReactionModel{ConversationID: "sg_g1", Seq: 1, UserID: "u1", Reaction: "a"}, 1, 0)
+	require.NoError(t, err)
+
+	// 写入与撤回之间的读取把超限的回应写进了缓存
+	store.afterCreate = func() { _, _ = database.GetReactionCounts(ctx, "sg_g1", 1) }
+	_, err = database.AddReaction(ctx, &relation.MsgReactionModel{ConversationID: "sg_g1", Seq: 1, UserID: "u2", Reaction: "b"}, 1, 0)
+	assert.True(t, errs.ErrNoPermission.Is(err))
+	store.afterCreate = nil
+
+	counts, err := database.GetReactionCounts(ctx, "sg_g1", 1)
+	require.NoError(t, err)
+	require.Len(t, counts, 1)
+	assert.Equal(t, "a", counts[0].Reaction)
+}
+
+func TestGetMsgsReactions(t *testing.T) {
+	database, _ := newReactionDatabase()
+	ctx := context.Background()
+	for _, r := range []*relation.MsgReactionModel{
+		{ConversationID: "sg_g1", Seq: 1, UserID: "u1", Reaction: "a"},
+		{ConversationID: "sg_g1", Seq: 1, UserID: "u2", Reaction: "a"},
+		{ConversationID: "sg_g1", Seq: 2, UserID: "u2", Reaction: "b"},
+	} {
+		_, err := database.AddReaction(ctx, r, 0, 0)
+		require.NoError(t, err)
+	}
+	reactions, err := database.GetMsgsReactions(ctx, "sg_g1", []int64{1, 2, 3}, "u1")
+	require.NoError(t, err)
+	assert.Len(t, reactions, 2)
+	require.Len(t, reactions[1], 1)
+	assert.Equal(t, int64(2), reactions[1][0].Count)
+	assert.True(t, reactions[1][0].IsReacted)
+	require.Len(t, reactions[2], 1)
+	assert.False(t, reactions[2][0].IsReacted)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type MsgScheduleDatabase interface {
+	Create(ctx context.Context, schedule *relation.MsgScheduleModel) error
+	Take(ctx context.Context, scheduleID string) (*relation.MsgScheduleModel, error)
+	CountPending(ctx context.Context, sendID string) (int64, error)
+	FindBySendID(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*relation.MsgScheduleModel, error)
+	// FindDue 查询到期未发送以及抢占超过 claimTimeout 仍未完成发送的消息
+	FindDue(ctx context.Context, before time.Time, claimTimeout time.Duration, limit int64) ([]*relation.MsgScheduleModel, error)
+	Reschedule(ctx context.Context, scheduleID string, scheduleTime time.Time) (bool, error)
+	Cancel(ctx context.Context, scheduleID string) (bool, error)
+	// Claim 抢占待发送的消息，成功的实例负责发送；抢占超过 claimTimeout 仍未完成发送时可被重新抢占
+	Claim(ctx context.Context, scheduleID string, claimTimeout time.Duration) (bool, error)
+	// Finish 记录发送结果，sendErr 不为空时标记为失败
+	Finish(ctx context.Context, scheduleID string, sendErr error) error
+}
+
+type msgScheduleDatabase struct {
+	schedule relation.MsgScheduleModelInterface
+}
+
+func NewMsgScheduleDatabase(schedule relation.MsgScheduleModelInterface) MsgScheduleDatabase {
+	return &msgScheduleDatabase{schedule: schedule}
+}
+
+func (m *msgScheduleDatabase) Create(ctx context.Context, schedule *relation.MsgScheduleModel) error {
+	return m.schedule.Create(ctx, []*relation.MsgScheduleModel{schedule})
+}
+
+func (m *msgScheduleDatabase) Take(ctx context.Context, scheduleID string) (*relation.MsgScheduleModel, error) {
+	return m.schedule.Take(ctx, scheduleID)
+}
+
+func (m *msgScheduleDatabase) CountPending(ctx context.Context, sendID string) (int64, error) {
+	return m.schedule.CountPending(ctx, sendID)
+}
+
+func (m *msgScheduleDatabase) FindBySendID(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*relation.MsgScheduleModel, error) {
+	return m.schedule.FindBySendID(ctx, sendID, status, pagination)
+}
+
+func (m *msgScheduleDatabase) FindDue(ctx context.Context, before time.Time, claimTimeout time.Duration, limit int64) ([]*relation.MsgScheduleModel, error) {
+	return m.schedule.FindDue(ctx, before, time.Now().Add(-claimTimeout), limit)
+}
+
+func (m *msgScheduleDatabase) Reschedule(ctx context.Context, scheduleID string, scheduleTime time.Time) (bool, error) {
+	return m.schedule.UpdateScheduleTime(ctx, scheduleID, scheduleTime)
+}
+
+func (m *msgScheduleDatabase) Cancel(ctx context.Context, scheduleID string) (bool, error) {
+	return m.schedule.UpdateStatus(ctx, scheduleID, relation.MsgSchedulePending, relation.MsgScheduleCanceled, "")
+}
+
+func (m *msgScheduleDatabase) Claim(ctx context.Context, scheduleID string, claimTimeout time.Duration) (bool, error) {
+	return m.schedule.Claim(ctx, scheduleID, time.Now().Add(-claimTimeout))
+}
+
+func (m *msgScheduleDatabase) Finish(ctx context.Context, scheduleID string, sendErr error) error {
+	if sendErr != nil {
+		_, err := m.schedule.UpdateStatus(ctx, scheduleID, relation.MsgScheduleSending, relation.MsgScheduleFailed, sendErr.Error())
+		return err
+	}
+	_, err := m.schedule.UpdateStatus(ctx, scheduleID, relation.MsgScheduleSending, relation.MsgScheduleSent, "")
+	return err
+}
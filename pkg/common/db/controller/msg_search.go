@@ -0,0 +1,113 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/pagination"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/msgsearch"
+)
+
+type MsgSearchDatabase interface {
+	// IndexMsgs 消息入索引，不支持搜索的消息类型忽略
+	IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	// ReindexMsg 消息修改后更新索引，消息还未入索引时写入
+	ReindexMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData) error
+	RemoveMsgs(ctx context.Context, conversationID string, seqs []int64) error
+	RemoveMsgsBySeq(ctx context.Context, conversationID string, seq int64) error
+	RemoveMsgsBySendTime(ctx context.Context, conversationID string, sendTime int64) error
+	// RemoveUserMsgs 用户删除消息，只对该用户不可见
+	RemoveUserMsgs(ctx context.Context, userID string, conversationID string, seqs []int64) error
+	RemoveUserMsgsBySeq(ctx context.Context, userID string, conversationID string, seq int64) error
+	// Search keyword 为空时只按其他条件过滤
+	Search(ctx context.Context, filter *relation.MsgSearchFilter, keyword string, pagination pagination.Pagination) (total int64, msgs []*relation.MsgSearchModel, err error)
+}
+
+type msgSearchDatabase struct {
+	search relation.MsgSearchModelInterface
+}
+
+func NewMsgSearchDatabase(search relation.MsgSearchModelInterface) MsgSearchDatabase {
+	return &msgSearchDatabase{search: search}
+}
+
+func (m *msgSearchDatabase) IndexMsgs(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	models := make([]*relation.MsgSearchModel, 0, len(msgs))
+	for _, msg := range msgs {
+		if msg == nil || msg.Seq <= 0 || !msgsearch.Indexable(msg.ContentType) {
+			continue
+		}
+		models = append(models, newMsgSearchModel(conversationID, msg))
+	}
+	if len(models) == 0 {
+		return nil
+	}
+	return m.search.Create(ctx, models)
+}
+
+// ReindexMsg 消息可能在 msgtransfer 入索引前被修改，此时写入修改后的消息，之后入索引的原消息被忽略
+func (m *msgSearchDatabase) ReindexMsg(ctx context.Context, conversationID string, msg *sdkws.MsgData) error {
+	return m.search.UpsertText(ctx, newMsgSearchModel(conversationID, msg))
+}
+
+func newMsgSearchModel(conversationID string, msg *sdkws.MsgData) *relation.MsgSearchModel {
+	text := msgsearch.ExtractText(msg.ContentType, msg.Content)
+	return &relation.MsgSearchModel{
+		ConversationID: conversationID,
+		Seq:            msg.Seq,
+		SendID:         msg.SendID,
+		SessionType:    msg.SessionType,
+		ContentType:    msg.ContentType,
+		SendTime:       msg.SendTime,
+		Text:           text,
+		Tokens:         msgsearch.Tokenize(text),
+	}
+}
+
+func (m *msgSearchDatabase) RemoveMsgs(ctx context.Context, conversationID string, seqs []int64) error {
+	return m.search.Delete(ctx, conversationID, seqs)
+}
+
+func (m *msgSearchDatabase) RemoveMsgsBySeq(ctx context.Context, conversationID string, seq int64) error {
+	return m.search.DeleteBySeq(ctx, conversationID, seq)
+}
+
+func (m *msgSearchDatabase) RemoveMsgsBySendTime(ctx context.Context, conversationID string, sendTime int64) error {
+	return m.search.DeleteBySendTime(ctx, conversationID, sendTime)
+}
+
+func (m *msgSearchDatabase) RemoveUserMsgs(ctx context.Context, userID string, conversationID string, seqs []int64) error {
+	return m.search.AddDelUser(ctx, userID, conversationID, seqs)
+}
+
+func (m *msgSearchDatabase) RemoveUserMsgsBySeq(ctx context.Context, userID string, conversationID string, seq int64) error {
+	return m.search.AddDelUserBySeq(ctx, userID, conversationID, seq)
+}
+
+func (m *msgSearchDatabase) Search(ctx context.Context, filter *relation.MsgSearchFilter, keyword string, pagination pagination.Pagination) (int64, []*relation.MsgSearchModel, error) {
+	if keyword != "" {
+		filter.Tokens = msgsearch.QueryTokens(keyword)
+		filter.Phrases = msgsearch.QueryPhrases(keyword)
+		// 关键词只有标点等无法分词的字符时没有结果
+		if len(filter.Tokens) == 0 {
+			return 0, nil, nil
+		}
+	}
+	return m.search.Search(ctx, filter, pagination)
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+type MsgThreadDatabase interface {
+	// AddReplies 记录消息中的话题回复，非回复消息忽略
+	AddReplies(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error
+	GetThread(ctx context.Context, conversationID string, rootSeq int64) (thread *relation.MsgThreadModel, err error)
+	// FindReplies 按话题内 seq 拉取回复
+	FindReplies(ctx context.Context, conversationID string, rootSeq int64, beginThreadSeq int64, num int64) (replies []*relation.MsgThreadReplyModel, err error)
+	// GetThreadInfos 获取多条根消息的话题信息以及 userID 在话题内 seq 上的已读位置和未读数
+	GetThreadInfos(ctx context.Context, conversationID string, rootSeqs []int64, userID string) (map[int64]*sdkws.MsgThreadInfo, error)
+	MarkAsRead(ctx context.Context, conversationID string, rootSeq int64, userID string, hasReadSeq int64) error
+}
+
+type msgThreadDatabase struct {
+	thread relation.MsgThreadModelInterface
+	cache  cache.MsgThreadCache
+}
+
+func NewMsgThreadDatabase(thread relation.MsgThreadModelInterface, cache cache.MsgThreadCache) MsgThreadDatabase {
+	return &msgThreadDatabase{thread: thread, cache: cache}
+}
+
+func (m *msgThreadDatabase) AddReplies(ctx context.Context, conversationID string, msgs []*sdkws.MsgData) error {
+	var rootSeqs []int64
+	for _, msg := range msgs {
+		if msg == nil || msg.ThreadRootSeq <= 0 || msg.Seq <= 0 {
+			continue
+		}
+		added, err := m.thread.AddReply(ctx, &relation.MsgThreadReplyModel{
+			ConversationID: conversationID,
+			RootSeq:        msg.ThreadRootSeq,
+			Seq:            msg.Seq,
+			SendID:         msg.SendID,
+			SendTime:       msg.SendTime,
+		})
+		if err != nil {
+			return err
+		}
+		if added {
+			rootSeqs = append(rootSeqs, msg.ThreadRootSeq)
+		}
+	}
+	if len(rootSeqs) == 0 {
+		return nil
+	}
+	return m.cache.DelThreads(conversationID, rootSeqs...).ExecDel(ctx)
+}
+
+func (m *msgThreadDatabase) GetThread(ctx context.Context, conversationID string, rootSeq int64) (*relation.MsgThreadModel, error) {
+	return m.cache.GetThread(ctx, conversationID, rootSeq)
+}
+
+func (m *msgThreadDatabase) FindReplies(ctx context.Context, conversationID string, rootSeq int64, beginThreadSeq int64, num int64) ([]*relation.MsgThreadReplyModel, error) {
+	return m.thread.FindReplies(ctx, conversationID, rootSeq, beginThreadSeq, num)
+}
+
+func (m *msgThreadDatabase) GetThreadInfos(ctx context.Context, conversationID string, rootSeqs []int64, userID string) (map[int64]*sdkws.MsgThreadInfo, error) {
+	threads, err := m.cache.GetThreads(ctx, conversationID, rootSeqs)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]*sdkws.MsgThreadInfo)
+	var threadSeqs []int64
+	for rootSeq, thread := range threads {
+		if thread.ReplyCount == 0 {
+			continue
+		}
+		res[rootSeq] = &sdkws.MsgThreadInfo{
+			ReplyCount:      thread.ReplyCount,
+			LastReplySeq:    thread.LastReplySeq,
+			LastReplyTime:   thread.LastReplyTime,
+			LastReplyUserID: thread.LastReplyUserID,
+			UnreadCount:     thread.ReplyCount,
+			MaxThreadSeq:    thread.MaxThreadSeq,
+		}
+		threadSeqs = append(threadSeqs, rootSeq)
+	}
+	// 只查询有回复的话题
+	if len(threadSeqs) == 0 || userID == "" {
+		return res, nil
+	}
+	reads, err := m.thread.FindReads(ctx, conversationID, threadSeqs, userID)
+	if err != nil {
+		return nil, err
+	}
+	// 话题内 seq 中断时可能跳号，只统计已读位置之后还有回复的话题
+	afterThreadSeqs := make(map[int64]int64)
+	for _, read := range reads {
+		info := res[read.RootSeq]
+		if info == nil {
+			continue
+		}
+		info.HasReadSeq = read.HasReadSeq
+		if read.HasReadSeq >= info.MaxThreadSeq {
+			info.UnreadCount = 0
+			continue
+		}
+		afterThreadSeqs[read.RootSeq] = read.HasReadSeq
+	}
+	if len(afterThreadSeqs) == 0 {
+		return res, nil
+	}
+	counts, err := m.thread.CountReplies(ctx, conversationID, afterThreadSeqs)
+	if err != nil {
+		return nil, err
+	}
+	for rootSeq := range afterThreadSeqs {
+		res[rootSeq].UnreadCount = counts[rootSeq]
+	}
+	return res, nil
+}
+
+func (m *msgThreadDatabase) MarkAsRead(ctx context.Context, conversationID string, rootSeq int64, userID string, hasReadSeq int64) error {
+	return m.thread.SetRead(ctx, conversationID, rootSeq, userID, hasReadSeq)
+}
@@ -0,0 +1,205 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controller
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/cache"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+// threadStore allocates thread seqs like the mongo store, lost[rootSeq] seqs are allocated
+// and never written to simulate an interrupted AddReply.
+type threadStore struct {
+	relation.MsgThreadModelInterface
+	threads     map[int64]*relation.MsgThreadModel
+	replies     []*relation.MsgThreadReplyModel
+	reads       []*relation.MsgThreadReadModel
+	lost        map[int64]int64
+	countAfters []map[int64]int64
+}
+
+func newThreadStore() *threadStore {
+	return &threadStore{threads: make(map[int64]*relation.MsgThreadModel), lost: make(map[int64]int64)}
+}
+
+func (s *threadStore) AddReply(_ context.Context, reply *relation.MsgThreadReplyModel) (bool, error) {
+	for _, r := range s.replies {
+		if r.RootSeq == reply.RootSeq && r.Seq == reply.Seq {
+			return false, nil
+		}
+	}
+	thread := s.threads[reply.RootSeq]
+	if thread == nil {
+		thread = &relation.MsgThreadModel{ConversationID: reply.ConversationID, RootSeq: reply.RootSeq}
+		s.threads[reply.RootSeq] = thread
+	}
+	thread.MaxThreadSeq += s.lost[reply.RootSeq] + 1
+	delete(s.lost, reply.RootSeq)
+	reply.ThreadSeq = thread.MaxThreadSeq
+	s.replies = append(s.replies, reply)
+	thread.ReplyCount++
+	thread.LastReplySeq, thread.LastReplyTime, thread.LastReplyUserID = reply.Seq, reply.SendTime, reply.SendID
+	return true, nil
+}
+
+func (s *threadStore) Take(_ context.Context, conversationID string, rootSeq int64) (*relation.MsgThreadModel, error) {
+	if thread := s.threads[rootSeq]; thread != nil {
+		copied := *thread
+		return &copied, nil
+	}
+	return &relation.MsgThreadModel{ConversationID: conversationID, RootSeq: rootSeq}, nil
+}
+
+func (s *threadStore) FindReads(_ context.Context, _ string, rootSeqs []int64, userID string) ([]*relation.MsgThreadReadModel, error) {
+	var res []*relation.MsgThreadReadModel
+	for _, read := range s.reads {
+		for _, rootSeq := range rootSeqs {
+			if read.RootSeq == rootSeq && read.UserID == userID {
+				res = append(res, read)
+			}
+		}
+	}
+	return res, nil
+}
+
+func (s *threadStore) CountReplies(_ context.Context, _ string, afterThreadSeqs map[int64]int64) (map[int64]int64, error) {
+	s.countAfters = append(s.countAfters, afterThreadSeqs)
+	counts := make(map[int64]int64)
+	for _, r := range s.replies {
+		if after, ok := afterThreadSeqs[r.RootSeq]; ok && r.ThreadSeq > after {
+			counts[r.RootSeq]++
+		}
+	}
+	return counts, nil
+}
+
+func (s *threadStore) SetRead(_ context.Context, conversationID string, rootSeq int64, userID string, hasReadSeq int64) error {
+	for _, read := range s.reads {
+		if read.RootSeq == rootSeq && read.UserID == userID {
+			if hasReadSeq > read.HasReadSeq {
+				read.HasReadSeq = hasReadSeq
+			}
+			return nil
+		}
+	}
+	s.reads = append(s.reads, &relation.MsgThreadReadModel{ConversationID: conversationID, RootSeq: rootSeq, UserID: userID, HasReadSeq: hasReadSeq})
+	return nil
+}
+
+// threadCache keeps the threads it read until they are deleted.
+type threadCache struct {
+	cache.MsgThreadCache
+	store   *threadStore
+	threads map[int64]*relation.MsgThreadModel
+	del     [][]int64
+}
+
+func (c *threadCache) GetThreads(ctx context.Context, conversationID string, rootSeqs []int64) (map[int64]*relation.MsgThreadModel, error) {
+	res := make(map[int64]*relation.MsgThreadModel)
+	for _, rootSeq := range rootSeqs {
+		thread, ok := c.threads[rootSeq]
+		if !ok {
+			var err error
+			if thread, err = c.store.Take(ctx, conversationID, rootSeq); err != nil {
+				return nil, err
+			}
+			c.threads[rootSeq] = thread
+		}
+		res[rootSeq] = thread
+	}
+	return res, nil
+}
+
+func (c *threadCache) DelThreads(_ string, rootSeqs ...int64) cache.MsgThreadCache {
+	for _, rootSeq := range rootSeqs {
+		delete(c.threads, rootSeq)
+	}
+	c.del = append(c.del, rootSeqs)
+	return c
+}
+
+func (c *threadCache) ExecDel(context.Context, ...bool) error { return nil }
+
+func newTestThreadDatabase() (MsgThreadDatabase, *threadStore, *threadCache) {
+	store := newThreadStore()
+	c := &threadCache{store: store, threads: make(map[int64]*relation.MsgThreadModel)}
+	return NewMsgThreadDatabase(store, c), store, c
+}
+
+func TestAddRepliesThreadSeq(t *testing.T) {
+	database, store, c := newTestThreadDatabase()
+	ctx := context.Background()
+	batch := []*sdkws.MsgData{
+		{Seq: 2, ThreadRootSeq: 1, SendID: "u2"},
+		{Seq: 3},
+		{Seq: 4, ThreadRootSeq: 3, SendID: "u2"},
+		{Seq: 5, ThreadRootSeq: 1, SendID: "u3"},
+	}
+	require.NoError(t, database.AddReplies(ctx, "sg_g1", batch))
+	infos, err := database.GetThreadInfos(ctx, "sg_g1", []int64{1, 3}, "")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), infos[1].MaxThreadSeq)
+	assert.Equal(t, int64(1), infos[3].MaxThreadSeq)
+	var threadSeqs []int64
+	for _, r := range store.replies {
+		threadSeqs = append(threadSeqs, r.ThreadSeq)
+	}
+	assert.Equal(t, []int64{1, 1, 2}, threadSeqs)
+
+	// 重复消费不分配 seq，也不删除缓存
+	require.NoError(t, database.AddReplies(ctx, "sg_g1", batch))
+	assert.Len(t, store.replies, 3)
+	assert.Equal(t, [][]int64{{1, 3, 1}}, c.del)
+
+	require.NoError(t, database.AddReplies(ctx, "sg_g1", []*sdkws.MsgData{{Seq: 6, ThreadRootSeq: 3, SendID: "u3", SendTime: 60}}))
+	infos, err = database.GetThreadInfos(ctx, "sg_g1", []int64{3}, "")
+	require.NoError(t, err)
+	assert.Equal(t, &sdkws.MsgThreadInfo{ReplyCount: 2, LastReplySeq: 6, LastReplyTime: 60, LastReplyUserID: "u3", UnreadCount: 2, MaxThreadSeq: 2}, infos[3])
+}
+
+func TestGetThreadInfosUnreadWithThreadSeqGap(t *testing.T) {
+	database, store, _ := newTestThreadDatabase()
+	ctx := context.Background()
+	require.NoError(t, database.AddReplies(ctx, "sg_g1", []*sdkws.MsgData{{Seq: 2, ThreadRootSeq: 1}}))
+	// 话题 1 的第 2 个 seq 分配后写入中断
+	store.lost[1] = 1
+	require.NoError(t, database.AddReplies(ctx, "sg_g1", []*sdkws.MsgData{
+		{Seq: 3, ThreadRootSeq: 1},
+		{Seq: 4, ThreadRootSeq: 1},
+		{Seq: 6, ThreadRootSeq: 5},
+	}))
+	require.NoError(t, database.MarkAsRead(ctx, "sg_g1", 1, "u1", 1))
+	require.NoError(t, database.MarkAsRead(ctx, "sg_g1", 5, "u1", 1))
+	// 已读位置不回退
+	require.NoError(t, database.MarkAsRead(ctx, "sg_g1", 5, "u1", 0))
+
+	infos, err := database.GetThreadInfos(ctx, "sg_g1", []int64{1, 5, 7}, "u1")
+	require.NoError(t, err)
+	assert.Len(t, infos, 2)
+	// 未读数按回复统计，跳过的 seq 不计入
+	assert.Equal(t, int64(4), infos[1].MaxThreadSeq)
+	assert.Equal(t, int64(1), infos[1].HasReadSeq)
+	assert.Equal(t, int64(2), infos[1].UnreadCount)
+	assert.Zero(t, infos[5].UnreadCount)
+	// 已读到最后的话题不查询
+	assert.Equal(t, []map[int64]int64{{1: 1}}, store.countAfters)
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewMsgPinMongo(db *mongo.Database) (relation.MsgPinModelInterface, error) {
+	coll := db.Collection("msg_pin")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+			{Key: "seq", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgPinMgo{coll: coll}, nil
+}
+
+type MsgPinMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgPinMgo) Create(ctx context.Context, pins []*relation.MsgPinModel) (err error) {
+	return mgoutil.InsertMany(ctx, m.coll, pins)
+}
+
+func (m *MsgPinMgo) Delete(ctx context.Context, conversationID string, seq int64) (bool, error) {
+	res, err := m.coll.DeleteOne(ctx, bson.M{"conversation_id": conversationID, "seq": seq})
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (m *MsgPinMgo) FindByConversationID(ctx context.Context, conversationID string) ([]*relation.MsgPinModel, error) {
+	return mgoutil.Find[*relation.MsgPinModel](ctx, m.coll, bson.M{"conversation_id": conversationID}, pinSortOpt())
+}
+
+func (m *MsgPinMgo) FindByConversationIDs(ctx context.Context, conversationIDs []string) (map[string][]*relation.MsgPinModel, error) {
+	pins, err := mgoutil.Find[*relation.MsgPinModel](ctx, m.coll, bson.M{"conversation_id": bson.M{"$in": conversationIDs}}, pinSortOpt())
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string][]*relation.MsgPinModel)
+	for _, pin := range pins {
+		res[pin.ConversationID] = append(res[pin.ConversationID], pin)
+	}
+	return res, nil
+}
+
+// pinSortOpt 按置顶时间倒序，同一时间按 seq 倒序
+func pinSortOpt() *options.FindOptions {
+	return options.Find().SetSort(bson.D{{Key: "pin_time", Value: -1}, {Key: "seq", Value: -1}})
+}
@@ -0,0 +1,110 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewMsgReactionMongo(db *mongo.Database) (relation.MsgReactionModelInterface, error) {
+	coll := db.Collection("msg_reaction")
+	_, err := coll.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "conversation_id", Value: 1},
+			{Key: "seq", Value: 1},
+			{Key: "user_id", Value: 1},
+			{Key: "reaction", Value: 1},
+		},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgReactionMgo{coll: coll}, nil
+}
+
+type MsgReactionMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgReactionMgo) Create(ctx context.Context, reaction *relation.MsgReactionModel) (err error) {
+	return mgoutil.InsertMany(ctx, m.coll, []*relation.MsgReactionModel{reaction})
+}
+
+func (m *MsgReactionMgo) Delete(ctx context.Context, conversationID string, seq int64, userID, reaction string) (bool, error) {
+	res, err := m.coll.DeleteOne(ctx, bson.M{"conversation_id": conversationID, "seq": seq, "user_id": userID, "reaction": reaction})
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.DeletedCount > 0, nil
+}
+
+func (m *MsgReactionMgo) CountUserReactions(ctx context.Context, conversationID string, seq int64, userID string) (int64, error) {
+	return mgoutil.Count(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": seq, "user_id": userID})
+}
+
+func (m *MsgReactionMgo) CountReactions(ctx context.Context, conversationID string, seq int64) ([]*relation.MsgReactionCountModel, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"conversation_id": conversationID, "seq": seq}},
+		bson.M{"$group": bson.M{"_id": "$reaction", "count": bson.M{"$sum": 1}, "first": bson.M{"$min": "$create_time"}}},
+		bson.M{"$sort": bson.D{{Key: "first", Value: 1}, {Key: "_id", Value: 1}}},
+	}
+	return mgoutil.Aggregate[*relation.MsgReactionCountModel](ctx, m.coll, pipeline)
+}
+
+func (m *MsgReactionMgo) CountReactionsBySeqs(ctx context.Context, conversationID string, seqs []int64) (map[int64][]*relation.MsgReactionCountModel, error) {
+	type seqReactionCount struct {
+		ID struct {
+			Seq      int64  `bson:"seq"`
+			Reaction string `bson:"reaction"`
+		} `bson:"_id"`
+		Count int64 `bson:"count"`
+	}
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}}},
+		bson.M{"$group": bson.M{"_id": bson.M{"seq": "$seq", "reaction": "$reaction"}, "count": bson.M{"$sum": 1}, "first": bson.M{"$min": "$create_time"}}},
+		bson.M{"$sort": bson.D{{Key: "first", Value: 1}, {Key: "_id.reaction", Value: 1}}},
+	}
+	counts, err := mgoutil.Aggregate[*seqReactionCount](ctx, m.coll, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64][]*relation.MsgReactionCountModel)
+	for _, count := range counts {
+		res[count.ID.Seq] = append(res[count.ID.Seq], &relation.MsgReactionCountModel{Reaction: count.ID.Reaction, Count: count.Count})
+	}
+	return res, nil
+}
+
+func (m *MsgReactionMgo) FindUserReactions(ctx context.Context, conversationID string, seqs []int64, userID string) ([]*relation.MsgReactionModel, error) {
+	return mgoutil.Find[*relation.MsgReactionModel](ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}, "user_id": userID})
+}
+
+func (m *MsgReactionMgo) FindReactions(ctx context.Context, conversationID string, seq int64, reaction string, pagination pagination.Pagination) (int64, []*relation.MsgReactionModel, error) {
+	filter := bson.M{"conversation_id": conversationID, "seq": seq}
+	if reaction != "" {
+		filter["reaction"] = reaction
+	}
+	return mgoutil.FindPage[*relation.MsgReactionModel](ctx, m.coll, filter, pagination, options.Find().SetSort(bson.D{{Key: "create_time", Value: 1}}))
+}
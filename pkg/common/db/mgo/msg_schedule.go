@@ -0,0 +1,114 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewMsgScheduleMongo(db *mongo.Database) (relation.MsgScheduleModelInterface, error) {
+	coll := db.Collection("msg_schedule")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "schedule_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "send_id", Value: 1}, {Key: "status", Value: 1}, {Key: "schedule_time", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "schedule_time", Value: 1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgScheduleMgo{coll: coll}, nil
+}
+
+type MsgScheduleMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgScheduleMgo) Create(ctx context.Context, schedules []*relation.MsgScheduleModel) (err error) {
+	return mgoutil.InsertMany(ctx, m.coll, schedules)
+}
+
+func (m *MsgScheduleMgo) Take(ctx context.Context, scheduleID string) (*relation.MsgScheduleModel, error) {
+	return mgoutil.FindOne[*relation.MsgScheduleModel](ctx, m.coll, bson.M{"schedule_id": scheduleID})
+}
+
+func (m *MsgScheduleMgo) CountPending(ctx context.Context, sendID string) (int64, error) {
+	return mgoutil.Count(ctx, m.coll, bson.M{"send_id": sendID, "status": relation.MsgSchedulePending})
+}
+
+func (m *MsgScheduleMgo) FindBySendID(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (int64, []*relation.MsgScheduleModel, error) {
+	filter := bson.M{"send_id": sendID}
+	if status != 0 {
+		filter["status"] = status
+	}
+	return mgoutil.FindPage[*relation.MsgScheduleModel](ctx, m.coll, filter, pagination, options.Find().SetSort(bson.D{{Key: "schedule_time", Value: 1}}))
+}
+
+func (m *MsgScheduleMgo) FindDue(ctx context.Context, before time.Time, staleBefore time.Time, limit int64) ([]*relation.MsgScheduleModel, error) {
+	filter := bson.M{"schedule_time": bson.M{"$lte": before}, "$or": claimableFilter(staleBefore)}
+	return mgoutil.Find[*relation.MsgScheduleModel](ctx, m.coll, filter, options.Find().SetSort(bson.D{{Key: "schedule_time", Value: 1}}).SetLimit(limit))
+}
+
+func (m *MsgScheduleMgo) Claim(ctx context.Context, scheduleID string, staleBefore time.Time) (bool, error) {
+	now := time.Now()
+	// 查询到期后可能被改期，抢占时再校验定时时间
+	filter := bson.M{"schedule_id": scheduleID, "schedule_time": bson.M{"$lte": now}, "$or": claimableFilter(staleBefore)}
+	update := bson.M{"$set": bson.M{"status": relation.MsgScheduleSending, "claim_time": now, "update_time": now}}
+	return m.updateOne(ctx, filter, update)
+}
+
+// claimableFilter 待发送，或抢占后实例退出、记录结果失败而停留在发送中的消息
+func claimableFilter(staleBefore time.Time) bson.A {
+	return bson.A{
+		bson.M{"status": relation.MsgSchedulePending},
+		bson.M{"status": relation.MsgScheduleSending, "claim_time": bson.M{"$lt": staleBefore}},
+	}
+}
+
+func (m *MsgScheduleMgo) UpdateScheduleTime(ctx context.Context, scheduleID string, scheduleTime time.Time) (bool, error) {
+	filter := bson.M{"schedule_id": scheduleID, "status": relation.MsgSchedulePending}
+	update := bson.M{"$set": bson.M{"schedule_time": scheduleTime, "update_time": time.Now()}}
+	return m.updateOne(ctx, filter, update)
+}
+
+func (m *MsgScheduleMgo) UpdateStatus(ctx context.Context, scheduleID string, from, to int32, errMsg string) (bool, error) {
+	filter := bson.M{"schedule_id": scheduleID, "status": from}
+	update := bson.M{"$set": bson.M{"status": to, "error": errMsg, "update_time": time.Now()}}
+	return m.updateOne(ctx, filter, update)
+}
+
+func (m *MsgScheduleMgo) updateOne(ctx context.Context, filter any, update any) (bool, error) {
+	res, err := m.coll.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return false, errs.Wrap(err)
+	}
+	return res.ModifiedCount > 0, nil
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+// newTestMongo connects to the local mongo, the test is skipped without one.
+func newTestMongo(t *testing.T) *mongo.Database {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI("mongodb://127.0.0.1:27017").SetServerSelectionTimeout(time.Second))
+	if err == nil {
+		err = client.Ping(ctx, nil)
+	}
+	if err != nil {
+		t.Skip("mongo is not available:", err)
+	}
+	db := client.Database("openim_test")
+	t.Cleanup(func() {
+		_ = db.Drop(context.Background())
+		_ = client.Disconnect(context.Background())
+	})
+	return db
+}
+
+func TestMsgScheduleClaim(t *testing.T) {
+	db := newTestMongo(t)
+	schedule, err := NewMsgScheduleMongo(db)
+	require.NoError(t, err)
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, schedule.Create(ctx, []*relation.MsgScheduleModel{
+		{ScheduleID: "s1", Status: relation.MsgSchedulePending, ScheduleTime: now.Add(-time.Minute)},
+		{ScheduleID: "s2", Status: relation.MsgSchedulePending, ScheduleTime: now.Add(time.Hour)},
+	}))
+
+	due, err := schedule.FindDue(ctx, now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, "s1", due[0].ScheduleID)
+
+	claimed, err := schedule.Claim(ctx, "s1", now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, err = schedule.Claim(ctx, "s1", now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	due, err = schedule.FindDue(ctx, now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	// 抢占超时后可以重新抢占
+	staleBefore := time.Now().Add(time.Second)
+	due, err = schedule.FindDue(ctx, now, staleBefore, 10)
+	require.NoError(t, err)
+	assert.Len(t, due, 1)
+	claimed, err = schedule.Claim(ctx, "s1", staleBefore)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	updated, err := schedule.UpdateStatus(ctx, "s1", relation.MsgScheduleSending, relation.MsgScheduleSent, "")
+	require.NoError(t, err)
+	assert.True(t, updated)
+	claimed, err = schedule.Claim(ctx, "s1", staleBefore)
+	require.NoError(t, err)
+	assert.False(t, claimed)
+}
+
+func TestMsgScheduleClaimRescheduled(t *testing.T) {
+	db := newTestMongo(t)
+	schedule, err := NewMsgScheduleMongo(db)
+	require.NoError(t, err)
+	ctx := context.Background()
+	now := time.Now()
+	require.NoError(t, schedule.Create(ctx, []*relation.MsgScheduleModel{
+		{ScheduleID: "s1", Status: relation.MsgSchedulePending, ScheduleTime: now.Add(-time.Minute)},
+	}))
+	due, err := schedule.FindDue(ctx, now, now.Add(-time.Minute), 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	// 查询到期与抢占之间被改期到未来，不能再按原时间发送
+	updated, err := schedule.UpdateScheduleTime(ctx, "s1", now.Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, updated)
+	claimed, err := schedule.Claim(ctx, "s1", now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+	s, err := schedule.Take(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, relation.MsgSchedulePending, s.Status)
+}
@@ -0,0 +1,165 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"github.com/OpenIMSDK/tools/pagination"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewMsgSearchMongo(db *mongo.Database) (relation.MsgSearchModelInterface, error) {
+	coll := db.Collection("msg_search")
+	_, err := coll.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "conversation_id", Value: 1}, {Key: "seq", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "conversation_id", Value: 1}, {Key: "send_time", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "tokens", Value: 1}, {Key: "send_time", Value: -1}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgSearchMgo{coll: coll}, nil
+}
+
+type MsgSearchMgo struct {
+	coll *mongo.Collection
+}
+
+func (m *MsgSearchMgo) Create(ctx context.Context, msgs []*relation.MsgSearchModel) error {
+	// 消息可能重复消费，无序写入跳过已存在的消息
+	err := mgoutil.InsertMany(ctx, m.coll, msgs, options.InsertMany().SetOrdered(false))
+	if err != nil && mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
+
+func (m *MsgSearchMgo) UpsertText(ctx context.Context, msg *relation.MsgSearchModel) error {
+	filter := bson.M{"conversation_id": msg.ConversationID, "seq": msg.Seq}
+	update := bson.M{
+		"$set":         bson.M{"content_type": msg.ContentType, "text": msg.Text, "tokens": msg.Tokens},
+		"$setOnInsert": bson.M{"send_id": msg.SendID, "session_type": msg.SessionType, "send_time": msg.SendTime},
+	}
+	return mgoutil.UpdateOne(ctx, m.coll, filter, update, false, options.Update().SetUpsert(true))
+}
+
+func (m *MsgSearchMgo) Delete(ctx context.Context, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	return mgoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}})
+}
+
+func (m *MsgSearchMgo) DeleteBySeq(ctx context.Context, conversationID string, seq int64) error {
+	return mgoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "seq": bson.M{"$lte": seq}})
+}
+
+func (m *MsgSearchMgo) DeleteBySendTime(ctx context.Context, conversationID string, sendTime int64) error {
+	return mgoutil.DeleteMany(ctx, m.coll, bson.M{"conversation_id": conversationID, "send_time": bson.M{"$lt": sendTime}})
+}
+
+func (m *MsgSearchMgo) AddDelUser(ctx context.Context, userID string, conversationID string, seqs []int64) error {
+	if len(seqs) == 0 {
+		return nil
+	}
+	filter := bson.M{"conversation_id": conversationID, "seq": bson.M{"$in": seqs}}
+	_, err := mgoutil.UpdateMany(ctx, m.coll, filter, bson.M{"$addToSet": bson.M{"del_user_ids": userID}})
+	return err
+}
+
+func (m *MsgSearchMgo) AddDelUserBySeq(ctx context.Context, userID string, conversationID string, seq int64) error {
+	filter := bson.M{"conversation_id": conversationID, "seq": bson.M{"$lte": seq}}
+	_, err := mgoutil.UpdateMany(ctx, m.coll, filter, bson.M{"$addToSet": bson.M{"del_user_ids": userID}})
+	return err
+}
+
+func (m *MsgSearchMgo) Search(ctx context.Context, filter *relation.MsgSearchFilter, pagination pagination.Pagination) (int64, []*relation.MsgSearchModel, error) {
+	query := bson.M{}
+	if filter.UserID != "" {
+		query["del_user_ids"] = bson.M{"$ne": filter.UserID}
+	}
+	if len(filter.MinSeqs) > 0 {
+		query["$or"] = searchConversationFilter(filter.ConversationIDs, filter.MinSeqs)
+	} else if len(filter.ConversationIDs) > 0 {
+		query["conversation_id"] = bson.M{"$in": filter.ConversationIDs}
+	}
+	if len(filter.SendIDs) > 0 {
+		query["send_id"] = bson.M{"$in": filter.SendIDs}
+	}
+	if len(filter.ContentTypes) > 0 {
+		query["content_type"] = bson.M{"$in": filter.ContentTypes}
+	}
+	if filter.StartTime > 0 || filter.EndTime > 0 {
+		sendTime := bson.M{}
+		if filter.StartTime > 0 {
+			sendTime["$gte"] = filter.StartTime
+		}
+		if filter.EndTime > 0 {
+			sendTime["$lte"] = filter.EndTime
+		}
+		query["send_time"] = sendTime
+	}
+	if len(filter.Tokens) > 0 {
+		query["tokens"] = bson.M{"$all": filter.Tokens}
+	}
+	if len(filter.Phrases) > 0 {
+		and := make(bson.A, 0, len(filter.Phrases))
+		for _, phrase := range filter.Phrases {
+			and = append(and, bson.M{"text": bson.M{"$regex": regexp.QuoteMeta(phrase)}})
+		}
+		query["$and"] = and
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "send_time", Value: -1}, {Key: "seq", Value: -1}}).SetProjection(bson.M{"tokens": 0, "del_user_ids": 0})
+	return mgoutil.FindPage[*relation.MsgSearchModel](ctx, m.coll, query, pagination, opts)
+}
+
+// searchConversationFilter 有最小 seq 的会话单独限制 seq，其余会话只按 conversation_id 过滤
+func searchConversationFilter(conversationIDs []string, minSeqs map[string]int64) bson.A {
+	or := make(bson.A, 0, len(minSeqs)+1)
+	if len(conversationIDs) == 0 {
+		minSeqConversationIDs := make([]string, 0, len(minSeqs))
+		for conversationID, minSeq := range minSeqs {
+			minSeqConversationIDs = append(minSeqConversationIDs, conversationID)
+			or = append(or, bson.M{"conversation_id": conversationID, "seq": bson.M{"$gte": minSeq}})
+		}
+		return append(or, bson.M{"conversation_id": bson.M{"$nin": minSeqConversationIDs}})
+	}
+	var noMinSeqConversationIDs []string
+	for _, conversationID := range conversationIDs {
+		if minSeq, ok := minSeqs[conversationID]; ok {
+			or = append(or, bson.M{"conversation_id": conversationID, "seq": bson.M{"$gte": minSeq}})
+		} else {
+			noMinSeqConversationIDs = append(noMinSeqConversationIDs, conversationID)
+		}
+	}
+	if len(noMinSeqConversationIDs) > 0 {
+		or = append(or, bson.M{"conversation_id": bson.M{"$in": noMinSeqConversationIDs}})
+	}
+	return or
+}
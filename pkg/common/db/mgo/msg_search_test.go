@@ -0,0 +1,79 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func TestMsgSearchMinSeqs(t *testing.T) {
+	db := newTestMongo(t)
+	search, err := NewMsgSearchMongo(db)
+	require.NoError(t, err)
+	ctx := context.Background()
+	var msgs []*relation.MsgSearchModel
+	for _, conversationID := range []string{"sg_g1", "sg_g2"} {
+		for seq := int64(1); seq <= 4; seq++ {
+			msgs = append(msgs, &relation.MsgSearchModel{ConversationID: conversationID, Seq: seq, SendTime: seq, Tokens: []string{"hi"}})
+		}
+	}
+	require.NoError(t, search.Create(ctx, msgs))
+
+	page := &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10}
+	total, res, err := search.Search(ctx, &relation.MsgSearchFilter{
+		ConversationIDs: []string{"sg_g1", "sg_g2"},
+		MinSeqs:         map[string]int64{"sg_g1": 3},
+		Tokens:          []string{"hi"},
+	}, page)
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), total)
+	for _, msg := range res {
+		if msg.ConversationID == "sg_g1" {
+			assert.GreaterOrEqual(t, msg.Seq, int64(3))
+		}
+	}
+
+	// 不限会话时其他会话不受影响
+	total, _, err = search.Search(ctx, &relation.MsgSearchFilter{MinSeqs: map[string]int64{"sg_g2": 4}}, page)
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), total)
+}
+
+func TestMsgSearchUpsertTextBeforeIndex(t *testing.T) {
+	db := newTestMongo(t)
+	search, err := NewMsgSearchMongo(db)
+	require.NoError(t, err)
+	ctx := context.Background()
+	// 消息在入索引前被修改，之后入索引的原消息被忽略
+	require.NoError(t, search.UpsertText(ctx, &relation.MsgSearchModel{ConversationID: "sg_g1", Seq: 1, SendID: "u1", SendTime: 1, Text: "edited", Tokens: []string{"edited"}}))
+	require.NoError(t, search.Create(ctx, []*relation.MsgSearchModel{{ConversationID: "sg_g1", Seq: 1, SendID: "u1", SendTime: 1, Text: "origin", Tokens: []string{"origin"}}}))
+
+	page := &sdkws.RequestPagination{PageNumber: 1, ShowNumber: 10}
+	total, _, err := search.Search(ctx, &relation.MsgSearchFilter{Tokens: []string{"origin"}}, page)
+	require.NoError(t, err)
+	assert.Zero(t, total)
+	total, res, err := search.Search(ctx, &relation.MsgSearchFilter{SendIDs: []string{"u1"}, Tokens: []string{"edited"}}, page)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), total)
+	require.Len(t, res, 1)
+	assert.Equal(t, int64(1), res[0].SendTime)
+}
@@ -0,0 +1,156 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mgoutil"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func NewMsgThreadMongo(db *mongo.Database) (relation.MsgThreadModelInterface, error) {
+	indexes := map[string]bson.D{
+		"msg_thread":       {{Key: "conversation_id", Value: 1}, {Key: "root_seq", Value: 1}},
+		"msg_thread_reply": {{Key: "conversation_id", Value: 1}, {Key: "root_seq", Value: 1}, {Key: "seq", Value: 1}},
+		"msg_thread_read":  {{Key: "conversation_id", Value: 1}, {Key: "root_seq", Value: 1}, {Key: "user_id", Value: 1}},
+	}
+	for name, keys := range indexes {
+		_, err := db.Collection(name).Indexes().CreateOne(context.Background(), mongo.IndexModel{
+			Keys:    keys,
+			Options: options.Index().SetUnique(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err := db.Collection("msg_thread_reply").Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "conversation_id", Value: 1}, {Key: "root_seq", Value: 1}, {Key: "thread_seq", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &MsgThreadMgo{
+		thread: db.Collection("msg_thread"),
+		reply:  db.Collection("msg_thread_reply"),
+		read:   db.Collection("msg_thread_read"),
+	}, nil
+}
+
+type MsgThreadMgo struct {
+	thread *mongo.Collection
+	reply  *mongo.Collection
+	read   *mongo.Collection
+}
+
+func (m *MsgThreadMgo) AddReply(ctx context.Context, reply *relation.MsgThreadReplyModel) (bool, error) {
+	filter := bson.M{"conversation_id": reply.ConversationID, "root_seq": reply.RootSeq}
+	// 重复消费时不再分配话题内 seq
+	exist, err := mgoutil.Exist(ctx, m.reply, bson.M{"conversation_id": reply.ConversationID, "root_seq": reply.RootSeq, "seq": reply.Seq})
+	if err != nil || exist {
+		return false, err
+	}
+	// 先分配话题内 seq 再写入回复，写入前中断时 seq 跳号但不会重复
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	var thread relation.MsgThreadModel
+	if err := m.thread.FindOneAndUpdate(ctx, filter, bson.M{"$inc": bson.M{"max_thread_seq": 1}}, opts).Decode(&thread); err != nil {
+		return false, errs.Wrap(err)
+	}
+	reply.ThreadSeq = thread.MaxThreadSeq
+	// 回复唯一，并发重复消费时不重复计数
+	if err := mgoutil.InsertMany(ctx, m.reply, []*relation.MsgThreadReplyModel{reply}); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	update := bson.M{
+		"$inc": bson.M{"reply_count": 1},
+		"$max": bson.M{"last_reply_seq": reply.Seq},
+	}
+	if err := mgoutil.UpdateOne(ctx, m.thread, filter, update, false); err != nil {
+		return false, err
+	}
+	// 只有最新的回复更新最后回复信息
+	filter["last_reply_seq"] = reply.Seq
+	update = bson.M{"$set": bson.M{"last_reply_time": reply.SendTime, "last_reply_user_id": reply.SendID}}
+	if err := mgoutil.UpdateOne(ctx, m.thread, filter, update, false); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Take 话题不存在时返回空汇总
+func (m *MsgThreadMgo) Take(ctx context.Context, conversationID string, rootSeq int64) (*relation.MsgThreadModel, error) {
+	thread, err := mgoutil.FindOne[*relation.MsgThreadModel](ctx, m.thread, bson.M{"conversation_id": conversationID, "root_seq": rootSeq})
+	if err != nil {
+		if errs.Unwrap(err) == mongo.ErrNoDocuments {
+			return &relation.MsgThreadModel{ConversationID: conversationID, RootSeq: rootSeq}, nil
+		}
+		return nil, err
+	}
+	return thread, nil
+}
+
+func (m *MsgThreadMgo) FindReplies(ctx context.Context, conversationID string, rootSeq int64, beginThreadSeq int64, num int64) ([]*relation.MsgThreadReplyModel, error) {
+	filter := bson.M{"conversation_id": conversationID, "root_seq": rootSeq, "thread_seq": bson.M{"$gte": beginThreadSeq}}
+	return mgoutil.Find[*relation.MsgThreadReplyModel](ctx, m.reply, filter, options.Find().SetSort(bson.D{{Key: "thread_seq", Value: 1}}).SetLimit(num))
+}
+
+func (m *MsgThreadMgo) Find(ctx context.Context, conversationID string, rootSeqs []int64) ([]*relation.MsgThreadModel, error) {
+	return mgoutil.Find[*relation.MsgThreadModel](ctx, m.thread, bson.M{"conversation_id": conversationID, "root_seq": bson.M{"$in": rootSeqs}})
+}
+
+func (m *MsgThreadMgo) CountReplies(ctx context.Context, conversationID string, afterThreadSeqs map[int64]int64) (map[int64]int64, error) {
+	counts := make(map[int64]int64, len(afterThreadSeqs))
+	if len(afterThreadSeqs) == 0 {
+		return counts, nil
+	}
+	or := make(bson.A, 0, len(afterThreadSeqs))
+	for rootSeq, afterThreadSeq := range afterThreadSeqs {
+		or = append(or, bson.M{"root_seq": rootSeq, "thread_seq": bson.M{"$gt": afterThreadSeq}})
+	}
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"conversation_id": conversationID, "$or": or}},
+		bson.M{"$group": bson.M{"_id": "$root_seq", "count": bson.M{"$sum": 1}}},
+	}
+	type replyCount struct {
+		RootSeq int64 `bson:"_id"`
+		Count   int64 `bson:"count"`
+	}
+	res, err := mgoutil.Aggregate[*replyCount](ctx, m.reply, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range res {
+		counts[c.RootSeq] = c.Count
+	}
+	return counts, nil
+}
+
+func (m *MsgThreadMgo) FindReads(ctx context.Context, conversationID string, rootSeqs []int64, userID string) ([]*relation.MsgThreadReadModel, error) {
+	return mgoutil.Find[*relation.MsgThreadReadModel](ctx, m.read, bson.M{"conversation_id": conversationID, "root_seq": bson.M{"$in": rootSeqs}, "user_id": userID})
+}
+
+func (m *MsgThreadMgo) SetRead(ctx context.Context, conversationID string, rootSeq int64, userID string, hasReadSeq int64) error {
+	filter := bson.M{"conversation_id": conversationID, "root_seq": rootSeq, "user_id": userID}
+	return mgoutil.UpdateOne(ctx, m.read, filter, bson.M{"$max": bson.M{"has_read_seq": hasReadSeq}}, false, options.Update().SetUpsert(true))
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mgo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/db/table/relation"
+)
+
+func TestMsgThreadAddReplyThreadSeq(t *testing.T) {
+	db := newTestMongo(t)
+	thread, err := NewMsgThreadMongo(db)
+	require.NoError(t, err)
+	ctx := context.Background()
+	// 两个话题的回复在会话中交错，话题内 seq 各自从 1 开始
+	for _, r := range []struct{ rootSeq, seq int64 }{{1, 2}, {3, 4}, {1, 5}, {1, 6}, {3, 7}} {
+		added, err := thread.AddReply(ctx, &relation.MsgThreadReplyModel{ConversationID: "sg_g1", RootSeq: r.rootSeq, Seq: r.seq, SendID: "u1", SendTime: r.seq})
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+	// 重复消费不分配新的 seq
+	added, err := thread.AddReply(ctx, &relation.MsgThreadReplyModel{ConversationID: "sg_g1", RootSeq: 1, Seq: 5, SendID: "u1", SendTime: 5})
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	root, err := thread.Take(ctx, "sg_g1", 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), root.ReplyCount)
+	assert.Equal(t, int64(3), root.MaxThreadSeq)
+	assert.Equal(t, int64(6), root.LastReplySeq)
+
+	replies, err := thread.FindReplies(ctx, "sg_g1", 1, 2, 10)
+	require.NoError(t, err)
+	require.Len(t, replies, 2)
+	assert.Equal(t, []int64{2, 3}, []int64{replies[0].ThreadSeq, replies[1].ThreadSeq})
+	assert.Equal(t, []int64{5, 6}, []int64{replies[0].Seq, replies[1].Seq})
+
+	counts, err := thread.CountReplies(ctx, "sg_g1", map[int64]int64{1: 1, 3: 0})
+	require.NoError(t, err)
+	assert.Equal(t, map[int64]int64{1: 2, 3: 2}, counts)
+}
@@ -0,0 +1,38 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+)
+
+// MsgPinModel 会话中置顶的消息
+type MsgPinModel struct {
+	ConversationID string    `bson:"conversation_id" json:"conversationID"`
+	Seq            int64     `bson:"seq"             json:"seq"`
+	PinUserID      string    `bson:"pin_user_id"     json:"pinUserID"`
+	PinTime        time.Time `bson:"pin_time"        json:"pinTime"`
+}
+
+type MsgPinModelInterface interface {
+	Create(ctx context.Context, pins []*MsgPinModel) (err error)
+	// Delete 返回是否删除了置顶
+	Delete(ctx context.Context, conversationID string, seq int64) (deleted bool, err error)
+	// FindByConversationID 按置顶时间倒序
+	FindByConversationID(ctx context.Context, conversationID string) (pins []*MsgPinModel, err error)
+	// FindByConversationIDs 多个会话的 FindByConversationID，没有置顶的会话不在结果中
+	FindByConversationIDs(ctx context.Context, conversationIDs []string) (pins map[string][]*MsgPinModel, err error)
+}
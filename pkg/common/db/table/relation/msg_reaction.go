@@ -0,0 +1,49 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+type MsgReactionModel struct {
+	ConversationID string    `bson:"conversation_id"`
+	Seq            int64     `bson:"seq"`
+	UserID         string    `bson:"user_id"`
+	Reaction       string    `bson:"reaction"`
+	CreateTime     time.Time `bson:"create_time"`
+}
+
+// MsgReactionCountModel 一条消息上每种回应的人数
+type MsgReactionCountModel struct {
+	Reaction string `bson:"_id"   json:"reaction"`
+	Count    int64  `bson:"count" json:"count"`
+}
+
+type MsgReactionModelInterface interface {
+	Create(ctx context.Context, reaction *MsgReactionModel) (err error)
+	// Delete 返回是否删除了回应
+	Delete(ctx context.Context, conversationID string, seq int64, userID, reaction string) (deleted bool, err error)
+	CountUserReactions(ctx context.Context, conversationID string, seq int64, userID string) (count int64, err error)
+	// CountReactions 按首次回应时间排序
+	CountReactions(ctx context.Context, conversationID string, seq int64) (counts []*MsgReactionCountModel, err error)
+	// CountReactionsBySeqs 多条消息的 CountReactions，没有回应的消息不在结果中
+	CountReactionsBySeqs(ctx context.Context, conversationID string, seqs []int64) (counts map[int64][]*MsgReactionCountModel, err error)
+	FindUserReactions(ctx context.Context, conversationID string, seqs []int64, userID string) (reactions []*MsgReactionModel, err error)
+	FindReactions(ctx context.Context, conversationID string, seq int64, reaction string, pagination pagination.Pagination) (total int64, reactions []*MsgReactionModel, err error)
+}
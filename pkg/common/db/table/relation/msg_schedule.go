@@ -0,0 +1,59 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+	"time"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+// 定时消息状态
+const (
+	MsgSchedulePending  int32 = 1
+	MsgScheduleSending  int32 = 2
+	MsgScheduleSent     int32 = 3
+	MsgScheduleCanceled int32 = 4
+	MsgScheduleFailed   int32 = 5
+)
+
+type MsgScheduleModel struct {
+	ScheduleID   string    `bson:"schedule_id"`
+	SendID       string    `bson:"send_id"`
+	Msg          []byte    `bson:"msg"` // proto 编码的 sdkws.MsgData
+	ScheduleTime time.Time `bson:"schedule_time"`
+	Status       int32     `bson:"status"`
+	Error        string    `bson:"error"`
+	ClaimTime    time.Time `bson:"claim_time"` // 抢占发送的时间
+	CreateTime   time.Time `bson:"create_time"`
+	UpdateTime   time.Time `bson:"update_time"`
+}
+
+type MsgScheduleModelInterface interface {
+	Create(ctx context.Context, schedules []*MsgScheduleModel) (err error)
+	Take(ctx context.Context, scheduleID string) (schedule *MsgScheduleModel, err error)
+	CountPending(ctx context.Context, sendID string) (count int64, err error)
+	// FindBySendID status 为 0 时查询全部状态
+	FindBySendID(ctx context.Context, sendID string, status int32, pagination pagination.Pagination) (total int64, schedules []*MsgScheduleModel, err error)
+	// FindDue 查询到期未发送的消息以及 staleBefore 之前抢占仍未完成发送的消息，按定时时间排序
+	FindDue(ctx context.Context, before time.Time, staleBefore time.Time, limit int64) (schedules []*MsgScheduleModel, err error)
+	// Claim 抢占已到期的待发送或 staleBefore 之前抢占仍未完成发送的消息，多实例以此抢占发送
+	Claim(ctx context.Context, scheduleID string, staleBefore time.Time) (claimed bool, err error)
+	// UpdateScheduleTime 只修改待发送的消息
+	UpdateScheduleTime(ctx context.Context, scheduleID string, scheduleTime time.Time) (updated bool, err error)
+	// UpdateStatus 状态为 from 时修改为 to
+	UpdateStatus(ctx context.Context, scheduleID string, from, to int32, errMsg string) (updated bool, err error)
+}
@@ -0,0 +1,64 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+
+	"github.com/OpenIMSDK/tools/pagination"
+)
+
+// MsgSearchModel 消息搜索索引，一条消息一个文档
+type MsgSearchModel struct {
+	ConversationID string   `bson:"conversation_id"`
+	Seq            int64    `bson:"seq"`
+	SendID         string   `bson:"send_id"`
+	SessionType    int32    `bson:"session_type"`
+	ContentType    int32    `bson:"content_type"`
+	SendTime       int64    `bson:"send_time"`
+	Text           string   `bson:"text"`
+	Tokens         []string `bson:"tokens"`
+	DelUserIDs     []string `bson:"del_user_ids,omitempty"` // 已删除该消息的用户
+}
+
+// MsgSearchFilter 空值的条件不过滤
+type MsgSearchFilter struct {
+	UserID          string
+	ConversationIDs []string
+	MinSeqs         map[string]int64 // 会话中用户可见的最小 seq，不在其中的会话不限制
+	SendIDs         []string
+	ContentTypes    []int32
+	StartTime       int64
+	EndTime         int64
+	Tokens          []string // 全部命中
+	Phrases         []string // 原文包含
+}
+
+type MsgSearchModelInterface interface {
+	// Create 已存在的消息忽略
+	Create(ctx context.Context, msgs []*MsgSearchModel) (err error)
+	// UpsertText 更新消息的类型和文本，消息还未入索引时写入
+	UpsertText(ctx context.Context, msg *MsgSearchModel) (err error)
+	Delete(ctx context.Context, conversationID string, seqs []int64) (err error)
+	// DeleteBySeq 删除 seq 及之前的消息
+	DeleteBySeq(ctx context.Context, conversationID string, seq int64) (err error)
+	// DeleteBySendTime 删除发送时间在 sendTime 之前的消息
+	DeleteBySendTime(ctx context.Context, conversationID string, sendTime int64) (err error)
+	AddDelUser(ctx context.Context, userID string, conversationID string, seqs []int64) (err error)
+	// AddDelUserBySeq 用户删除 seq 及之前的消息
+	AddDelUserBySeq(ctx context.Context, userID string, conversationID string, seq int64) (err error)
+	// Search 按发送时间倒序
+	Search(ctx context.Context, filter *MsgSearchFilter, pagination pagination.Pagination) (total int64, msgs []*MsgSearchModel, err error)
+}
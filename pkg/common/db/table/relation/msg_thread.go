@@ -0,0 +1,63 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package relation
+
+import (
+	"context"
+)
+
+// MsgThreadModel 话题汇总，以根消息 seq 标识，MaxThreadSeq 为已分配的最大话题内 seq
+type MsgThreadModel struct {
+	ConversationID  string `bson:"conversation_id" json:"conversationID"`
+	RootSeq         int64  `bson:"root_seq"        json:"rootSeq"`
+	ReplyCount      int64  `bson:"reply_count"     json:"replyCount"`
+	MaxThreadSeq    int64  `bson:"max_thread_seq"  json:"maxThreadSeq"`
+	LastReplySeq    int64  `bson:"last_reply_seq"  json:"lastReplySeq"`
+	LastReplyTime   int64  `bson:"last_reply_time" json:"lastReplyTime"`
+	LastReplyUserID string `bson:"last_reply_user_id" json:"lastReplyUserID"`
+}
+
+// MsgThreadReplyModel 话题回复，消息本身仍在会话中，Seq 为会话 seq，ThreadSeq 为话题内从 1 开始的 seq
+type MsgThreadReplyModel struct {
+	ConversationID string `bson:"conversation_id"`
+	RootSeq        int64  `bson:"root_seq"`
+	Seq            int64  `bson:"seq"`
+	ThreadSeq      int64  `bson:"thread_seq"`
+	SendID         string `bson:"send_id"`
+	SendTime       int64  `bson:"send_time"`
+}
+
+// MsgThreadReadModel 用户在话题中的已读位置，HasReadSeq 为话题内 seq
+type MsgThreadReadModel struct {
+	ConversationID string `bson:"conversation_id"`
+	RootSeq        int64  `bson:"root_seq"`
+	UserID         string `bson:"user_id"`
+	HasReadSeq     int64  `bson:"has_read_seq"`
+}
+
+type MsgThreadModelInterface interface {
+	// AddReply 为回复分配话题内 seq 并更新话题汇总，回复已存在时 added 为 false
+	AddReply(ctx context.Context, reply *MsgThreadReplyModel) (added bool, err error)
+	Take(ctx context.Context, conversationID string, rootSeq int64) (thread *MsgThreadModel, err error)
+	// Find 没有回复的根消息不在结果中
+	Find(ctx context.Context, conversationID string, rootSeqs []int64) (threads []*MsgThreadModel, err error)
+	// FindReplies 按话题内 seq 升序返回不小于 beginThreadSeq 的 num 条回复
+	FindReplies(ctx context.Context, conversationID string, rootSeq int64, beginThreadSeq int64, num int64) (replies []*MsgThreadReplyModel, err error)
+	// CountReplies 统计每个话题中话题内 seq 大于 afterThreadSeqs[rootSeq] 的回复数
+	CountReplies(ctx context.Context, conversationID string, afterThreadSeqs map[int64]int64) (counts map[int64]int64, err error)
+	FindReads(ctx context.Context, conversationID string, rootSeqs []int64, userID string) (reads []*MsgThreadReadModel, err error)
+	// SetRead 已读位置只增不减
+	SetRead(ctx context.Context, conversationID string, rootSeq int64, userID string, hasReadSeq int64) error
+}
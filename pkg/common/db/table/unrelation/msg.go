@@ -46,6 +46,15 @@ type RevokeModel struct {
 	Time     int64  `bson:"time"`
 }
 
+// MsgEditModel 消息的一个版本，modify 为最新修改的内容，edits 为每次修改前的版本
+type MsgEditModel struct {
+	UserID      string `bson:"user_id"`
+	ContentType int32  `bson:"content_type"`
+	Content     string `bson:"content"`
+	Ex          string `bson:"ex"`
+	Time        int64  `bson:"time"`
+}
+
 type OfflinePushModel struct {
 	Title         string `bson:"title"`
 	Desc          string `bson:"desc"`
@@ -77,13 +86,16 @@ type MsgDataModel struct {
 	AtUserIDList     []string          `bson:"at_user_id_list"`
 	AttachedInfo     string            `bson:"attached_info"`
 	Ex               string            `bson:"ex"`
+	ThreadRootSeq    int64             `bson:"thread_root_seq,omitempty"`
 }
 
 type MsgInfoModel struct {
-	Msg     *MsgDataModel `bson:"msg"`
-	Revoke  *RevokeModel  `bson:"revoke"`
-	DelList []string      `bson:"del_list"`
-	IsRead  bool          `bson:"is_read"`
+	Msg     *MsgDataModel   `bson:"msg"`
+	Revoke  *RevokeModel    `bson:"revoke"`
+	Modify  *MsgEditModel   `bson:"modify,omitempty"`
+	Edits   []*MsgEditModel `bson:"edits,omitempty"`
+	DelList []string        `bson:"del_list"`
+	IsRead  bool            `bson:"is_read"`
 }
 
 type UserCount struct {
@@ -308,6 +308,7 @@ func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 		if infoModel == nil || infoModel.Msg == nil {
 			continue
 		}
+		applyMsgModify(infoModel)
 		if infoModel.Revoke != nil {
 			revokeContent := sdkws.MessageRevokedContent{
 				RevokerID:                   infoModel.Revoke.UserID,
@@ -341,6 +342,16 @@ func (m *MsgMongoDriver) GetMsgBySeqIndexIn1Doc(
 	return msgs, nil
 }
 
+// applyMsgModify 使用修改后的内容覆盖消息
+func applyMsgModify(info *table.MsgInfoModel) {
+	if info.Modify == nil || info.Msg == nil {
+		return
+	}
+	info.Msg.ContentType = info.Modify.ContentType
+	info.Msg.Content = info.Modify.Content
+	info.Msg.Ex = info.Modify.Ex
+}
+
 func (m *MsgMongoDriver) IsExistDocID(ctx context.Context, docID string) (bool, error) {
 	count, err := m.MsgCollection.CountDocuments(ctx, bson.M{"doc_id": docID})
 	if err != nil {
@@ -1190,6 +1201,7 @@ func (m *MsgMongoDriver) searchMessage(ctx context.Context, req *msg.SearchMessa
 		if msgInfo == nil || msgInfo.Msg == nil {
 			continue
 		}
+		applyMsgModify(msgInfo)
 		if msgInfo.Revoke != nil {
 			revokeContent := sdkws.MessageRevokedContent{
 				RevokerID:                   msgInfo.Revoke.UserID,
@@ -1615,6 +1627,7 @@ func (m *MsgMongoDriver) GetMsgByConversationId(ctx context.Context, userID stri
 		if msgDoc.Msg == nil {
 			continue
 		}
+		applyMsgModify(msgDoc.Msg)
 		if msgDoc.Msg.Revoke != nil {
 			revokeContent := sdkws.MessageRevokedContent{
 				RevokerID:                   msgDoc.Msg.Revoke.UserID,
@@ -0,0 +1,39 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unrelation
+
+import (
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/stretchr/testify/assert"
+
+	table "github.com/openimsdk/open-im-server/v3/pkg/common/db/table/unrelation"
+)
+
+func TestApplyMsgModify(t *testing.T) {
+	info := &table.MsgInfoModel{Msg: &table.MsgDataModel{ContentType: constant.Text, Content: "old", Ex: "ex"}}
+	applyMsgModify(info)
+	assert.Equal(t, "old", info.Msg.Content)
+
+	// 转存的原消息晚于修改写入时，读取仍返回修改后的内容
+	info.Modify = &table.MsgEditModel{ContentType: constant.AtText, Content: "new", Ex: "ex2"}
+	applyMsgModify(info)
+	assert.Equal(t, int32(constant.AtText), info.Msg.ContentType)
+	assert.Equal(t, "new", info.Msg.Content)
+	assert.Equal(t, "ex2", info.Msg.Ex)
+
+	applyMsgModify(&table.MsgInfoModel{Modify: info.Modify})
+}
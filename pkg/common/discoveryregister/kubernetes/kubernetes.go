@@ -22,8 +22,10 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
 
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 	"github.com/OpenIMSDK/tools/log"
@@ -35,6 +37,9 @@ type K8sDR struct {
 	options               []grpc.DialOption
 	rpcRegisterAddr       string
 	gatewayHostConsistent *consistent.Consistent
+	// msggateway pods are dialed directly, keep one conn per pod instead of dialing on every call
+	gatewayConnsLock sync.Mutex
+	gatewayConns     map[string]*grpc.ClientConn
 }
 
 func NewK8sDiscoveryRegister() (discoveryregistry.SvcDiscoveryRegistry, error) {
@@ -43,7 +48,7 @@ func NewK8sDiscoveryRegister() (discoveryregistry.SvcDiscoveryRegistry, error) {
 	for _, v := range gatewayHosts {
 		gatewayConsistent.Add(v)
 	}
-	return &K8sDR{gatewayHostConsistent: gatewayConsistent}, nil
+	return &K8sDR{gatewayHostConsistent: gatewayConsistent, gatewayConns: make(map[string]*grpc.ClientConn)}, nil
 }
 
 func (cli *K8sDR) Register(serviceName, host string, port int, opts ...grpc.DialOption) error {
@@ -137,7 +142,7 @@ func (cli *K8sDR) GetConns(ctx context.Context, serviceName string, opts ...grpc
 		var ret []*grpc.ClientConn
 		gatewayHosts := getMsgGatewayHost(ctx)
 		for _, host := range gatewayHosts {
-			conn, err := grpc.DialContext(ctx, host, append(cli.options, opts...)...)
+			conn, err := cli.getGatewayConn(ctx, host, opts...)
 			if err != nil {
 				return nil, err
 			} else {
@@ -148,6 +153,20 @@ func (cli *K8sDR) GetConns(ctx context.Context, serviceName string, opts ...grpc
 	}
 }
 
+func (cli *K8sDR) getGatewayConn(ctx context.Context, host string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	cli.gatewayConnsLock.Lock()
+	defer cli.gatewayConnsLock.Unlock()
+	if conn, ok := cli.gatewayConns[host]; ok && conn.GetState() != connectivity.Shutdown {
+		return conn, nil
+	}
+	conn, err := grpc.DialContext(ctx, host, append(cli.options, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+	cli.gatewayConns[host] = conn
+	return conn, nil
+}
+
 func (cli *K8sDR) GetConn(ctx context.Context, serviceName string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 
 	return grpc.DialContext(ctx, serviceName, append(cli.options, opts...)...)
@@ -172,5 +191,10 @@ func (cli *K8sDR) GetClientLocalConns() map[string][]*grpc.ClientConn {
 	return nil
 }
 func (cli *K8sDR) Close() {
-	return
+	cli.gatewayConnsLock.Lock()
+	defer cli.gatewayConnsLock.Unlock()
+	for host, conn := range cli.gatewayConns {
+		_ = conn.Close()
+		delete(cli.gatewayConns, host)
+	}
 }
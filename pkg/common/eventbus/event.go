@@ -0,0 +1,149 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/protocol/sdkws"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/OpenIMSDK/tools/utils"
+)
+
+// event types
+const (
+	EventSensitiveHit = "sensitive.hit" // 敏感词命中，payload 为 live.HitSensitiveMessage
+	EventBrushTrigger = "brush.trigger" // 刷屏禁言，payload 为 live.TriggerUser
+	EventBrushBlock   = "brush.block"   // 刷屏禁言拉黑，payload 为 live.TriggerUser
+	EventMsgIndex     = "msg.index"     // 消息入es，payload 为 MsgIndexPayload
+	EventMsgRevoke    = "msg.revoke"    // 撤回消息入es，payload 为 MsgRevokePayload
+)
+
+// eventVersions payload 的当前版本，payload 不兼容变更时递增
+var eventVersions = map[string]int{
+	EventSensitiveHit: 1,
+	EventBrushTrigger: 1,
+	EventBrushBlock:   1,
+	EventMsgIndex:     1,
+	EventMsgRevoke:    1,
+}
+
+// defaultTopics 未配置 topic 时使用原来的 redis list key
+var defaultTopics = map[string]string{
+	EventSensitiveHit: "sensitive_hit_word_mq",
+	EventBrushTrigger: "im_brush_user_trigger_push_key",
+	EventBrushBlock:   "im_brush_user_block_push_key",
+	EventMsgIndex:     "live_admin:es:msg",
+	EventMsgRevoke:    "openIm:revoke:list",
+}
+
+// Event is the envelope every payload is published in.
+type Event struct {
+	ID          string          `json:"id"`
+	Type        string          `json:"type"`
+	Version     int             `json:"version"`
+	Time        int64           `json:"time"` // milliseconds
+	OperationID string          `json:"operationID,omitempty"`
+	Payload     json.RawMessage `json:"payload"`
+}
+
+type (
+	MsgIndexPayload struct {
+		ConversationID string           `json:"conversationId"`
+		Msgs           []*sdkws.MsgData `json:"msgs"`
+	}
+
+	MsgRevokePayload struct {
+		ID string `json:"id"` // ServerMsgID + seq
+	}
+)
+
+// legacyPayload is implemented by payloads whose bare list encoding was not json.
+type legacyPayload interface {
+	encodeLegacy() []byte
+	decodeLegacy(data []byte) error
+}
+
+func (p *MsgRevokePayload) encodeLegacy() []byte {
+	return []byte(p.ID)
+}
+
+func (p *MsgRevokePayload) decodeLegacy(data []byte) error {
+	p.ID = string(data)
+	return nil
+}
+
+func NewEvent(ctx context.Context, eventType string, payload any) (*Event, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, utils.Wrap(err, "marshal event payload")
+	}
+	return &Event{
+		ID:          utils.OperationIDGenerator(),
+		Type:        eventType,
+		Version:     eventVersions[eventType],
+		Time:        time.Now().UnixMilli(),
+		OperationID: mcontext.GetOperationID(ctx),
+		Payload:     data,
+	}, nil
+}
+
+// Decode unmarshals the payload into v. Events read from a list written with legacyPayload
+// have version 0 and the bare payload.
+func (e *Event) Decode(v any) error {
+	if e.Version == 0 {
+		if p, ok := v.(legacyPayload); ok {
+			return p.decodeLegacy(e.Payload)
+		}
+	}
+	return utils.Wrap(json.Unmarshal(e.Payload, v), "unmarshal event payload")
+}
+
+func encodeEvent(event *Event) ([]byte, error) {
+	data, err := json.Marshal(event)
+	return data, utils.Wrap(err, "marshal event")
+}
+
+// encodeLegacy 原来推送至 redis list 的格式
+func encodeLegacy(payload any) ([]byte, error) {
+	if p, ok := payload.(legacyPayload); ok {
+		return p.encodeLegacy(), nil
+	}
+	data, err := json.Marshal(payload)
+	return data, utils.Wrap(err, "marshal event payload")
+}
+
+// decodeEvent 非 envelope 的数据视为旧格式的 payload
+func decodeEvent(eventType string, data []byte) *Event {
+	var event Event
+	if err := json.Unmarshal(data, &event); err == nil && event.Type != "" && event.Payload != nil {
+		return &event
+	}
+	return &Event{Type: eventType, Payload: data}
+}
+
+// eventContext 使用事件的 operationID 作为消费的 ctx
+func eventContext(ctx context.Context, event *Event) context.Context {
+	operationID := event.OperationID
+	if operationID == "" {
+		operationID = event.ID
+	}
+	if operationID == "" {
+		operationID = utils.OperationIDGenerator()
+	}
+	return mcontext.SetOperationID(ctx, operationID)
+}
@@ -0,0 +1,70 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"testing"
+
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventRoundTrip(t *testing.T) {
+	ctx := mcontext.SetOperationID(mcontext.NewCtx("op"), "op1")
+	event, err := NewEvent(ctx, EventMsgRevoke, &MsgRevokePayload{ID: "msg1"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, event.Version)
+	assert.Equal(t, "op1", event.OperationID)
+	data, err := encodeEvent(event)
+	assert.NoError(t, err)
+
+	decoded := decodeEvent(EventMsgRevoke, data)
+	assert.Equal(t, event.ID, decoded.ID)
+	var payload MsgRevokePayload
+	assert.NoError(t, decoded.Decode(&payload))
+	assert.Equal(t, "msg1", payload.ID)
+}
+
+func TestLegacyPayload(t *testing.T) {
+	data, err := encodeLegacy(&MsgRevokePayload{ID: "msg1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "msg1", string(data))
+	event := decodeEvent(EventMsgRevoke, data)
+	assert.Equal(t, 0, event.Version)
+	var revoke MsgRevokePayload
+	assert.NoError(t, event.Decode(&revoke))
+	assert.Equal(t, "msg1", revoke.ID)
+
+	data, err = encodeLegacy(&MsgIndexPayload{ConversationID: "si_a_b"})
+	assert.NoError(t, err)
+	event = decodeEvent(EventMsgIndex, data)
+	assert.Equal(t, EventMsgIndex, event.Type)
+	var index MsgIndexPayload
+	assert.NoError(t, event.Decode(&index))
+	assert.Equal(t, "si_a_b", index.ConversationID)
+}
+
+func TestTopics(t *testing.T) {
+	topics := newTopics(map[string]string{EventMsgIndex: "msg_index", EventBrushBlock: ""})
+	topic, err := topics.get(EventMsgIndex)
+	assert.NoError(t, err)
+	assert.Equal(t, "msg_index", topic)
+	topic, err = topics.get(EventBrushBlock)
+	assert.NoError(t, err)
+	assert.Equal(t, "im_brush_user_block_push_key", topic)
+	_, err = topics.get("unknown")
+	assert.Error(t, err)
+	assert.Equal(t, "live_admin.es.msg", kafkaTopic(defaultTopics[EventMsgIndex]))
+}
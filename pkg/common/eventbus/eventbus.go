@@ -0,0 +1,100 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventbus publishes the moderation and search side channel events to a redis list, a
+// redis stream or kafka, selected by config, and consumes them with the same drivers.
+package eventbus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+const (
+	DriverRedisList   = "redisList"
+	DriverRedisStream = "redisStream"
+	DriverKafka       = "kafka"
+)
+
+type Publisher interface {
+	// Publish 封装为 Event 后发布，key 相同的事件在 kafka 中保证顺序，可为空
+	Publish(ctx context.Context, eventType, key string, payload any) error
+	Close() error
+}
+
+// Handler returning an error leaves the event pending for a retry on redisStream, other drivers log it.
+type Handler func(ctx context.Context, event *Event) error
+
+type Subscriber interface {
+	// Subscribe 阻塞消费 eventType 的事件直到 ctx 结束，group 为消费组，redisList 不区分消费组
+	Subscribe(ctx context.Context, eventType, group string, handler Handler) error
+	Close() error
+}
+
+// NewPublisher creates the publisher of config.Config.EventBus.
+func NewPublisher(rdb redis.UniversalClient) (Publisher, error) {
+	conf := config.Config.EventBus
+	switch conf.Driver {
+	case "", DriverRedisList:
+		return &redisListBus{rdb: rdb, topics: newTopics(conf.Topics), maxLen: conf.MaxLen, legacy: conf.LegacyPayload}, nil
+	case DriverRedisStream:
+		return &redisStreamBus{rdb: rdb, topics: newTopics(conf.Topics), maxLen: conf.MaxLen}, nil
+	case DriverKafka:
+		return newKafkaPublisher(newTopics(conf.Topics)), nil
+	default:
+		return nil, fmt.Errorf("unknown event bus driver %q", conf.Driver)
+	}
+}
+
+// NewSubscriber creates the subscriber of config.Config.EventBus.
+func NewSubscriber(rdb redis.UniversalClient) (Subscriber, error) {
+	conf := config.Config.EventBus
+	switch conf.Driver {
+	case "", DriverRedisList:
+		return &redisListBus{rdb: rdb, topics: newTopics(conf.Topics)}, nil
+	case DriverRedisStream:
+		return &redisStreamBus{rdb: rdb, topics: newTopics(conf.Topics), maxLen: conf.MaxLen, maxDeliveries: conf.MaxDeliveries}, nil
+	case DriverKafka:
+		return &kafkaSubscriber{topics: newTopics(conf.Topics)}, nil
+	default:
+		return nil, fmt.Errorf("unknown event bus driver %q", conf.Driver)
+	}
+}
+
+type topics map[string]string
+
+func newTopics(conf map[string]string) topics {
+	t := make(topics, len(defaultTopics))
+	for eventType, topic := range defaultTopics {
+		t[eventType] = topic
+	}
+	for eventType, topic := range conf {
+		if topic != "" {
+			t[eventType] = topic
+		}
+	}
+	return t
+}
+
+func (t topics) get(eventType string) (string, error) {
+	topic, ok := t[eventType]
+	if !ok {
+		return "", fmt.Errorf("no topic for event type %q", eventType)
+	}
+	return topic, nil
+}
@@ -0,0 +1,126 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/OpenIMSDK/tools/log"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/kafka"
+)
+
+// kafkaTopic kafka topic 不允许 ":"
+func kafkaTopic(topic string) string {
+	return strings.ReplaceAll(topic, ":", ".")
+}
+
+type kafkaPublisher struct {
+	topics    topics
+	producers map[string]*kafka.Producer
+}
+
+func newKafkaPublisher(t topics) *kafkaPublisher {
+	p := &kafkaPublisher{topics: t, producers: make(map[string]*kafka.Producer, len(t))}
+	for eventType, topic := range t {
+		p.producers[eventType] = kafka.NewKafkaProducer(config.Config.Kafka.Addr, kafkaTopic(topic))
+	}
+	return p
+}
+
+func (p *kafkaPublisher) Publish(ctx context.Context, eventType, key string, payload any) error {
+	if _, err := p.topics.get(eventType); err != nil {
+		return err
+	}
+	event, err := NewEvent(ctx, eventType, payload)
+	if err != nil {
+		return err
+	}
+	data, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	if key == "" {
+		key = event.ID
+	}
+	_, _, err = p.producers[eventType].SendBytes(ctx, key, data)
+	return err
+}
+
+func (p *kafkaPublisher) Close() error {
+	var closeErr error
+	for _, producer := range p.producers {
+		if err := producer.Close(); err != nil && closeErr == nil {
+			closeErr = err
+		}
+	}
+	return closeErr
+}
+
+// kafkaSubscriber 消费组提交 offset，处理失败的事件只记录日志.
+type kafkaSubscriber struct {
+	topics topics
+}
+
+func (s *kafkaSubscriber) Subscribe(ctx context.Context, eventType, group string, handler Handler) error {
+	topic, err := s.topics.get(eventType)
+	if err != nil {
+		return err
+	}
+	topic = kafkaTopic(topic)
+	consumerGroup := kafka.NewMConsumerGroup(&kafka.MConsumerGroupConfig{
+		KafkaVersion:   sarama.V2_0_0_0,
+		OffsetsInitial: sarama.OffsetNewest,
+		IsReturnErr:    false,
+	}, []string{topic}, config.Config.Kafka.Addr, group)
+	defer consumerGroup.Close()
+	h := &kafkaHandler{eventType: eventType, handler: handler}
+	for ctx.Err() == nil {
+		if err := consumerGroup.Consume(ctx, []string{topic}, h); err != nil && ctx.Err() == nil {
+			log.ZWarn(ctx, "event bus kafka consume failed", err, "topic", topic, "group", group)
+			time.Sleep(time.Second)
+		}
+	}
+	return nil
+}
+
+func (s *kafkaSubscriber) Close() error {
+	return nil
+}
+
+type kafkaHandler struct {
+	eventType string
+	handler   Handler
+}
+
+func (h *kafkaHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *kafkaHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *kafkaHandler) ConsumeClaim(sess sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		event := decodeEvent(h.eventType, msg.Value)
+		ctx := eventContext(sess.Context(), event)
+		if err := h.handler(ctx, event); err != nil {
+			log.ZWarn(ctx, "event bus handle failed", err, "topic", msg.Topic, "offset", msg.Offset)
+		}
+		sess.MarkMessage(msg, "")
+	}
+	return nil
+}
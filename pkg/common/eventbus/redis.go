@@ -0,0 +1,276 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisBlockTimeout = 5 * time.Second
+	streamReadCount   = 100
+	streamEventField  = "event"
+	// defaultStreamMaxDeliveries 未配置 maxDeliveries 时的最大投递次数
+	defaultStreamMaxDeliveries = 10
+	streamDeadSuffix           = ":dead"
+)
+
+// streamClaimIdle 超过该时间未确认的事件重新投递给当前消费者
+var streamClaimIdle = time.Minute
+
+// redisListBus LPush 发布，BRPop 消费，没有消费组和确认，失败的事件只记录日志.
+type redisListBus struct {
+	rdb    redis.UniversalClient
+	topics topics
+	maxLen int64
+	legacy bool
+}
+
+func (b *redisListBus) Publish(ctx context.Context, eventType, key string, payload any) error {
+	topic, err := b.topics.get(eventType)
+	if err != nil {
+		return err
+	}
+	var data []byte
+	if b.legacy {
+		data, err = encodeLegacy(payload)
+	} else {
+		var event *Event
+		if event, err = NewEvent(ctx, eventType, payload); err == nil {
+			data, err = encodeEvent(event)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	pipe := b.rdb.Pipeline()
+	pipe.LPush(ctx, topic, data)
+	if b.maxLen > 0 {
+		pipe.LTrim(ctx, topic, 0, b.maxLen-1)
+	}
+	_, err = pipe.Exec(ctx)
+	return utils.Wrap(err, "")
+}
+
+func (b *redisListBus) Subscribe(ctx context.Context, eventType, _ string, handler Handler) error {
+	topic, err := b.topics.get(eventType)
+	if err != nil {
+		return err
+	}
+	for ctx.Err() == nil {
+		res, err := b.rdb.BRPop(ctx, redisBlockTimeout, topic).Result()
+		if err != nil {
+			if err == redis.Nil || ctx.Err() != nil {
+				continue
+			}
+			log.ZWarn(ctx, "event bus BRPop failed", err, "topic", topic)
+			time.Sleep(time.Second)
+			continue
+		}
+		event := decodeEvent(eventType, []byte(res[1]))
+		if err := handler(eventContext(ctx, event), event); err != nil {
+			log.ZWarn(ctx, "event bus handle failed", err, "topic", topic, "event", res[1])
+		}
+	}
+	return nil
+}
+
+func (b *redisListBus) Close() error {
+	return nil
+}
+
+// redisStreamBus XAdd 发布，消费组 XReadGroup 消费，处理成功后 XAck，未确认的事件超时后重新投递.
+type redisStreamBus struct {
+	rdb           redis.UniversalClient
+	topics        topics
+	maxLen        int64
+	maxDeliveries int64
+}
+
+func (b *redisStreamBus) Publish(ctx context.Context, eventType, key string, payload any) error {
+	topic, err := b.topics.get(eventType)
+	if err != nil {
+		return err
+	}
+	event, err := NewEvent(ctx, eventType, payload)
+	if err != nil {
+		return err
+	}
+	data, err := encodeEvent(event)
+	if err != nil {
+		return err
+	}
+	return utils.Wrap(b.rdb.XAdd(ctx, &redis.XAddArgs{
+		Stream: topic,
+		MaxLen: b.maxLen,
+		Approx: b.maxLen > 0,
+		Values: map[string]any{streamEventField: data},
+	}).Err(), "")
+}
+
+func (b *redisStreamBus) Subscribe(ctx context.Context, eventType, group string, handler Handler) error {
+	topic, err := b.topics.get(eventType)
+	if err != nil {
+		return err
+	}
+	if err := b.rdb.XGroupCreateMkStream(ctx, topic, group, "0").Err(); err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return utils.Wrap(err, "")
+	}
+	consumer := streamConsumerName()
+	// 先处理上次退出时未确认的事件
+	start := "0"
+	for ctx.Err() == nil {
+		// 读取 pending 事件不阻塞
+		streams, err := b.readGroup(ctx, topic, group, consumer, start, -1)
+		if err != nil {
+			return err
+		}
+		if len(streams) == 0 || len(streams[0].Messages) == 0 {
+			break
+		}
+		messages := streams[0].Messages
+		b.handle(ctx, topic, group, eventType, messages, handler)
+		start = messages[len(messages)-1].ID
+	}
+	for ctx.Err() == nil {
+		streams, err := b.readGroup(ctx, topic, group, consumer, ">", redisBlockTimeout)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.ZWarn(ctx, "event bus XReadGroup failed", err, "topic", topic, "group", group)
+				time.Sleep(time.Second)
+			}
+			continue
+		}
+		if len(streams) == 0 {
+			// 空闲时先移走多次投递仍失败的事件，再接管超时未确认的事件
+			if err := b.deadLetter(ctx, topic, group); err != nil {
+				log.ZWarn(ctx, "event bus dead letter failed", err, "topic", topic, "group", group)
+			}
+			messages, _, err := b.rdb.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+				Stream:   topic,
+				Group:    group,
+				Consumer: consumer,
+				MinIdle:  streamClaimIdle,
+				Start:    "0",
+				Count:    streamReadCount,
+			}).Result()
+			if err != nil {
+				log.ZWarn(ctx, "event bus XAutoClaim failed", err, "topic", topic, "group", group)
+				continue
+			}
+			b.handle(ctx, topic, group, eventType, messages, handler)
+			continue
+		}
+		b.handle(ctx, topic, group, eventType, streams[0].Messages, handler)
+	}
+	return nil
+}
+
+func (b *redisStreamBus) readGroup(ctx context.Context, topic, group, consumer, start string, block time.Duration) ([]redis.XStream, error) {
+	streams, err := b.rdb.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    group,
+		Consumer: consumer,
+		Streams:  []string{topic, start},
+		Count:    streamReadCount,
+		Block:    block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, nil
+	}
+	return streams, utils.Wrap(err, "")
+}
+
+func (b *redisStreamBus) handle(ctx context.Context, topic, group, eventType string, messages []redis.XMessage, handler Handler) {
+	for _, message := range messages {
+		data, _ := message.Values[streamEventField].(string)
+		event := decodeEvent(eventType, []byte(data))
+		if err := handler(eventContext(ctx, event), event); err != nil {
+			log.ZWarn(ctx, "event bus handle failed", err, "topic", topic, "group", group, "id", message.ID)
+			continue
+		}
+		if err := b.rdb.XAck(ctx, topic, group, message.ID).Err(); err != nil {
+			log.ZWarn(ctx, "event bus XAck failed", err, "topic", topic, "group", group, "id", message.ID)
+		}
+	}
+}
+
+// deadLetter 将投递次数达到上限且超时未确认的事件写入 topic:dead 并确认
+func (b *redisStreamBus) deadLetter(ctx context.Context, topic, group string) error {
+	maxDeliveries := b.maxDeliveries
+	if maxDeliveries <= 0 {
+		maxDeliveries = defaultStreamMaxDeliveries
+	}
+	pending, err := b.rdb.XPendingExt(ctx, &redis.XPendingExtArgs{
+		Stream: topic,
+		Group:  group,
+		Idle:   streamClaimIdle,
+		Start:  "-",
+		End:    "+",
+		Count:  streamReadCount,
+	}).Result()
+	if err != nil {
+		return utils.Wrap(err, "")
+	}
+	for _, p := range pending {
+		if p.RetryCount < maxDeliveries {
+			continue
+		}
+		messages, err := b.rdb.XRangeN(ctx, topic, p.ID, p.ID, 1).Result()
+		if err != nil {
+			return utils.Wrap(err, "")
+		}
+		// 已被 maxLen 裁剪的事件直接确认
+		for _, message := range messages {
+			values := map[string]any{"id": message.ID, "group": group, "deliveries": p.RetryCount}
+			for k, v := range message.Values {
+				values[k] = v
+			}
+			if err := b.rdb.XAdd(ctx, &redis.XAddArgs{
+				Stream: topic + streamDeadSuffix,
+				MaxLen: b.maxLen,
+				Approx: b.maxLen > 0,
+				Values: values,
+			}).Err(); err != nil {
+				return utils.Wrap(err, "")
+			}
+		}
+		if err := b.rdb.XAck(ctx, topic, group, p.ID).Err(); err != nil {
+			return utils.Wrap(err, "")
+		}
+		log.ZWarn(ctx, "event bus event moved to dead letter", nil, "topic", topic, "group", group, "id", p.ID, "deliveries", p.RetryCount)
+	}
+	return nil
+}
+
+func (b *redisStreamBus) Close() error {
+	return nil
+}
+
+// streamConsumerName 重启后使用相同的名字，以便继续处理未确认的事件
+func streamConsumerName() string {
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		return "unknown"
+	}
+	return hostname
+}
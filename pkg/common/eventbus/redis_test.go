@@ -0,0 +1,84 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbus
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEventTestRedis connects to a spare db of the local redis, the test is skipped without one.
+func newEventTestRedis(t *testing.T, keys ...string) redis.UniversalClient {
+	rdb := redis.NewClient(&redis.Options{DB: 15, DialTimeout: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		t.Skip("redis is not available:", err)
+	}
+	rdb.Del(ctx, keys...)
+	t.Cleanup(func() {
+		rdb.Del(context.Background(), keys...)
+		rdb.Close()
+	})
+	return rdb
+}
+
+func TestStreamDeadLetter(t *testing.T) {
+	const topic, group = "eventbus_test_stream", "test"
+	rdb := newEventTestRedis(t, topic, topic+streamDeadSuffix)
+	idle := streamClaimIdle
+	streamClaimIdle = 10 * time.Millisecond
+	defer func() { streamClaimIdle = idle }()
+
+	b := &redisStreamBus{rdb: rdb, topics: newTopics(map[string]string{EventMsgRevoke: topic}), maxDeliveries: 2}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, b.Publish(ctx, EventMsgRevoke, "", &MsgRevokePayload{ID: "msg1"}))
+
+	var deliveries int
+	done := make(chan error, 1)
+	go func() {
+		done <- b.Subscribe(ctx, EventMsgRevoke, group, func(ctx context.Context, event *Event) error {
+			deliveries++
+			return errors.New("handle failed")
+		})
+	}()
+	// 阻塞读取超时后依次接管、移入死信
+	assert.Eventually(t, func() bool {
+		return rdb.XLen(context.Background(), topic+streamDeadSuffix).Val() == 1
+	}, 3*redisBlockTimeout+time.Second, 100*time.Millisecond)
+	cancel()
+	require.NoError(t, <-done)
+
+	assert.Equal(t, 2, deliveries)
+	pending, err := rdb.XPending(context.Background(), topic, group).Result()
+	require.NoError(t, err)
+	assert.Zero(t, pending.Count)
+	dead, err := rdb.XRange(context.Background(), topic+streamDeadSuffix, "-", "+").Result()
+	require.NoError(t, err)
+	require.Len(t, dead, 1)
+	assert.Equal(t, group, dead[0].Values["group"])
+	event := decodeEvent(EventMsgRevoke, []byte(dead[0].Values[streamEventField].(string)))
+	var payload MsgRevokePayload
+	require.NoError(t, event.Decode(&payload))
+	assert.Equal(t, "msg1", payload.ID)
+}
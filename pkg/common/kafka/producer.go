@@ -157,6 +157,11 @@ func (p *Producer) SendMessage(ctx context.Context, key string, msg proto.Messag
 		return 0, 0, utils.Wrap(errEmptyMsg, "")
 	}
 
+	return p.SendBytes(ctx, key, bMsg)
+}
+
+// SendBytes sends an already encoded message to the Kafka topic configured in the Producer.
+func (p *Producer) SendBytes(ctx context.Context, key string, bMsg []byte) (int32, int64, error) {
 	// Prepare Kafka message
 	kMsg := &sarama.ProducerMessage{
 		Topic: p.topic,
@@ -186,3 +191,8 @@ func (p *Producer) SendMessage(ctx context.Context, key string, msg proto.Messag
 	log.ZDebug(ctx, "ByteEncoder SendMessage end", "key", kMsg.Key, "key length", kMsg.Value.Length())
 	return partition, offset, nil
 }
+
+// Close closes the underlying sync producer.
+func (p *Producer) Close() error {
+	return utils.Wrap(p.producer.Close(), "")
+}
@@ -0,0 +1,176 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package msgsearch 消息全文搜索的文本提取和分词，中日韩文字按单字和双字切分，其他按单词切分
+package msgsearch
+
+import (
+	"encoding/json"
+	"strings"
+	"unicode"
+
+	"github.com/OpenIMSDK/protocol/constant"
+)
+
+const (
+	maxTextLen = 4096 // 超出部分不索引
+	maxTokens  = 512
+)
+
+// Indexable 用户消息入索引，通知和不落库的消息类型不入索引
+func Indexable(contentType int32) bool {
+	switch contentType {
+	case constant.Revoke, constant.Typing, constant.CustomOnlineOnly,
+		constant.ReactionMessageModifier, constant.ReactionMessageDeleter:
+		return false
+	}
+	return contentType > constant.ContentTypeBegin && contentType < constant.NotificationBegin
+}
+
+// ExtractText 提取可搜索的文本，不支持的消息类型返回空字符串
+func ExtractText(contentType int32, content []byte) string {
+	var elem struct {
+		Content     string `json:"content"`
+		Text        string `json:"text"`
+		FileName    string `json:"fileName"`
+		Title       string `json:"title"`
+		Description string `json:"description"`
+	}
+	var text string
+	switch contentType {
+	case constant.Text:
+		_ = json.Unmarshal(content, &elem)
+		text = elem.Content
+	case constant.AtText, constant.Quote, constant.AdvancedText:
+		_ = json.Unmarshal(content, &elem)
+		text = elem.Text
+	case constant.File:
+		_ = json.Unmarshal(content, &elem)
+		text = elem.FileName
+	case constant.Merger:
+		_ = json.Unmarshal(content, &elem)
+		text = elem.Title
+	case constant.Location:
+		_ = json.Unmarshal(content, &elem)
+		text = elem.Description
+	}
+	if len(text) > maxTextLen {
+		text = strings.ToValidUTF8(text[:maxTextLen], "")
+	}
+	return text
+}
+
+func isCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) || unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) || unicode.Is(unicode.Hangul, r)
+}
+
+// segments 按字母数字切分，中日韩文字连续的部分单独成段
+func segments(text string) (words []string, cjk [][]rune) {
+	var (
+		word []rune
+		run  []rune
+	)
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+		if len(run) > 0 {
+			cjk = append(cjk, run)
+			run = nil
+		}
+	}
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case isCJK(r):
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = nil
+			}
+			run = append(run, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if len(run) > 0 {
+				cjk = append(cjk, run)
+				run = nil
+			}
+			word = append(word, r)
+		default:
+			flush()
+		}
+	}
+	flush()
+	return words, cjk
+}
+
+// Tokenize 索引用的分词，中日韩文字同时生成单字和双字，以支持单字查询
+func Tokenize(text string) []string {
+	words, cjk := segments(text)
+	tokens := make([]string, 0, len(words))
+	tokens = append(tokens, words...)
+	for _, run := range cjk {
+		for i := range run {
+			tokens = append(tokens, string(run[i]))
+			if i+1 < len(run) {
+				tokens = append(tokens, string(run[i:i+2]))
+			}
+		}
+	}
+	return distinct(tokens)
+}
+
+// QueryTokens 查询用的分词，中日韩文字只在单字时使用单字，其他使用双字
+func QueryTokens(keyword string) []string {
+	words, cjk := segments(keyword)
+	tokens := make([]string, 0, len(words))
+	tokens = append(tokens, words...)
+	for _, run := range cjk {
+		if len(run) == 1 {
+			tokens = append(tokens, string(run))
+			continue
+		}
+		for i := 0; i+1 < len(run); i++ {
+			tokens = append(tokens, string(run[i:i+2]))
+		}
+	}
+	return distinct(tokens)
+}
+
+// QueryPhrases 三个字以上的中日韩文字段，双字可能不连续出现，需要按原文匹配
+func QueryPhrases(keyword string) []string {
+	_, cjk := segments(keyword)
+	var phrases []string
+	for _, run := range cjk {
+		if len(run) > 2 {
+			phrases = append(phrases, string(run))
+		}
+	}
+	return phrases
+}
+
+func distinct(tokens []string) []string {
+	seen := make(map[string]struct{}, len(tokens))
+	res := tokens[:0]
+	for _, token := range tokens {
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		res = append(res, token)
+		if len(res) >= maxTokens {
+			break
+		}
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package msgsearch
+
+import (
+	"testing"
+
+	"github.com/OpenIMSDK/protocol/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"hello", "openim", "v3", "你", "你好", "好"}, Tokenize("Hello, OpenIM v3 你好!"))
+	assert.Equal(t, []string{"go", "语", "语言", "言"}, Tokenize("go语言 go"))
+}
+
+func TestQueryTokens(t *testing.T) {
+	assert.Equal(t, []string{"今天", "天天", "天气"}, QueryTokens("今天天气"))
+	assert.Equal(t, []string{"天"}, QueryTokens("天"))
+	assert.Equal(t, []string{"meeting", "会议"}, QueryTokens("Meeting 会议"))
+	assert.Empty(t, QueryTokens(" ,.!"))
+	// 查询的分词都包含在索引的分词中
+	assert.Subset(t, Tokenize("明天下午三点开会议"), QueryTokens("开会议"))
+	assert.Equal(t, []string{"开会议"}, QueryPhrases("开会议 go 会议"))
+}
+
+func TestExtractText(t *testing.T) {
+	assert.Equal(t, "hi", ExtractText(constant.Text, []byte(`{"content":"hi"}`)))
+	assert.Equal(t, "@a hi", ExtractText(constant.AtText, []byte(`{"text":"@a hi"}`)))
+	assert.Equal(t, "report.pdf", ExtractText(constant.File, []byte(`{"fileName":"report.pdf"}`)))
+	assert.Empty(t, ExtractText(constant.Picture, []byte(`{"sourcePicture":{}}`)))
+}
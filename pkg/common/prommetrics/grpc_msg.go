@@ -35,4 +35,19 @@ var (
 		Name: "group_chat_msg_process_failed_total",
 		Help: "The number of group chat msg failed processed",
 	})
+	SensitiveWordGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sensitive_word_num",
+		Help: "The number of sensitive words in the loaded word list by its version",
+	}, []string{"version"})
+	SensitiveFilterDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "sensitive_filter_duration_seconds",
+		Help:    "The time spent filtering sensitive words of a message",
+		Buckets: prometheus.ExponentialBuckets(0.00001, 4, 8),
+	})
 )
+
+// SetSensitiveWordNum records the version and size of the sensitive word list loaded.
+func SetSensitiveWordNum(version string, words int) {
+	SensitiveWordGauge.Reset()
+	SensitiveWordGauge.WithLabelValues(version).Set(float64(words))
+}
@@ -23,4 +23,36 @@ var (
 		Name: "online_user_num",
 		Help: "The number of online user num",
 	})
+	SendQueueDepthGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "send_queue_depth",
+		Help: "The number of frames waiting in the send queues of this node",
+	})
+	SendQueueDroppedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "send_queue_dropped_total",
+		Help: "The number of frames refused by full send queues",
+	})
+	SlowConsumerEvictedCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "slow_consumer_evicted_total",
+		Help: "The number of connections closed for not draining their send queue",
+	})
+	PushAckTimeoutCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "push_ack_timeout_total",
+		Help: "The number of pushed messages not acked in time and pushed offline",
+	})
+	PushDroppedOfflineCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "push_dropped_offline_total",
+		Help: "The number of pushed messages discarded from a send queue and pushed offline",
+	})
+	WsRequestRateLimitedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ws_request_rate_limited_total",
+		Help: "The number of websocket requests rejected by the rate limiter",
+	}, []string{"scope"})
+	SdkVersionConnGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sdk_version_conn_num",
+		Help: "The number of connections of this node by platform and sdk version",
+	}, []string{"platform", "version"})
+	SdkVersionRejectedCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "sdk_version_rejected_total",
+		Help: "The number of handshakes rejected for a sdk version below the minimum",
+	}, []string{"platform"})
 )
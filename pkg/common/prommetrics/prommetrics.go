@@ -36,15 +36,17 @@ func NewGrpcPromObj(cusMetrics []prometheus.Collector) (*prometheus.Registry, *g
 func GetGrpcCusMetrics(registerName string) []prometheus.Collector {
 	switch registerName {
 	case config2.Config.RpcRegisterName.OpenImMessageGatewayName:
-		return []prometheus.Collector{OnlineUserGauge}
+		return []prometheus.Collector{OnlineUserGauge, SendQueueDepthGauge, SendQueueDroppedCounter, SlowConsumerEvictedCounter, WsRequestRateLimitedCounter, PushAckTimeoutCounter, PushDroppedOfflineCounter, SdkVersionConnGauge, SdkVersionRejectedCounter}
 	case config2.Config.RpcRegisterName.OpenImMsgName:
-		return []prometheus.Collector{SingleChatMsgProcessSuccessCounter, SingleChatMsgProcessFailedCounter, GroupChatMsgProcessSuccessCounter, GroupChatMsgProcessFailedCounter}
+		return []prometheus.Collector{SingleChatMsgProcessSuccessCounter, SingleChatMsgProcessFailedCounter, GroupChatMsgProcessSuccessCounter, GroupChatMsgProcessFailedCounter, SensitiveWordGauge, SensitiveFilterDuration}
 	case "Transfer":
 		return []prometheus.Collector{MsgInsertRedisSuccessCounter, MsgInsertRedisFailedCounter, MsgInsertMongoSuccessCounter, MsgInsertMongoFailedCounter, SeqSetFailedCounter}
 	case config2.Config.RpcRegisterName.OpenImPushName:
 		return []prometheus.Collector{MsgOfflinePushFailedCounter}
 	case config2.Config.RpcRegisterName.OpenImAuthName:
 		return []prometheus.Collector{UserLoginCounter}
+	case config2.Config.RpcRegisterName.OpenImUserName, config2.Config.RpcRegisterName.OpenImGroupName, config2.Config.RpcRegisterName.OpenImFriendName:
+		return []prometheus.Collector{SensitiveWordGauge}
 	default:
 		return nil
 	}
@@ -0,0 +1,134 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tls
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+)
+
+// defaultReloadInterval is how often the certificate files are checked for changes.
+const defaultReloadInterval = time.Minute
+
+// CertReloader serves a server certificate loaded from files and reloads it when the files change.
+// Handshakes after a reload use the new certificate, established connections are not affected.
+type CertReloader struct {
+	certFile string
+	keyFile  string
+	keyPwd   []byte
+	cert     atomic.Pointer[tls.Certificate]
+	mu       sync.Mutex
+	modTime  time.Time
+}
+
+func NewCertReloader(certFile, keyFile string, keyPwd []byte) (*CertReloader, error) {
+	r := &CertReloader{certFile: certFile, keyFile: keyFile, keyPwd: keyPwd}
+	if _, err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate is used as tls.Config.GetCertificate.
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return r.cert.Load(), nil
+}
+
+// Reload loads the certificate again if one of its files was modified since the last load.
+// A certificate failing to load keeps the previous one in use.
+func (r *CertReloader) Reload() (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	modTime, err := r.lastModTime()
+	if err != nil {
+		return false, err
+	}
+	if r.cert.Load() != nil && !modTime.After(r.modTime) {
+		return false, nil
+	}
+	certPEMBlock, err := os.ReadFile(r.certFile)
+	if err != nil {
+		return false, errs.Wrap(err, r.certFile)
+	}
+	keyPEMBlock, err := readEncryptablePEMBlock(r.keyFile, r.keyPwd)
+	if err != nil {
+		return false, errs.Wrap(err, r.keyFile)
+	}
+	cert, err := tls.X509KeyPair(certPEMBlock, keyPEMBlock)
+	if err != nil {
+		return false, errs.Wrap(err, "load x509 key pair")
+	}
+	r.cert.Store(&cert)
+	r.modTime = modTime
+	return true, nil
+}
+
+func (r *CertReloader) lastModTime() (time.Time, error) {
+	var last time.Time
+	for _, file := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(file)
+		if err != nil {
+			return time.Time{}, errs.Wrap(err, file)
+		}
+		if info.ModTime().After(last) {
+			last = info.ModTime()
+		}
+	}
+	return last, nil
+}
+
+// Watch reloads the certificate every interval until ctx is done.
+func (r *CertReloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultReloadInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := r.Reload()
+			if err != nil {
+				log.ZWarn(ctx, "reload tls certificate failed", err, "cert", r.certFile, "key", r.keyFile)
+				continue
+			}
+			if reloaded {
+				log.ZInfo(ctx, "tls certificate reloaded", "cert", r.certFile, "key", r.keyFile)
+			}
+		}
+	}
+}
+
+// NewServerTLSConfig setup a server TLS config whose certificate is reloaded every reloadInterval until ctx is done.
+func NewServerTLSConfig(ctx context.Context, certFile, keyFile string, keyPwd []byte, reloadInterval time.Duration) (*tls.Config, error) {
+	r, err := NewCertReloader(certFile, keyFile, keyPwd)
+	if err != nil {
+		return nil, err
+	}
+	go r.Watch(ctx, reloadInterval)
+	return &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: r.GetCertificate,
+	}, nil
+}
@@ -116,6 +116,9 @@ const (
 
 	ClearConversationNotification = 2101
 	DeleteMsgsNotification        = 2102
+	MsgModifyNotification         = 2103
+	MsgReactionNotification       = 2104
+	MsgPinNotification            = 2105
 
 	HasReadReceipt = 2200
 
@@ -153,6 +156,8 @@ const (
 	PcMobileAndWeb = 4
 	// The PC terminal can be online at the same time,but other terminal only one of the endpoints can login.
 	PCAndOther = 5
+	// The sessions of a user are capped in total and per terminal class, the oldest ones are kicked.
+	DeviceCountLimit = 6
 
 	OnlineStatus  = "online"
 	OfflineStatus = "offline"
@@ -216,6 +221,7 @@ const (
 	CallbackUserOnlineCommand                            = "callbackUserOnlineCommand"
 	CallbackUserOfflineCommand                           = "callbackUserOfflineCommand"
 	CallbackUserKickOffCommand                           = "callbackUserKickOffCommand"
+	CallbackUserDeviceEvictedCommand                     = "callbackUserDeviceEvictedCommand"
 	CallbackOfflinePushCommand                           = "callbackOfflinePushCommand"
 	CallbackOnlinePushCommand                            = "callbackOnlinePushCommand"
 	CallbackSuperGroupOnlinePushCommand                  = "callbackSuperGroupOnlinePushCommand"
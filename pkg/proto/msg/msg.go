@@ -217,3 +217,13 @@ func (x *GetConversationsHasReadAndMaxSeqReq) Check() error {
 	}
 	return nil
 }
+
+func (x *GetMsgDeliveryStatesReq) Check() error {
+	if x.ConversationID == "" {
+		return errors.New("conversationID is empty")
+	}
+	if len(x.Seqs) == 0 {
+		return errors.New("seqs is empty")
+	}
+	return nil
+}
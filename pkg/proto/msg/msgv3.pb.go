@@ -367,6 +367,8 @@ type SendMsgReq struct {
 	unknownFields protoimpl.UnknownFields
 
 	MsgData *sdkws.MsgData `protobuf:"bytes,3,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	// 定时发送时间(毫秒)，晚于当前时间时消息保存后到时发送
+	ScheduleTime int64 `protobuf:"varint,4,opt,name=scheduleTime,proto3" json:"scheduleTime,omitempty"`
 }
 
 func (x *SendMsgReq) Reset() {
@@ -408,6 +410,13 @@ func (x *SendMsgReq) GetMsgData() *sdkws.MsgData {
 	return nil
 }
 
+func (x *SendMsgReq) GetScheduleTime() int64 {
+	if x != nil {
+		return x.ScheduleTime
+	}
+	return 0
+}
+
 type SendMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -416,6 +425,7 @@ type SendMsgResp struct {
 	ServerMsgID string `protobuf:"bytes,1,opt,name=serverMsgID,proto3" json:"serverMsgID,omitempty"`
 	ClientMsgID string `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID,omitempty"`
 	SendTime    int64  `protobuf:"varint,3,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	ScheduleID  string `protobuf:"bytes,4,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
 }
 
 func (x *SendMsgResp) Reset() {
@@ -471,6 +481,13 @@ func (x *SendMsgResp) GetSendTime() int64 {
 	return 0
 }
 
+func (x *SendMsgResp) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
 type SetSendMsgStatusReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -881,18 +898,19 @@ func (*RevokeMsgResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{16}
 }
 
-type MarkMsgsAsReadReq struct {
+type ModifyMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
-	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
-	UserID         string  `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Content        string `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 }
 
-func (x *MarkMsgsAsReadReq) Reset() {
-	*x = MarkMsgsAsReadReq{}
+func (x *ModifyMsgReq) Reset() {
+	*x = ModifyMsgReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -900,13 +918,13 @@ func (x *MarkMsgsAsReadReq) Reset() {
 	}
 }
 
-func (x *MarkMsgsAsReadReq) String() string {
+func (x *ModifyMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkMsgsAsReadReq) ProtoMessage() {}
+func (*ModifyMsgReq) ProtoMessage() {}
 
-func (x *MarkMsgsAsReadReq) ProtoReflect() protoreflect.Message {
+func (x *ModifyMsgReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -918,40 +936,50 @@ func (x *MarkMsgsAsReadReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkMsgsAsReadReq.ProtoReflect.Descriptor instead.
-func (*MarkMsgsAsReadReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModifyMsgReq.ProtoReflect.Descriptor instead.
+func (*ModifyMsgReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MarkMsgsAsReadReq) GetConversationID() string {
+func (x *ModifyMsgReq) GetConversationID() string {
 	if x != nil {
 		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *MarkMsgsAsReadReq) GetSeqs() []int64 {
+func (x *ModifyMsgReq) GetSeq() int64 {
 	if x != nil {
-		return x.Seqs
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *MarkMsgsAsReadReq) GetUserID() string {
+func (x *ModifyMsgReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type MarkMsgsAsReadResp struct {
+func (x *ModifyMsgReq) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ModifyMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Content    string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	ModifyTime int64  `protobuf:"varint,2,opt,name=modifyTime,proto3" json:"modifyTime,omitempty"`
 }
 
-func (x *MarkMsgsAsReadResp) Reset() {
-	*x = MarkMsgsAsReadResp{}
+func (x *ModifyMsgResp) Reset() {
+	*x = ModifyMsgResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -959,13 +987,13 @@ func (x *MarkMsgsAsReadResp) Reset() {
 	}
 }
 
-func (x *MarkMsgsAsReadResp) String() string {
+func (x *ModifyMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkMsgsAsReadResp) ProtoMessage() {}
+func (*ModifyMsgResp) ProtoMessage() {}
 
-func (x *MarkMsgsAsReadResp) ProtoReflect() protoreflect.Message {
+func (x *ModifyMsgResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -977,24 +1005,38 @@ func (x *MarkMsgsAsReadResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkMsgsAsReadResp.ProtoReflect.Descriptor instead.
-func (*MarkMsgsAsReadResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ModifyMsgResp.ProtoReflect.Descriptor instead.
+func (*ModifyMsgResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{18}
 }
 
-type MarkConversationAsReadReq struct {
+func (x *ModifyMsgResp) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ModifyMsgResp) GetModifyTime() int64 {
+	if x != nil {
+		return x.ModifyTime
+	}
+	return 0
+}
+
+type AddReactionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
-	UserID         string  `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	HasReadSeq     int64   `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
-	Seqs           []int64 `protobuf:"varint,4,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reaction       string `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
 }
 
-func (x *MarkConversationAsReadReq) Reset() {
-	*x = MarkConversationAsReadReq{}
+func (x *AddReactionReq) Reset() {
+	*x = AddReactionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1002,13 +1044,13 @@ func (x *MarkConversationAsReadReq) Reset() {
 	}
 }
 
-func (x *MarkConversationAsReadReq) String() string {
+func (x *AddReactionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkConversationAsReadReq) ProtoMessage() {}
+func (*AddReactionReq) ProtoMessage() {}
 
-func (x *MarkConversationAsReadReq) ProtoReflect() protoreflect.Message {
+func (x *AddReactionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1020,47 +1062,49 @@ func (x *MarkConversationAsReadReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkConversationAsReadReq.ProtoReflect.Descriptor instead.
-func (*MarkConversationAsReadReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddReactionReq.ProtoReflect.Descriptor instead.
+func (*AddReactionReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MarkConversationAsReadReq) GetConversationID() string {
+func (x *AddReactionReq) GetConversationID() string {
 	if x != nil {
 		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *MarkConversationAsReadReq) GetUserID() string {
+func (x *AddReactionReq) GetSeq() int64 {
 	if x != nil {
-		return x.UserID
+		return x.Seq
 	}
-	return ""
+	return 0
 }
 
-func (x *MarkConversationAsReadReq) GetHasReadSeq() int64 {
+func (x *AddReactionReq) GetUserID() string {
 	if x != nil {
-		return x.HasReadSeq
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *MarkConversationAsReadReq) GetSeqs() []int64 {
+func (x *AddReactionReq) GetReaction() string {
 	if x != nil {
-		return x.Seqs
+		return x.Reaction
 	}
-	return nil
+	return ""
 }
 
-type MarkConversationAsReadResp struct {
+type AddReactionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reactions []*sdkws.MsgReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
 }
 
-func (x *MarkConversationAsReadResp) Reset() {
-	*x = MarkConversationAsReadResp{}
+func (x *AddReactionResp) Reset() {
+	*x = AddReactionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1068,13 +1112,13 @@ func (x *MarkConversationAsReadResp) Reset() {
 	}
 }
 
-func (x *MarkConversationAsReadResp) String() string {
+func (x *AddReactionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkConversationAsReadResp) ProtoMessage() {}
+func (*AddReactionResp) ProtoMessage() {}
 
-func (x *MarkConversationAsReadResp) ProtoReflect() protoreflect.Message {
+func (x *AddReactionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1086,23 +1130,31 @@ func (x *MarkConversationAsReadResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkConversationAsReadResp.ProtoReflect.Descriptor instead.
-func (*MarkConversationAsReadResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddReactionResp.ProtoReflect.Descriptor instead.
+func (*AddReactionResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{20}
 }
 
-type SetConversationHasReadSeqReq struct {
+func (x *AddReactionResp) GetReactions() []*sdkws.MsgReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type RemoveReactionReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
-	UserID         string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	HasReadSeq     int64  `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reaction       string `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
 }
 
-func (x *SetConversationHasReadSeqReq) Reset() {
-	*x = SetConversationHasReadSeqReq{}
+func (x *RemoveReactionReq) Reset() {
+	*x = RemoveReactionReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1110,13 +1162,13 @@ func (x *SetConversationHasReadSeqReq) Reset() {
 	}
 }
 
-func (x *SetConversationHasReadSeqReq) String() string {
+func (x *RemoveReactionReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConversationHasReadSeqReq) ProtoMessage() {}
+func (*RemoveReactionReq) ProtoMessage() {}
 
-func (x *SetConversationHasReadSeqReq) ProtoReflect() protoreflect.Message {
+func (x *RemoveReactionReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1128,40 +1180,49 @@ func (x *SetConversationHasReadSeqReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConversationHasReadSeqReq.ProtoReflect.Descriptor instead.
-func (*SetConversationHasReadSeqReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveReactionReq.ProtoReflect.Descriptor instead.
+func (*RemoveReactionReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SetConversationHasReadSeqReq) GetConversationID() string {
+func (x *RemoveReactionReq) GetConversationID() string {
 	if x != nil {
 		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *SetConversationHasReadSeqReq) GetUserID() string {
+func (x *RemoveReactionReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *RemoveReactionReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *SetConversationHasReadSeqReq) GetHasReadSeq() int64 {
+func (x *RemoveReactionReq) GetReaction() string {
 	if x != nil {
-		return x.HasReadSeq
+		return x.Reaction
 	}
-	return 0
+	return ""
 }
 
-type SetConversationHasReadSeqResp struct {
+type RemoveReactionResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reactions []*sdkws.MsgReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
 }
 
-func (x *SetConversationHasReadSeqResp) Reset() {
-	*x = SetConversationHasReadSeqResp{}
+func (x *RemoveReactionResp) Reset() {
+	*x = RemoveReactionResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1169,13 +1230,13 @@ func (x *SetConversationHasReadSeqResp) Reset() {
 	}
 }
 
-func (x *SetConversationHasReadSeqResp) String() string {
+func (x *RemoveReactionResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetConversationHasReadSeqResp) ProtoMessage() {}
+func (*RemoveReactionResp) ProtoMessage() {}
 
-func (x *SetConversationHasReadSeqResp) ProtoReflect() protoreflect.Message {
+func (x *RemoveReactionResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1187,22 +1248,32 @@ func (x *SetConversationHasReadSeqResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetConversationHasReadSeqResp.ProtoReflect.Descriptor instead.
-func (*SetConversationHasReadSeqResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveReactionResp.ProtoReflect.Descriptor instead.
+func (*RemoveReactionResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{22}
 }
 
-type DeleteSyncOpt struct {
+func (x *RemoveReactionResp) GetReactions() []*sdkws.MsgReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+type GetReactionsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IsSyncSelf  bool `protobuf:"varint,3,opt,name=IsSyncSelf,proto3" json:"IsSyncSelf,omitempty"`
-	IsSyncOther bool `protobuf:"varint,4,opt,name=IsSyncOther,proto3" json:"IsSyncOther,omitempty"`
+	ConversationID string                   `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64                    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string                   `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reaction       string                   `protobuf:"bytes,4,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	Pagination     *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *DeleteSyncOpt) Reset() {
-	*x = DeleteSyncOpt{}
+func (x *GetReactionsReq) Reset() {
+	*x = GetReactionsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,13 +1281,13 @@ func (x *DeleteSyncOpt) Reset() {
 	}
 }
 
-func (x *DeleteSyncOpt) String() string {
+func (x *GetReactionsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSyncOpt) ProtoMessage() {}
+func (*GetReactionsReq) ProtoMessage() {}
 
-func (x *DeleteSyncOpt) ProtoReflect() protoreflect.Message {
+func (x *GetReactionsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1228,37 +1299,58 @@ func (x *DeleteSyncOpt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSyncOpt.ProtoReflect.Descriptor instead.
-func (*DeleteSyncOpt) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReactionsReq.ProtoReflect.Descriptor instead.
+func (*GetReactionsReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *DeleteSyncOpt) GetIsSyncSelf() bool {
+func (x *GetReactionsReq) GetConversationID() string {
 	if x != nil {
-		return x.IsSyncSelf
+		return x.ConversationID
 	}
-	return false
+	return ""
 }
 
-func (x *DeleteSyncOpt) GetIsSyncOther() bool {
+func (x *GetReactionsReq) GetSeq() int64 {
 	if x != nil {
-		return x.IsSyncOther
+		return x.Seq
 	}
-	return false
+	return 0
 }
 
-type ClearConversationsMsgReq struct {
+func (x *GetReactionsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetReactionsReq) GetReaction() string {
+	if x != nil {
+		return x.Reaction
+	}
+	return ""
+}
+
+func (x *GetReactionsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ReactionUser struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationIDs []string       `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
-	UserID          string         `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
-	DeleteSyncOpt   *DeleteSyncOpt `protobuf:"bytes,3,opt,name=deleteSyncOpt,proto3" json:"deleteSyncOpt,omitempty"`
+	UserID     string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Reaction   string `protobuf:"bytes,2,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	CreateTime int64  `protobuf:"varint,3,opt,name=createTime,proto3" json:"createTime,omitempty"`
 }
 
-func (x *ClearConversationsMsgReq) Reset() {
-	*x = ClearConversationsMsgReq{}
+func (x *ReactionUser) Reset() {
+	*x = ReactionUser{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1266,13 +1358,13 @@ func (x *ClearConversationsMsgReq) Reset() {
 	}
 }
 
-func (x *ClearConversationsMsgReq) String() string {
+func (x *ReactionUser) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearConversationsMsgReq) ProtoMessage() {}
+func (*ReactionUser) ProtoMessage() {}
 
-func (x *ClearConversationsMsgReq) ProtoReflect() protoreflect.Message {
+func (x *ReactionUser) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1284,40 +1376,44 @@ func (x *ClearConversationsMsgReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearConversationsMsgReq.ProtoReflect.Descriptor instead.
-func (*ClearConversationsMsgReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReactionUser.ProtoReflect.Descriptor instead.
+func (*ReactionUser) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ClearConversationsMsgReq) GetConversationIDs() []string {
+func (x *ReactionUser) GetUserID() string {
 	if x != nil {
-		return x.ConversationIDs
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-func (x *ClearConversationsMsgReq) GetUserID() string {
+func (x *ReactionUser) GetReaction() string {
 	if x != nil {
-		return x.UserID
+		return x.Reaction
 	}
 	return ""
 }
 
-func (x *ClearConversationsMsgReq) GetDeleteSyncOpt() *DeleteSyncOpt {
+func (x *ReactionUser) GetCreateTime() int64 {
 	if x != nil {
-		return x.DeleteSyncOpt
+		return x.CreateTime
 	}
-	return nil
+	return 0
 }
 
-type ClearConversationsMsgResp struct {
+type GetReactionsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Reactions []*sdkws.MsgReaction `protobuf:"bytes,1,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	Total     int64                `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Users     []*ReactionUser      `protobuf:"bytes,3,rep,name=users,proto3" json:"users,omitempty"`
 }
 
-func (x *ClearConversationsMsgResp) Reset() {
-	*x = ClearConversationsMsgResp{}
+func (x *GetReactionsResp) Reset() {
+	*x = GetReactionsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1325,13 +1421,13 @@ func (x *ClearConversationsMsgResp) Reset() {
 	}
 }
 
-func (x *ClearConversationsMsgResp) String() string {
+func (x *GetReactionsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ClearConversationsMsgResp) ProtoMessage() {}
+func (*GetReactionsResp) ProtoMessage() {}
 
-func (x *ClearConversationsMsgResp) ProtoReflect() protoreflect.Message {
+func (x *GetReactionsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,22 +1439,47 @@ func (x *ClearConversationsMsgResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ClearConversationsMsgResp.ProtoReflect.Descriptor instead.
-func (*ClearConversationsMsgResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetReactionsResp.ProtoReflect.Descriptor instead.
+func (*GetReactionsResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{25}
 }
 
-type UserClearAllMsgReq struct {
+func (x *GetReactionsResp) GetReactions() []*sdkws.MsgReaction {
+	if x != nil {
+		return x.Reactions
+	}
+	return nil
+}
+
+func (x *GetReactionsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetReactionsResp) GetUsers() []*ReactionUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetThreadMsgsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID        string         `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	DeleteSyncOpt *DeleteSyncOpt `protobuf:"bytes,3,opt,name=deleteSyncOpt,proto3" json:"deleteSyncOpt,omitempty"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	RootSeq        int64  `protobuf:"varint,2,opt,name=rootSeq,proto3" json:"rootSeq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// replies with thread seq >= beginThreadSeq in thread seq order
+	BeginThreadSeq int64 `protobuf:"varint,4,opt,name=beginThreadSeq,proto3" json:"beginThreadSeq,omitempty"`
+	Num            int64 `protobuf:"varint,5,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-func (x *UserClearAllMsgReq) Reset() {
-	*x = UserClearAllMsgReq{}
+func (x *GetThreadMsgsReq) Reset() {
+	*x = GetThreadMsgsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1366,13 +1487,13 @@ func (x *UserClearAllMsgReq) Reset() {
 	}
 }
 
-func (x *UserClearAllMsgReq) String() string {
+func (x *GetThreadMsgsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserClearAllMsgReq) ProtoMessage() {}
+func (*GetThreadMsgsReq) ProtoMessage() {}
 
-func (x *UserClearAllMsgReq) ProtoReflect() protoreflect.Message {
+func (x *GetThreadMsgsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1384,47 +1505,72 @@ func (x *UserClearAllMsgReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserClearAllMsgReq.ProtoReflect.Descriptor instead.
-func (*UserClearAllMsgReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetThreadMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetThreadMsgsReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UserClearAllMsgReq) GetUserID() string {
+func (x *GetThreadMsgsReq) GetConversationID() string {
 	if x != nil {
-		return x.UserID
+		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *UserClearAllMsgReq) GetDeleteSyncOpt() *DeleteSyncOpt {
+func (x *GetThreadMsgsReq) GetRootSeq() int64 {
 	if x != nil {
-		return x.DeleteSyncOpt
+		return x.RootSeq
 	}
-	return nil
+	return 0
 }
 
-type UserClearAllMsgResp struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetThreadMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
 }
 
-func (x *UserClearAllMsgResp) Reset() {
-	*x = UserClearAllMsgResp{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[27]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+func (x *GetThreadMsgsReq) GetBeginThreadSeq() int64 {
+	if x != nil {
+		return x.BeginThreadSeq
+	}
+	return 0
+}
+
+func (x *GetThreadMsgsReq) GetNum() int64 {
+	if x != nil {
+		return x.Num
+	}
+	return 0
+}
+
+type GetThreadMsgsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RootMsg *sdkws.MsgData   `protobuf:"bytes,1,opt,name=rootMsg,proto3" json:"rootMsg,omitempty"`
+	Msgs    []*sdkws.MsgData `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	Total   int64            `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *GetThreadMsgsResp) Reset() {
+	*x = GetThreadMsgsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserClearAllMsgResp) String() string {
+func (x *GetThreadMsgsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserClearAllMsgResp) ProtoMessage() {}
+func (*GetThreadMsgsResp) ProtoMessage() {}
 
-func (x *UserClearAllMsgResp) ProtoReflect() protoreflect.Message {
+func (x *GetThreadMsgsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1436,24 +1582,46 @@ func (x *UserClearAllMsgResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserClearAllMsgResp.ProtoReflect.Descriptor instead.
-func (*UserClearAllMsgResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetThreadMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetThreadMsgsResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{27}
 }
 
-type DeleteMsgsReq struct {
+func (x *GetThreadMsgsResp) GetRootMsg() *sdkws.MsgData {
+	if x != nil {
+		return x.RootMsg
+	}
+	return nil
+}
+
+func (x *GetThreadMsgsResp) GetMsgs() []*sdkws.MsgData {
+	if x != nil {
+		return x.Msgs
+	}
+	return nil
+}
+
+func (x *GetThreadMsgsResp) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type MarkThreadAsReadReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationID string         `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
-	Seqs           []int64        `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
-	UserID         string         `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	DeleteSyncOpt  *DeleteSyncOpt `protobuf:"bytes,4,opt,name=deleteSyncOpt,proto3" json:"deleteSyncOpt,omitempty"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	RootSeq        int64  `protobuf:"varint,2,opt,name=rootSeq,proto3" json:"rootSeq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// thread seq, 0 marks up to the last reply
+	HasReadSeq int64 `protobuf:"varint,4,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
 }
 
-func (x *DeleteMsgsReq) Reset() {
-	*x = DeleteMsgsReq{}
+func (x *MarkThreadAsReadReq) Reset() {
+	*x = MarkThreadAsReadReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1461,13 +1629,13 @@ func (x *DeleteMsgsReq) Reset() {
 	}
 }
 
-func (x *DeleteMsgsReq) String() string {
+func (x *MarkThreadAsReadReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMsgsReq) ProtoMessage() {}
+func (*MarkThreadAsReadReq) ProtoMessage() {}
 
-func (x *DeleteMsgsReq) ProtoReflect() protoreflect.Message {
+func (x *MarkThreadAsReadReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1479,47 +1647,47 @@ func (x *DeleteMsgsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMsgsReq.ProtoReflect.Descriptor instead.
-func (*DeleteMsgsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkThreadAsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteMsgsReq) GetConversationID() string {
+func (x *MarkThreadAsReadReq) GetConversationID() string {
 	if x != nil {
 		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *DeleteMsgsReq) GetSeqs() []int64 {
+func (x *MarkThreadAsReadReq) GetRootSeq() int64 {
 	if x != nil {
-		return x.Seqs
+		return x.RootSeq
 	}
-	return nil
+	return 0
 }
 
-func (x *DeleteMsgsReq) GetUserID() string {
+func (x *MarkThreadAsReadReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-func (x *DeleteMsgsReq) GetDeleteSyncOpt() *DeleteSyncOpt {
+func (x *MarkThreadAsReadReq) GetHasReadSeq() int64 {
 	if x != nil {
-		return x.DeleteSyncOpt
+		return x.HasReadSeq
 	}
-	return nil
+	return 0
 }
 
-type DeleteMsgsResp struct {
+type MarkThreadAsReadResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteMsgsResp) Reset() {
-	*x = DeleteMsgsResp{}
+func (x *MarkThreadAsReadResp) Reset() {
+	*x = MarkThreadAsReadResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1527,13 +1695,13 @@ func (x *DeleteMsgsResp) Reset() {
 	}
 }
 
-func (x *DeleteMsgsResp) String() string {
+func (x *MarkThreadAsReadResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMsgsResp) ProtoMessage() {}
+func (*MarkThreadAsReadResp) ProtoMessage() {}
 
-func (x *DeleteMsgsResp) ProtoReflect() protoreflect.Message {
+func (x *MarkThreadAsReadResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1545,22 +1713,26 @@ func (x *DeleteMsgsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMsgsResp.ProtoReflect.Descriptor instead.
-func (*DeleteMsgsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkThreadAsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkThreadAsReadResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{29}
 }
 
-type DeleteMsgPhysicalReq struct {
+type ScheduledMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationIDs []string `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
-	Timestamp       int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ScheduleID   string         `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	MsgData      *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	ScheduleTime int64          `protobuf:"varint,3,opt,name=scheduleTime,proto3" json:"scheduleTime,omitempty"`
+	Status       int32          `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`
+	Error        string         `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CreateTime   int64          `protobuf:"varint,6,opt,name=createTime,proto3" json:"createTime,omitempty"`
 }
 
-func (x *DeleteMsgPhysicalReq) Reset() {
-	*x = DeleteMsgPhysicalReq{}
+func (x *ScheduledMsg) Reset() {
+	*x = ScheduledMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1568,13 +1740,13 @@ func (x *DeleteMsgPhysicalReq) Reset() {
 	}
 }
 
-func (x *DeleteMsgPhysicalReq) String() string {
+func (x *ScheduledMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMsgPhysicalReq) ProtoMessage() {}
+func (*ScheduledMsg) ProtoMessage() {}
 
-func (x *DeleteMsgPhysicalReq) ProtoReflect() protoreflect.Message {
+func (x *ScheduledMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1586,33 +1758,66 @@ func (x *DeleteMsgPhysicalReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMsgPhysicalReq.ProtoReflect.Descriptor instead.
-func (*DeleteMsgPhysicalReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ScheduledMsg.ProtoReflect.Descriptor instead.
+func (*ScheduledMsg) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *DeleteMsgPhysicalReq) GetConversationIDs() []string {
+func (x *ScheduledMsg) GetScheduleID() string {
 	if x != nil {
-		return x.ConversationIDs
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
 	}
 	return nil
 }
 
-func (x *DeleteMsgPhysicalReq) GetTimestamp() int64 {
+func (x *ScheduledMsg) GetScheduleTime() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.ScheduleTime
 	}
 	return 0
 }
 
-type DeleteMsgPhysicalResp struct {
+func (x *ScheduledMsg) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ScheduledMsg) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ScheduledMsg) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+type GetScheduledMsgsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendID string `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	// 0 为全部状态
+	Status     int32                    `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *DeleteMsgPhysicalResp) Reset() {
-	*x = DeleteMsgPhysicalResp{}
+func (x *GetScheduledMsgsReq) Reset() {
+	*x = GetScheduledMsgsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1620,13 +1825,13 @@ func (x *DeleteMsgPhysicalResp) Reset() {
 	}
 }
 
-func (x *DeleteMsgPhysicalResp) String() string {
+func (x *GetScheduledMsgsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMsgPhysicalResp) ProtoMessage() {}
+func (*GetScheduledMsgsReq) ProtoMessage() {}
 
-func (x *DeleteMsgPhysicalResp) ProtoReflect() protoreflect.Message {
+func (x *GetScheduledMsgsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1638,22 +1843,43 @@ func (x *DeleteMsgPhysicalResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMsgPhysicalResp.ProtoReflect.Descriptor instead.
-func (*DeleteMsgPhysicalResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetScheduledMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{31}
 }
 
-type DeleteMsgPhysicalBySeqReq struct {
+func (x *GetScheduledMsgsReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *GetScheduledMsgsReq) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetScheduledMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type GetScheduledMsgsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
-	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	Total int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Msgs  []*ScheduledMsg `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func (x *DeleteMsgPhysicalBySeqReq) Reset() {
-	*x = DeleteMsgPhysicalBySeqReq{}
+func (x *GetScheduledMsgsResp) Reset() {
+	*x = GetScheduledMsgsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1661,13 +1887,13 @@ func (x *DeleteMsgPhysicalBySeqReq) Reset() {
 	}
 }
 
-func (x *DeleteMsgPhysicalBySeqReq) String() string {
+func (x *GetScheduledMsgsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMsgPhysicalBySeqReq) ProtoMessage() {}
+func (*GetScheduledMsgsResp) ProtoMessage() {}
 
-func (x *DeleteMsgPhysicalBySeqReq) ProtoReflect() protoreflect.Message {
+func (x *GetScheduledMsgsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1679,33 +1905,36 @@ func (x *DeleteMsgPhysicalBySeqReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMsgPhysicalBySeqReq.ProtoReflect.Descriptor instead.
-func (*DeleteMsgPhysicalBySeqReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetScheduledMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetScheduledMsgsResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *DeleteMsgPhysicalBySeqReq) GetConversationID() string {
+func (x *GetScheduledMsgsResp) GetTotal() int64 {
 	if x != nil {
-		return x.ConversationID
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *DeleteMsgPhysicalBySeqReq) GetSeqs() []int64 {
+func (x *GetScheduledMsgsResp) GetMsgs() []*ScheduledMsg {
 	if x != nil {
-		return x.Seqs
+		return x.Msgs
 	}
 	return nil
 }
 
-type DeleteMsgPhysicalBySeqResp struct {
+type CancelScheduledMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	SendID     string `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	ScheduleID string `protobuf:"bytes,2,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
 }
 
-func (x *DeleteMsgPhysicalBySeqResp) Reset() {
-	*x = DeleteMsgPhysicalBySeqResp{}
+func (x *CancelScheduledMsgReq) Reset() {
+	*x = CancelScheduledMsgReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1713,13 +1942,13 @@ func (x *DeleteMsgPhysicalBySeqResp) Reset() {
 	}
 }
 
-func (x *DeleteMsgPhysicalBySeqResp) String() string {
+func (x *CancelScheduledMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteMsgPhysicalBySeqResp) ProtoMessage() {}
+func (*CancelScheduledMsgReq) ProtoMessage() {}
 
-func (x *DeleteMsgPhysicalBySeqResp) ProtoReflect() protoreflect.Message {
+func (x *CancelScheduledMsgReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1731,21 +1960,33 @@ func (x *DeleteMsgPhysicalBySeqResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteMsgPhysicalBySeqResp.ProtoReflect.Descriptor instead.
-func (*DeleteMsgPhysicalBySeqResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelScheduledMsgReq.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{33}
 }
 
-type GetMaxSeqsReq struct {
+func (x *CancelScheduledMsgReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *CancelScheduledMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+type CancelScheduledMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
 }
 
-func (x *GetMaxSeqsReq) Reset() {
-	*x = GetMaxSeqsReq{}
+func (x *CancelScheduledMsgResp) Reset() {
+	*x = CancelScheduledMsgResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1753,13 +1994,13 @@ func (x *GetMaxSeqsReq) Reset() {
 	}
 }
 
-func (x *GetMaxSeqsReq) String() string {
+func (x *CancelScheduledMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMaxSeqsReq) ProtoMessage() {}
+func (*CancelScheduledMsgResp) ProtoMessage() {}
 
-func (x *GetMaxSeqsReq) ProtoReflect() protoreflect.Message {
+func (x *CancelScheduledMsgResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1771,28 +2012,23 @@ func (x *GetMaxSeqsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMaxSeqsReq.ProtoReflect.Descriptor instead.
-func (*GetMaxSeqsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelScheduledMsgResp.ProtoReflect.Descriptor instead.
+func (*CancelScheduledMsgResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetMaxSeqsReq) GetConversationIDs() []string {
-	if x != nil {
-		return x.ConversationIDs
-	}
-	return nil
-}
-
-type GetMinSeqsReq struct {
+type RescheduleMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	SendID       string `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	ScheduleID   string `protobuf:"bytes,2,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	ScheduleTime int64  `protobuf:"varint,3,opt,name=scheduleTime,proto3" json:"scheduleTime,omitempty"`
 }
 
-func (x *GetMinSeqsReq) Reset() {
-	*x = GetMinSeqsReq{}
+func (x *RescheduleMsgReq) Reset() {
+	*x = RescheduleMsgReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1800,13 +2036,13 @@ func (x *GetMinSeqsReq) Reset() {
 	}
 }
 
-func (x *GetMinSeqsReq) String() string {
+func (x *RescheduleMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMinSeqsReq) ProtoMessage() {}
+func (*RescheduleMsgReq) ProtoMessage() {}
 
-func (x *GetMinSeqsReq) ProtoReflect() protoreflect.Message {
+func (x *RescheduleMsgReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1818,29 +2054,40 @@ func (x *GetMinSeqsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMinSeqsReq.ProtoReflect.Descriptor instead.
-func (*GetMinSeqsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RescheduleMsgReq.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetMinSeqsReq) GetConversationIDs() []string {
+func (x *RescheduleMsgReq) GetSendID() string {
 	if x != nil {
-		return x.ConversationIDs
+		return x.SendID
 	}
-	return nil
+	return ""
 }
 
-type GetHasReadSeqsReq struct {
+func (x *RescheduleMsgReq) GetScheduleID() string {
+	if x != nil {
+		return x.ScheduleID
+	}
+	return ""
+}
+
+func (x *RescheduleMsgReq) GetScheduleTime() int64 {
+	if x != nil {
+		return x.ScheduleTime
+	}
+	return 0
+}
+
+type RescheduleMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
 }
 
-func (x *GetHasReadSeqsReq) Reset() {
-	*x = GetHasReadSeqsReq{}
+func (x *RescheduleMsgResp) Reset() {
+	*x = RescheduleMsgResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1848,13 +2095,13 @@ func (x *GetHasReadSeqsReq) Reset() {
 	}
 }
 
-func (x *GetHasReadSeqsReq) String() string {
+func (x *RescheduleMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetHasReadSeqsReq) ProtoMessage() {}
+func (*RescheduleMsgResp) ProtoMessage() {}
 
-func (x *GetHasReadSeqsReq) ProtoReflect() protoreflect.Message {
+func (x *RescheduleMsgResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1866,36 +2113,23 @@ func (x *GetHasReadSeqsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetHasReadSeqsReq.ProtoReflect.Descriptor instead.
-func (*GetHasReadSeqsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use RescheduleMsgResp.ProtoReflect.Descriptor instead.
+func (*RescheduleMsgResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetHasReadSeqsReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *GetHasReadSeqsReq) GetConversationIDs() []string {
-	if x != nil {
-		return x.ConversationIDs
-	}
-	return nil
-}
-
-type SeqsInfoResp struct {
+type PinMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxSeqs map[string]int64 `protobuf:"bytes,1,rep,name=maxSeqs,proto3" json:"maxSeqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	MinSeqs map[string]int64 `protobuf:"bytes,2,rep,name=minSeqs,proto3" json:"minSeqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *SeqsInfoResp) Reset() {
-	*x = SeqsInfoResp{}
+func (x *PinMsgReq) Reset() {
+	*x = PinMsgReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1903,13 +2137,13 @@ func (x *SeqsInfoResp) Reset() {
 	}
 }
 
-func (x *SeqsInfoResp) String() string {
+func (x *PinMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SeqsInfoResp) ProtoMessage() {}
+func (*PinMsgReq) ProtoMessage() {}
 
-func (x *SeqsInfoResp) ProtoReflect() protoreflect.Message {
+func (x *PinMsgReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1921,36 +2155,40 @@ func (x *SeqsInfoResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SeqsInfoResp.ProtoReflect.Descriptor instead.
-func (*SeqsInfoResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinMsgReq.ProtoReflect.Descriptor instead.
+func (*PinMsgReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *SeqsInfoResp) GetMaxSeqs() map[string]int64 {
+func (x *PinMsgReq) GetConversationID() string {
 	if x != nil {
-		return x.MaxSeqs
+		return x.ConversationID
 	}
-	return nil
+	return ""
 }
 
-func (x *SeqsInfoResp) GetMinSeqs() map[string]int64 {
+func (x *PinMsgReq) GetSeq() int64 {
 	if x != nil {
-		return x.MinSeqs
+		return x.Seq
 	}
-	return nil
+	return 0
 }
 
-type GetMsgByConversationIDsReq struct {
+func (x *PinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type PinMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ConversationIDs []string         `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
-	MaxSeqs         map[string]int64 `protobuf:"bytes,2,rep,name=maxSeqs,proto3" json:"maxSeqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *GetMsgByConversationIDsReq) Reset() {
-	*x = GetMsgByConversationIDsReq{}
+func (x *PinMsgResp) Reset() {
+	*x = PinMsgResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1958,13 +2196,13 @@ func (x *GetMsgByConversationIDsReq) Reset() {
 	}
 }
 
-func (x *GetMsgByConversationIDsReq) String() string {
+func (x *PinMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMsgByConversationIDsReq) ProtoMessage() {}
+func (*PinMsgResp) ProtoMessage() {}
 
-func (x *GetMsgByConversationIDsReq) ProtoReflect() protoreflect.Message {
+func (x *PinMsgResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1976,35 +2214,23 @@ func (x *GetMsgByConversationIDsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMsgByConversationIDsReq.ProtoReflect.Descriptor instead.
-func (*GetMsgByConversationIDsReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use PinMsgResp.ProtoReflect.Descriptor instead.
+func (*PinMsgResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetMsgByConversationIDsReq) GetConversationIDs() []string {
-	if x != nil {
-		return x.ConversationIDs
-	}
-	return nil
-}
-
-func (x *GetMsgByConversationIDsReq) GetMaxSeqs() map[string]int64 {
-	if x != nil {
-		return x.MaxSeqs
-	}
-	return nil
-}
-
-type GetMsgByConversationIDsResp struct {
+type UnpinMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MsgDatas map[string]*sdkws.MsgData `protobuf:"bytes,1,rep,name=msgDatas,proto3" json:"msgDatas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seq            int64  `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GetMsgByConversationIDsResp) Reset() {
-	*x = GetMsgByConversationIDsResp{}
+func (x *UnpinMsgReq) Reset() {
+	*x = UnpinMsgReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2012,13 +2238,13 @@ func (x *GetMsgByConversationIDsResp) Reset() {
 	}
 }
 
-func (x *GetMsgByConversationIDsResp) String() string {
+func (x *UnpinMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMsgByConversationIDsResp) ProtoMessage() {}
+func (*UnpinMsgReq) ProtoMessage() {}
 
-func (x *GetMsgByConversationIDsResp) ProtoReflect() protoreflect.Message {
+func (x *UnpinMsgReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2030,28 +2256,40 @@ func (x *GetMsgByConversationIDsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMsgByConversationIDsResp.ProtoReflect.Descriptor instead.
-func (*GetMsgByConversationIDsResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpinMsgReq.ProtoReflect.Descriptor instead.
+func (*UnpinMsgReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetMsgByConversationIDsResp) GetMsgDatas() map[string]*sdkws.MsgData {
+func (x *UnpinMsgReq) GetConversationID() string {
 	if x != nil {
-		return x.MsgDatas
+		return x.ConversationID
 	}
-	return nil
+	return ""
 }
 
-type GetConversationMaxSeqReq struct {
+func (x *UnpinMsgReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *UnpinMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type UnpinMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
 }
 
-func (x *GetConversationMaxSeqReq) Reset() {
-	*x = GetConversationMaxSeqReq{}
+func (x *UnpinMsgResp) Reset() {
+	*x = UnpinMsgResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2059,13 +2297,13 @@ func (x *GetConversationMaxSeqReq) Reset() {
 	}
 }
 
-func (x *GetConversationMaxSeqReq) String() string {
+func (x *UnpinMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationMaxSeqReq) ProtoMessage() {}
+func (*UnpinMsgResp) ProtoMessage() {}
 
-func (x *GetConversationMaxSeqReq) ProtoReflect() protoreflect.Message {
+func (x *UnpinMsgResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2077,28 +2315,22 @@ func (x *GetConversationMaxSeqReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationMaxSeqReq.ProtoReflect.Descriptor instead.
-func (*GetConversationMaxSeqReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnpinMsgResp.ProtoReflect.Descriptor instead.
+func (*UnpinMsgResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetConversationMaxSeqReq) GetConversationID() string {
-	if x != nil {
-		return x.ConversationID
-	}
-	return ""
-}
-
-type GetConversationMaxSeqResp struct {
+type GetPinnedMsgsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxSeq int64 `protobuf:"varint,1,opt,name=maxSeq,proto3" json:"maxSeq,omitempty"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	UserID         string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GetConversationMaxSeqResp) Reset() {
-	*x = GetConversationMaxSeqResp{}
+func (x *GetPinnedMsgsReq) Reset() {
+	*x = GetPinnedMsgsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2106,13 +2338,13 @@ func (x *GetConversationMaxSeqResp) Reset() {
 	}
 }
 
-func (x *GetConversationMaxSeqResp) String() string {
+func (x *GetPinnedMsgsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationMaxSeqResp) ProtoMessage() {}
+func (*GetPinnedMsgsReq) ProtoMessage() {}
 
-func (x *GetConversationMaxSeqResp) ProtoReflect() protoreflect.Message {
+func (x *GetPinnedMsgsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2124,30 +2356,35 @@ func (x *GetConversationMaxSeqResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationMaxSeqResp.ProtoReflect.Descriptor instead.
-func (*GetConversationMaxSeqResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPinnedMsgsReq.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetConversationMaxSeqResp) GetMaxSeq() int64 {
+func (x *GetPinnedMsgsReq) GetConversationID() string {
 	if x != nil {
-		return x.MaxSeq
+		return x.ConversationID
 	}
-	return 0
+	return ""
 }
 
-type GetConversationsHasReadAndMaxSeqReq struct {
+func (x *GetPinnedMsgsReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type GetPinnedMsgsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID          string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	ConversationIDs []string                 `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
-	Pagination      *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	PinnedMsgs []*sdkws.PinnedMsg `protobuf:"bytes,1,rep,name=pinnedMsgs,proto3" json:"pinnedMsgs,omitempty"`
 }
 
-func (x *GetConversationsHasReadAndMaxSeqReq) Reset() {
-	*x = GetConversationsHasReadAndMaxSeqReq{}
+func (x *GetPinnedMsgsResp) Reset() {
+	*x = GetPinnedMsgsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2155,13 +2392,13 @@ func (x *GetConversationsHasReadAndMaxSeqReq) Reset() {
 	}
 }
 
-func (x *GetConversationsHasReadAndMaxSeqReq) String() string {
+func (x *GetPinnedMsgsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationsHasReadAndMaxSeqReq) ProtoMessage() {}
+func (*GetPinnedMsgsResp) ProtoMessage() {}
 
-func (x *GetConversationsHasReadAndMaxSeqReq) ProtoReflect() protoreflect.Message {
+func (x *GetPinnedMsgsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2173,43 +2410,38 @@ func (x *GetConversationsHasReadAndMaxSeqReq) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationsHasReadAndMaxSeqReq.ProtoReflect.Descriptor instead.
-func (*GetConversationsHasReadAndMaxSeqReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetPinnedMsgsResp.ProtoReflect.Descriptor instead.
+func (*GetPinnedMsgsResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *GetConversationsHasReadAndMaxSeqReq) GetUserID() string {
-	if x != nil {
-		return x.UserID
-	}
-	return ""
-}
-
-func (x *GetConversationsHasReadAndMaxSeqReq) GetConversationIDs() []string {
-	if x != nil {
-		return x.ConversationIDs
-	}
-	return nil
-}
-
-func (x *GetConversationsHasReadAndMaxSeqReq) GetPagination() *sdkws.RequestPagination {
+func (x *GetPinnedMsgsResp) GetPinnedMsgs() []*sdkws.PinnedMsg {
 	if x != nil {
-		return x.Pagination
+		return x.PinnedMsgs
 	}
 	return nil
 }
 
-type Seqs struct {
+type SearchMsgsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxSeq     int64 `protobuf:"varint,1,opt,name=maxSeq,proto3" json:"maxSeq,omitempty"`
-	HasReadSeq int64 `protobuf:"varint,2,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
-}
-
-func (x *Seqs) Reset() {
-	*x = Seqs{}
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// 关键词为空时只按其他条件过滤
+	Keyword string `protobuf:"bytes,2,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	// 为空时搜索用户的全部会话
+	ConversationIDs []string `protobuf:"bytes,3,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	SendIDs         []string `protobuf:"bytes,4,rep,name=sendIDs,proto3" json:"sendIDs,omitempty"`
+	ContentTypes    []int32  `protobuf:"varint,5,rep,packed,name=contentTypes,proto3" json:"contentTypes,omitempty"`
+	// 毫秒，0 为不限制
+	StartTime  int64                    `protobuf:"varint,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime    int64                    `protobuf:"varint,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,8,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *SearchMsgsReq) Reset() {
+	*x = SearchMsgsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2217,13 +2449,13 @@ func (x *Seqs) Reset() {
 	}
 }
 
-func (x *Seqs) String() string {
+func (x *SearchMsgsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Seqs) ProtoMessage() {}
+func (*SearchMsgsReq) ProtoMessage() {}
 
-func (x *Seqs) ProtoReflect() protoreflect.Message {
+func (x *SearchMsgsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2235,35 +2467,78 @@ func (x *Seqs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Seqs.ProtoReflect.Descriptor instead.
-func (*Seqs) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchMsgsReq.ProtoReflect.Descriptor instead.
+func (*SearchMsgsReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Seqs) GetMaxSeq() int64 {
+func (x *SearchMsgsReq) GetUserID() string {
 	if x != nil {
-		return x.MaxSeq
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *SearchMsgsReq) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *SearchMsgsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *SearchMsgsReq) GetSendIDs() []string {
+	if x != nil {
+		return x.SendIDs
+	}
+	return nil
+}
+
+func (x *SearchMsgsReq) GetContentTypes() []int32 {
+	if x != nil {
+		return x.ContentTypes
+	}
+	return nil
+}
+
+func (x *SearchMsgsReq) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
 	}
 	return 0
 }
 
-func (x *Seqs) GetHasReadSeq() int64 {
+func (x *SearchMsgsReq) GetEndTime() int64 {
 	if x != nil {
-		return x.HasReadSeq
+		return x.EndTime
 	}
 	return 0
 }
 
-type GetConversationsHasReadAndMaxSeqResp struct {
+func (x *SearchMsgsReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchedMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Seqs map[string]*Seqs `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ConversationID string         `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	MsgData        *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData,omitempty"`
 }
 
-func (x *GetConversationsHasReadAndMaxSeqResp) Reset() {
-	*x = GetConversationsHasReadAndMaxSeqResp{}
+func (x *SearchedMsg) Reset() {
+	*x = SearchedMsg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2271,13 +2546,13 @@ func (x *GetConversationsHasReadAndMaxSeqResp) Reset() {
 	}
 }
 
-func (x *GetConversationsHasReadAndMaxSeqResp) String() string {
+func (x *SearchedMsg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetConversationsHasReadAndMaxSeqResp) ProtoMessage() {}
+func (*SearchedMsg) ProtoMessage() {}
 
-func (x *GetConversationsHasReadAndMaxSeqResp) ProtoReflect() protoreflect.Message {
+func (x *SearchedMsg) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2289,32 +2564,36 @@ func (x *GetConversationsHasReadAndMaxSeqResp) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetConversationsHasReadAndMaxSeqResp.ProtoReflect.Descriptor instead.
-func (*GetConversationsHasReadAndMaxSeqResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchedMsg.ProtoReflect.Descriptor instead.
+func (*SearchedMsg) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetConversationsHasReadAndMaxSeqResp) GetSeqs() map[string]*Seqs {
+func (x *SearchedMsg) GetConversationID() string {
 	if x != nil {
-		return x.Seqs
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *SearchedMsg) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
 	}
 	return nil
 }
 
-type GetActiveUserReq struct {
+type SearchMsgsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start      int64                    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End        int64                    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Ase        bool                     `protobuf:"varint,3,opt,name=ase,proto3" json:"ase,omitempty"`
-	Group      bool                     `protobuf:"varint,4,opt,name=group,proto3" json:"group,omitempty"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Total int64          `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Msgs  []*SearchedMsg `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
 }
 
-func (x *GetActiveUserReq) Reset() {
-	*x = GetActiveUserReq{}
+func (x *SearchMsgsResp) Reset() {
+	*x = SearchMsgsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2322,13 +2601,13 @@ func (x *GetActiveUserReq) Reset() {
 	}
 }
 
-func (x *GetActiveUserReq) String() string {
+func (x *SearchMsgsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveUserReq) ProtoMessage() {}
+func (*SearchMsgsResp) ProtoMessage() {}
 
-func (x *GetActiveUserReq) ProtoReflect() protoreflect.Message {
+func (x *SearchMsgsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2340,57 +2619,37 @@ func (x *GetActiveUserReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveUserReq.ProtoReflect.Descriptor instead.
-func (*GetActiveUserReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use SearchMsgsResp.ProtoReflect.Descriptor instead.
+func (*SearchMsgsResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetActiveUserReq) GetStart() int64 {
-	if x != nil {
-		return x.Start
-	}
-	return 0
-}
-
-func (x *GetActiveUserReq) GetEnd() int64 {
+func (x *SearchMsgsResp) GetTotal() int64 {
 	if x != nil {
-		return x.End
+		return x.Total
 	}
 	return 0
 }
 
-func (x *GetActiveUserReq) GetAse() bool {
-	if x != nil {
-		return x.Ase
-	}
-	return false
-}
-
-func (x *GetActiveUserReq) GetGroup() bool {
-	if x != nil {
-		return x.Group
-	}
-	return false
-}
-
-func (x *GetActiveUserReq) GetPagination() *sdkws.RequestPagination {
+func (x *SearchMsgsResp) GetMsgs() []*SearchedMsg {
 	if x != nil {
-		return x.Pagination
+		return x.Msgs
 	}
 	return nil
 }
 
-type ActiveUser struct {
+type ForwardTarget struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  *sdkws.UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Count int64           `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SessionType int32  `protobuf:"varint,1,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
+	RecvID      string `protobuf:"bytes,2,opt,name=recvID,proto3" json:"recvID,omitempty"`
+	GroupID     string `protobuf:"bytes,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
 }
 
-func (x *ActiveUser) Reset() {
-	*x = ActiveUser{}
+func (x *ForwardTarget) Reset() {
+	*x = ForwardTarget{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2398,13 +2657,13 @@ func (x *ActiveUser) Reset() {
 	}
 }
 
-func (x *ActiveUser) String() string {
+func (x *ForwardTarget) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveUser) ProtoMessage() {}
+func (*ForwardTarget) ProtoMessage() {}
 
-func (x *ActiveUser) ProtoReflect() protoreflect.Message {
+func (x *ForwardTarget) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2416,38 +2675,50 @@ func (x *ActiveUser) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveUser.ProtoReflect.Descriptor instead.
-func (*ActiveUser) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardTarget.ProtoReflect.Descriptor instead.
+func (*ForwardTarget) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ActiveUser) GetUser() *sdkws.UserInfo {
+func (x *ForwardTarget) GetSessionType() int32 {
 	if x != nil {
-		return x.User
+		return x.SessionType
 	}
-	return nil
+	return 0
 }
 
-func (x *ActiveUser) GetCount() int64 {
+func (x *ForwardTarget) GetRecvID() string {
 	if x != nil {
-		return x.Count
+		return x.RecvID
 	}
-	return 0
+	return ""
 }
 
-type GetActiveUserResp struct {
+func (x *ForwardTarget) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+type ForwardMsgsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MsgCount  int64            `protobuf:"varint,1,opt,name=msgCount,proto3" json:"msgCount,omitempty"`
-	UserCount int64            `protobuf:"varint,2,opt,name=userCount,proto3" json:"userCount,omitempty"`
-	DateCount map[string]int64 `protobuf:"bytes,3,rep,name=dateCount,proto3" json:"dateCount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Users     []*ActiveUser    `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// 源会话
+	ConversationID string           `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64          `protobuf:"varint,3,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	Targets        []*ForwardTarget `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+	// 合并为一条聊天记录消息转发，否则逐条转发
+	IsMerge bool `protobuf:"varint,5,opt,name=isMerge,proto3" json:"isMerge,omitempty"`
+	// 合并转发的标题
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
 }
 
-func (x *GetActiveUserResp) Reset() {
-	*x = GetActiveUserResp{}
+func (x *ForwardMsgsReq) Reset() {
+	*x = ForwardMsgsReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2455,13 +2726,13 @@ func (x *GetActiveUserResp) Reset() {
 	}
 }
 
-func (x *GetActiveUserResp) String() string {
+func (x *ForwardMsgsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveUserResp) ProtoMessage() {}
+func (*ForwardMsgsReq) ProtoMessage() {}
 
-func (x *GetActiveUserResp) ProtoReflect() protoreflect.Message {
+func (x *ForwardMsgsReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2473,52 +2744,67 @@ func (x *GetActiveUserResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveUserResp.ProtoReflect.Descriptor instead.
-func (*GetActiveUserResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardMsgsReq.ProtoReflect.Descriptor instead.
+func (*ForwardMsgsReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetActiveUserResp) GetMsgCount() int64 {
+func (x *ForwardMsgsReq) GetUserID() string {
 	if x != nil {
-		return x.MsgCount
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetActiveUserResp) GetUserCount() int64 {
+func (x *ForwardMsgsReq) GetConversationID() string {
 	if x != nil {
-		return x.UserCount
+		return x.ConversationID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetActiveUserResp) GetDateCount() map[string]int64 {
+func (x *ForwardMsgsReq) GetSeqs() []int64 {
 	if x != nil {
-		return x.DateCount
+		return x.Seqs
 	}
 	return nil
 }
 
-func (x *GetActiveUserResp) GetUsers() []*ActiveUser {
+func (x *ForwardMsgsReq) GetTargets() []*ForwardTarget {
 	if x != nil {
-		return x.Users
+		return x.Targets
 	}
 	return nil
 }
 
-type GetActiveGroupReq struct {
+func (x *ForwardMsgsReq) GetIsMerge() bool {
+	if x != nil {
+		return x.IsMerge
+	}
+	return false
+}
+
+func (x *ForwardMsgsReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type ForwardResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Start      int64                    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
-	End        int64                    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
-	Ase        bool                     `protobuf:"varint,3,opt,name=ase,proto3" json:"ase,omitempty"`
-	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Target *ForwardTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Msgs   []*SendMsgResp `protobuf:"bytes,2,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// 0 为成功，失败时 msgs 为已发送成功的消息
+	ErrCode int32  `protobuf:"varint,3,opt,name=errCode,proto3" json:"errCode,omitempty"`
+	ErrMsg  string `protobuf:"bytes,4,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
 }
 
-func (x *GetActiveGroupReq) Reset() {
-	*x = GetActiveGroupReq{}
+func (x *ForwardResult) Reset() {
+	*x = ForwardResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2526,13 +2812,13 @@ func (x *GetActiveGroupReq) Reset() {
 	}
 }
 
-func (x *GetActiveGroupReq) String() string {
+func (x *ForwardResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveGroupReq) ProtoMessage() {}
+func (*ForwardResult) ProtoMessage() {}
 
-func (x *GetActiveGroupReq) ProtoReflect() protoreflect.Message {
+func (x *ForwardResult) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2544,50 +2830,49 @@ func (x *GetActiveGroupReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveGroupReq.ProtoReflect.Descriptor instead.
-func (*GetActiveGroupReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardResult.ProtoReflect.Descriptor instead.
+func (*ForwardResult) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *GetActiveGroupReq) GetStart() int64 {
+func (x *ForwardResult) GetTarget() *ForwardTarget {
 	if x != nil {
-		return x.Start
+		return x.Target
 	}
-	return 0
+	return nil
 }
 
-func (x *GetActiveGroupReq) GetEnd() int64 {
+func (x *ForwardResult) GetMsgs() []*SendMsgResp {
 	if x != nil {
-		return x.End
+		return x.Msgs
 	}
-	return 0
+	return nil
 }
 
-func (x *GetActiveGroupReq) GetAse() bool {
+func (x *ForwardResult) GetErrCode() int32 {
 	if x != nil {
-		return x.Ase
+		return x.ErrCode
 	}
-	return false
+	return 0
 }
 
-func (x *GetActiveGroupReq) GetPagination() *sdkws.RequestPagination {
+func (x *ForwardResult) GetErrMsg() string {
 	if x != nil {
-		return x.Pagination
+		return x.ErrMsg
 	}
-	return nil
+	return ""
 }
 
-type ActiveGroup struct {
+type ForwardMsgsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Group *sdkws.GroupInfo `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	Count int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Results []*ForwardResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *ActiveGroup) Reset() {
-	*x = ActiveGroup{}
+func (x *ForwardMsgsResp) Reset() {
+	*x = ForwardMsgsResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2595,13 +2880,13 @@ func (x *ActiveGroup) Reset() {
 	}
 }
 
-func (x *ActiveGroup) String() string {
+func (x *ForwardMsgsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveGroup) ProtoMessage() {}
+func (*ForwardMsgsResp) ProtoMessage() {}
 
-func (x *ActiveGroup) ProtoReflect() protoreflect.Message {
+func (x *ForwardMsgsResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2613,38 +2898,30 @@ func (x *ActiveGroup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveGroup.ProtoReflect.Descriptor instead.
-func (*ActiveGroup) Descriptor() ([]byte, []int) {
+// Deprecated: Use ForwardMsgsResp.ProtoReflect.Descriptor instead.
+func (*ForwardMsgsResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ActiveGroup) GetGroup() *sdkws.GroupInfo {
+func (x *ForwardMsgsResp) GetResults() []*ForwardResult {
 	if x != nil {
-		return x.Group
+		return x.Results
 	}
 	return nil
 }
 
-func (x *ActiveGroup) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-type GetActiveGroupResp struct {
+type MarkMsgsAsReadReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MsgCount   int64            `protobuf:"varint,1,opt,name=msgCount,proto3" json:"msgCount,omitempty"`
-	GroupCount int64            `protobuf:"varint,2,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
-	DateCount  map[string]int64 `protobuf:"bytes,3,rep,name=dateCount,proto3" json:"dateCount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Groups     []*ActiveGroup   `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	UserID         string  `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GetActiveGroupResp) Reset() {
-	*x = GetActiveGroupResp{}
+func (x *MarkMsgsAsReadReq) Reset() {
+	*x = MarkMsgsAsReadReq{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2652,13 +2929,13 @@ func (x *GetActiveGroupResp) Reset() {
 	}
 }
 
-func (x *GetActiveGroupResp) String() string {
+func (x *MarkMsgsAsReadReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActiveGroupResp) ProtoMessage() {}
+func (*MarkMsgsAsReadReq) ProtoMessage() {}
 
-func (x *GetActiveGroupResp) ProtoReflect() protoreflect.Message {
+func (x *MarkMsgsAsReadReq) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2670,54 +2947,40 @@ func (x *GetActiveGroupResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActiveGroupResp.ProtoReflect.Descriptor instead.
-func (*GetActiveGroupResp) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkMsgsAsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkMsgsAsReadReq) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetActiveGroupResp) GetMsgCount() int64 {
-	if x != nil {
-		return x.MsgCount
-	}
-	return 0
-}
-
-func (x *GetActiveGroupResp) GetGroupCount() int64 {
+func (x *MarkMsgsAsReadReq) GetConversationID() string {
 	if x != nil {
-		return x.GroupCount
+		return x.ConversationID
 	}
-	return 0
+	return ""
 }
 
-func (x *GetActiveGroupResp) GetDateCount() map[string]int64 {
+func (x *MarkMsgsAsReadReq) GetSeqs() []int64 {
 	if x != nil {
-		return x.DateCount
+		return x.Seqs
 	}
 	return nil
 }
 
-func (x *GetActiveGroupResp) GetGroups() []*ActiveGroup {
+func (x *MarkMsgsAsReadReq) GetUserID() string {
 	if x != nil {
-		return x.Groups
+		return x.UserID
 	}
-	return nil
+	return ""
 }
 
-type SearchMessageReq struct {
+type MarkMsgsAsReadResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	SendID      string                   `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID,omitempty"` //发送者ID
-	RecvID      string                   `protobuf:"bytes,2,opt,name=recvID,proto3" json:"recvID,omitempty"` //接收者ID
-	MsgType     int32                    `protobuf:"varint,3,opt,name=msgType,proto3" json:"msgType,omitempty"`
-	SendTime    string                   `protobuf:"bytes,4,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
-	SessionType int32                    `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
-	Pagination  *sdkws.RequestPagination `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *SearchMessageReq) Reset() {
-	*x = SearchMessageReq{}
+func (x *MarkMsgsAsReadResp) Reset() {
+	*x = MarkMsgsAsReadResp{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_msg_msgv3_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2725,13 +2988,13 @@ func (x *SearchMessageReq) Reset() {
 	}
 }
 
-func (x *SearchMessageReq) String() string {
+func (x *MarkMsgsAsReadResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMessageReq) ProtoMessage() {}
+func (*MarkMsgsAsReadResp) ProtoMessage() {}
 
-func (x *SearchMessageReq) ProtoReflect() protoreflect.Message {
+func (x *MarkMsgsAsReadResp) ProtoReflect() protoreflect.Message {
 	mi := &file_msg_msgv3_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2743,79 +3006,105 @@ func (x *SearchMessageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMessageReq.ProtoReflect.Descriptor instead.
-func (*SearchMessageReq) Descriptor() ([]byte, []int) {
+// Deprecated: Use MarkMsgsAsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkMsgsAsReadResp) Descriptor() ([]byte, []int) {
 	return file_msg_msgv3_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *SearchMessageReq) GetSendID() string {
-	if x != nil {
-		return x.SendID
+type MarkConversationAsReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	UserID         string  `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	HasReadSeq     int64   `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
+	Seqs           []int64 `protobuf:"varint,4,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+}
+
+func (x *MarkConversationAsReadReq) Reset() {
+	*x = MarkConversationAsReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *SearchMessageReq) GetRecvID() string {
-	if x != nil {
-		return x.RecvID
+func (x *MarkConversationAsReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkConversationAsReadReq) ProtoMessage() {}
+
+func (x *MarkConversationAsReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *SearchMessageReq) GetMsgType() int32 {
+// Deprecated: Use MarkConversationAsReadReq.ProtoReflect.Descriptor instead.
+func (*MarkConversationAsReadReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MarkConversationAsReadReq) GetConversationID() string {
 	if x != nil {
-		return x.MsgType
+		return x.ConversationID
 	}
-	return 0
+	return ""
 }
 
-func (x *SearchMessageReq) GetSendTime() string {
+func (x *MarkConversationAsReadReq) GetUserID() string {
 	if x != nil {
-		return x.SendTime
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *SearchMessageReq) GetSessionType() int32 {
+func (x *MarkConversationAsReadReq) GetHasReadSeq() int64 {
 	if x != nil {
-		return x.SessionType
+		return x.HasReadSeq
 	}
 	return 0
 }
 
-func (x *SearchMessageReq) GetPagination() *sdkws.RequestPagination {
+func (x *MarkConversationAsReadReq) GetSeqs() []int64 {
 	if x != nil {
-		return x.Pagination
+		return x.Seqs
 	}
 	return nil
 }
 
-type SearchMessageResp struct {
+type MarkConversationAsReadResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ChatLogs    []*ChatLog `protobuf:"bytes,1,rep,name=chatLogs,proto3" json:"chatLogs,omitempty"`
-	ChatLogsNum int32      `protobuf:"varint,2,opt,name=chatLogsNum,proto3" json:"chatLogsNum,omitempty"`
 }
 
-func (x *SearchMessageResp) Reset() {
-	*x = SearchMessageResp{}
+func (x *MarkConversationAsReadResp) Reset() {
+	*x = MarkConversationAsReadResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[52]
+		mi := &file_msg_msgv3_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SearchMessageResp) String() string {
+func (x *MarkConversationAsReadResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchMessageResp) ProtoMessage() {}
+func (*MarkConversationAsReadResp) ProtoMessage() {}
 
-func (x *SearchMessageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[52]
+func (x *MarkConversationAsReadResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2826,72 +3115,38 @@ func (x *SearchMessageResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchMessageResp.ProtoReflect.Descriptor instead.
-func (*SearchMessageResp) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *SearchMessageResp) GetChatLogs() []*ChatLog {
-	if x != nil {
-		return x.ChatLogs
-	}
-	return nil
+// Deprecated: Use MarkConversationAsReadResp.ProtoReflect.Descriptor instead.
+func (*MarkConversationAsReadResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *SearchMessageResp) GetChatLogsNum() int32 {
-	if x != nil {
-		return x.ChatLogsNum
-	}
-	return 0
-}
-
-type ChatLog struct {
+type SetConversationHasReadSeqReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ServerMsgID      string `protobuf:"bytes,1,opt,name=serverMsgID,proto3" json:"serverMsgID,omitempty"`
-	ClientMsgID      string `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID,omitempty"`
-	SendID           string `protobuf:"bytes,3,opt,name=sendID,proto3" json:"sendID,omitempty"`
-	RecvID           string `protobuf:"bytes,4,opt,name=recvID,proto3" json:"recvID,omitempty"`
-	GroupID          string `protobuf:"bytes,5,opt,name=groupID,proto3" json:"groupID,omitempty"`
-	RecvNickname     string `protobuf:"bytes,6,opt,name=recvNickname,proto3" json:"recvNickname,omitempty"`
-	SenderPlatformID int32  `protobuf:"varint,7,opt,name=senderPlatformID,proto3" json:"senderPlatformID,omitempty"`
-	SenderNickname   string `protobuf:"bytes,8,opt,name=senderNickname,proto3" json:"senderNickname,omitempty"`
-	SenderFaceURL    string `protobuf:"bytes,9,opt,name=senderFaceURL,proto3" json:"senderFaceURL,omitempty"`
-	GroupName        string `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
-	SessionType      int32  `protobuf:"varint,11,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
-	MsgFrom          int32  `protobuf:"varint,12,opt,name=msgFrom,proto3" json:"msgFrom,omitempty"`
-	ContentType      int32  `protobuf:"varint,13,opt,name=contentType,proto3" json:"contentType,omitempty"`
-	Content          string `protobuf:"bytes,14,opt,name=content,proto3" json:"content,omitempty"`
-	Status           int32  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`
-	SendTime         int64  `protobuf:"varint,16,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
-	CreateTime       int64  `protobuf:"varint,17,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	Ex               string `protobuf:"bytes,18,opt,name=ex,proto3" json:"ex,omitempty"`
-	GroupFaceURL     string `protobuf:"bytes,19,opt,name=groupFaceURL,proto3" json:"groupFaceURL,omitempty"`
-	GroupMemberCount uint32 `protobuf:"varint,20,opt,name=groupMemberCount,proto3" json:"groupMemberCount,omitempty"`
-	Seq              int64  `protobuf:"varint,21,opt,name=seq,proto3" json:"seq,omitempty"`
-	GroupOwner       string `protobuf:"bytes,22,opt,name=groupOwner,proto3" json:"groupOwner,omitempty"`
-	GroupType        int32  `protobuf:"varint,23,opt,name=groupType,proto3" json:"groupType,omitempty"`
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	UserID         string `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	HasReadSeq     int64  `protobuf:"varint,3,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
 }
 
-func (x *ChatLog) Reset() {
-	*x = ChatLog{}
+func (x *SetConversationHasReadSeqReq) Reset() {
+	*x = SetConversationHasReadSeqReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[53]
+		mi := &file_msg_msgv3_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChatLog) String() string {
+func (x *SetConversationHasReadSeqReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChatLog) ProtoMessage() {}
+func (*SetConversationHasReadSeqReq) ProtoMessage() {}
 
-func (x *ChatLog) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[53]
+func (x *SetConversationHasReadSeqReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2902,198 +3157,152 @@ func (x *ChatLog) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChatLog.ProtoReflect.Descriptor instead.
-func (*ChatLog) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{53}
-}
-
-func (x *ChatLog) GetServerMsgID() string {
-	if x != nil {
-		return x.ServerMsgID
-	}
-	return ""
-}
-
-func (x *ChatLog) GetClientMsgID() string {
-	if x != nil {
-		return x.ClientMsgID
-	}
-	return ""
-}
-
-func (x *ChatLog) GetSendID() string {
-	if x != nil {
-		return x.SendID
-	}
-	return ""
-}
-
-func (x *ChatLog) GetRecvID() string {
-	if x != nil {
-		return x.RecvID
-	}
-	return ""
+// Deprecated: Use SetConversationHasReadSeqReq.ProtoReflect.Descriptor instead.
+func (*SetConversationHasReadSeqReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ChatLog) GetGroupID() string {
+func (x *SetConversationHasReadSeqReq) GetConversationID() string {
 	if x != nil {
-		return x.GroupID
+		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *ChatLog) GetRecvNickname() string {
+func (x *SetConversationHasReadSeqReq) GetUserID() string {
 	if x != nil {
-		return x.RecvNickname
+		return x.UserID
 	}
 	return ""
 }
 
-func (x *ChatLog) GetSenderPlatformID() int32 {
+func (x *SetConversationHasReadSeqReq) GetHasReadSeq() int64 {
 	if x != nil {
-		return x.SenderPlatformID
+		return x.HasReadSeq
 	}
 	return 0
 }
 
-func (x *ChatLog) GetSenderNickname() string {
-	if x != nil {
-		return x.SenderNickname
-	}
-	return ""
-}
-
-func (x *ChatLog) GetSenderFaceURL() string {
-	if x != nil {
-		return x.SenderFaceURL
-	}
-	return ""
+type SetConversationHasReadSeqResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ChatLog) GetGroupName() string {
-	if x != nil {
-		return x.GroupName
+func (x *SetConversationHasReadSeqResp) Reset() {
+	*x = SetConversationHasReadSeqResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ChatLog) GetSessionType() int32 {
-	if x != nil {
-		return x.SessionType
-	}
-	return 0
+func (x *SetConversationHasReadSeqResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChatLog) GetMsgFrom() int32 {
-	if x != nil {
-		return x.MsgFrom
-	}
-	return 0
-}
+func (*SetConversationHasReadSeqResp) ProtoMessage() {}
 
-func (x *ChatLog) GetContentType() int32 {
-	if x != nil {
-		return x.ContentType
+func (x *SetConversationHasReadSeqResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ChatLog) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+// Deprecated: Use SetConversationHasReadSeqResp.ProtoReflect.Descriptor instead.
+func (*SetConversationHasReadSeqResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ChatLog) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
+type DeleteSyncOpt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ChatLog) GetSendTime() int64 {
-	if x != nil {
-		return x.SendTime
-	}
-	return 0
+	IsSyncSelf  bool `protobuf:"varint,3,opt,name=IsSyncSelf,proto3" json:"IsSyncSelf,omitempty"`
+	IsSyncOther bool `protobuf:"varint,4,opt,name=IsSyncOther,proto3" json:"IsSyncOther,omitempty"`
 }
 
-func (x *ChatLog) GetCreateTime() int64 {
-	if x != nil {
-		return x.CreateTime
+func (x *DeleteSyncOpt) Reset() {
+	*x = DeleteSyncOpt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ChatLog) GetEx() string {
-	if x != nil {
-		return x.Ex
-	}
-	return ""
+func (x *DeleteSyncOpt) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ChatLog) GetGroupFaceURL() string {
-	if x != nil {
-		return x.GroupFaceURL
-	}
-	return ""
-}
+func (*DeleteSyncOpt) ProtoMessage() {}
 
-func (x *ChatLog) GetGroupMemberCount() uint32 {
-	if x != nil {
-		return x.GroupMemberCount
+func (x *DeleteSyncOpt) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ChatLog) GetSeq() int64 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
+// Deprecated: Use DeleteSyncOpt.ProtoReflect.Descriptor instead.
+func (*DeleteSyncOpt) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ChatLog) GetGroupOwner() string {
+func (x *DeleteSyncOpt) GetIsSyncSelf() bool {
 	if x != nil {
-		return x.GroupOwner
+		return x.IsSyncSelf
 	}
-	return ""
+	return false
 }
 
-func (x *ChatLog) GetGroupType() int32 {
+func (x *DeleteSyncOpt) GetIsSyncOther() bool {
 	if x != nil {
-		return x.GroupType
+		return x.IsSyncOther
 	}
-	return 0
+	return false
 }
 
-type BatchSendMessageReq struct {
+type ClearConversationsMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RecvIDList []string       `protobuf:"bytes,1,rep,name=recvIDList,proto3" json:"recvIDList,omitempty"`
-	MsgData    *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	ConversationIDs []string       `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	UserID          string         `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	DeleteSyncOpt   *DeleteSyncOpt `protobuf:"bytes,3,opt,name=deleteSyncOpt,proto3" json:"deleteSyncOpt,omitempty"`
 }
 
-func (x *BatchSendMessageReq) Reset() {
-	*x = BatchSendMessageReq{}
+func (x *ClearConversationsMsgReq) Reset() {
+	*x = ClearConversationsMsgReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[54]
+		mi := &file_msg_msgv3_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchSendMessageReq) String() string {
+func (x *ClearConversationsMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchSendMessageReq) ProtoMessage() {}
+func (*ClearConversationsMsgReq) ProtoMessage() {}
 
-func (x *BatchSendMessageReq) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[54]
+func (x *ClearConversationsMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3104,48 +3313,55 @@ func (x *BatchSendMessageReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchSendMessageReq.ProtoReflect.Descriptor instead.
-func (*BatchSendMessageReq) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{54}
-}
-
-func (x *BatchSendMessageReq) GetRecvIDList() []string {
+// Deprecated: Use ClearConversationsMsgReq.ProtoReflect.Descriptor instead.
+func (*ClearConversationsMsgReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ClearConversationsMsgReq) GetConversationIDs() []string {
 	if x != nil {
-		return x.RecvIDList
+		return x.ConversationIDs
 	}
 	return nil
 }
 
-func (x *BatchSendMessageReq) GetMsgData() *sdkws.MsgData {
+func (x *ClearConversationsMsgReq) GetUserID() string {
 	if x != nil {
-		return x.MsgData
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *ClearConversationsMsgReq) GetDeleteSyncOpt() *DeleteSyncOpt {
+	if x != nil {
+		return x.DeleteSyncOpt
 	}
 	return nil
 }
 
-type BatchSendMessageResp struct {
+type ClearConversationsMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *BatchSendMessageResp) Reset() {
-	*x = BatchSendMessageResp{}
+func (x *ClearConversationsMsgResp) Reset() {
+	*x = ClearConversationsMsgResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[55]
+		mi := &file_msg_msgv3_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BatchSendMessageResp) String() string {
+func (x *ClearConversationsMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BatchSendMessageResp) ProtoMessage() {}
+func (*ClearConversationsMsgResp) ProtoMessage() {}
 
-func (x *BatchSendMessageResp) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[55]
+func (x *ClearConversationsMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3156,34 +3372,37 @@ func (x *BatchSendMessageResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BatchSendMessageResp.ProtoReflect.Descriptor instead.
-func (*BatchSendMessageResp) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ClearConversationsMsgResp.ProtoReflect.Descriptor instead.
+func (*ClearConversationsMsgResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{58}
 }
 
-type GetServerTimeReq struct {
+type UserClearAllMsgReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	UserID        string         `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	DeleteSyncOpt *DeleteSyncOpt `protobuf:"bytes,3,opt,name=deleteSyncOpt,proto3" json:"deleteSyncOpt,omitempty"`
 }
 
-func (x *GetServerTimeReq) Reset() {
-	*x = GetServerTimeReq{}
+func (x *UserClearAllMsgReq) Reset() {
+	*x = UserClearAllMsgReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[56]
+		mi := &file_msg_msgv3_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServerTimeReq) String() string {
+func (x *UserClearAllMsgReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServerTimeReq) ProtoMessage() {}
+func (*UserClearAllMsgReq) ProtoMessage() {}
 
-func (x *GetServerTimeReq) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[56]
+func (x *UserClearAllMsgReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3194,36 +3413,48 @@ func (x *GetServerTimeReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServerTimeReq.ProtoReflect.Descriptor instead.
-func (*GetServerTimeReq) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use UserClearAllMsgReq.ProtoReflect.Descriptor instead.
+func (*UserClearAllMsgReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{59}
 }
 
-type GetServerTimeResp struct {
+func (x *UserClearAllMsgReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *UserClearAllMsgReq) GetDeleteSyncOpt() *DeleteSyncOpt {
+	if x != nil {
+		return x.DeleteSyncOpt
+	}
+	return nil
+}
+
+type UserClearAllMsgResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ServerTime int64 `protobuf:"varint,1,opt,name=serverTime,proto3" json:"serverTime,omitempty"`
 }
 
-func (x *GetServerTimeResp) Reset() {
-	*x = GetServerTimeResp{}
+func (x *UserClearAllMsgResp) Reset() {
+	*x = UserClearAllMsgResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[57]
+		mi := &file_msg_msgv3_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetServerTimeResp) String() string {
+func (x *UserClearAllMsgResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServerTimeResp) ProtoMessage() {}
+func (*UserClearAllMsgResp) ProtoMessage() {}
 
-func (x *GetServerTimeResp) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[57]
+func (x *UserClearAllMsgResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3234,45 +3465,39 @@ func (x *GetServerTimeResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServerTimeResp.ProtoReflect.Descriptor instead.
-func (*GetServerTimeResp) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{57}
-}
-
-func (x *GetServerTimeResp) GetServerTime() int64 {
-	if x != nil {
-		return x.ServerTime
-	}
-	return 0
+// Deprecated: Use UserClearAllMsgResp.ProtoReflect.Descriptor instead.
+func (*UserClearAllMsgResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{60}
 }
 
-type MsgIdGetConversationsReq struct {
+type DeleteMsgsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromUserID string   `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
-	MsgId      []string `protobuf:"bytes,2,rep,name=msgId,proto3" json:"msgId,omitempty"`
-	IdType     int32    `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+	ConversationID string         `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64        `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+	UserID         string         `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	DeleteSyncOpt  *DeleteSyncOpt `protobuf:"bytes,4,opt,name=deleteSyncOpt,proto3" json:"deleteSyncOpt,omitempty"`
 }
 
-func (x *MsgIdGetConversationsReq) Reset() {
-	*x = MsgIdGetConversationsReq{}
+func (x *DeleteMsgsReq) Reset() {
+	*x = DeleteMsgsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[58]
+		mi := &file_msg_msgv3_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgIdGetConversationsReq) String() string {
+func (x *DeleteMsgsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgIdGetConversationsReq) ProtoMessage() {}
+func (*DeleteMsgsReq) ProtoMessage() {}
 
-func (x *MsgIdGetConversationsReq) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[58]
+func (x *DeleteMsgsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3283,57 +3508,62 @@ func (x *MsgIdGetConversationsReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgIdGetConversationsReq.ProtoReflect.Descriptor instead.
-func (*MsgIdGetConversationsReq) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use DeleteMsgsReq.ProtoReflect.Descriptor instead.
+func (*DeleteMsgsReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *MsgIdGetConversationsReq) GetFromUserID() string {
+func (x *DeleteMsgsReq) GetConversationID() string {
 	if x != nil {
-		return x.FromUserID
+		return x.ConversationID
 	}
 	return ""
 }
 
-func (x *MsgIdGetConversationsReq) GetMsgId() []string {
+func (x *DeleteMsgsReq) GetSeqs() []int64 {
 	if x != nil {
-		return x.MsgId
+		return x.Seqs
 	}
 	return nil
 }
 
-func (x *MsgIdGetConversationsReq) GetIdType() int32 {
+func (x *DeleteMsgsReq) GetUserID() string {
 	if x != nil {
-		return x.IdType
+		return x.UserID
 	}
-	return 0
+	return ""
 }
 
-type MsgIdGetConversationsResp struct {
+func (x *DeleteMsgsReq) GetDeleteSyncOpt() *DeleteSyncOpt {
+	if x != nil {
+		return x.DeleteSyncOpt
+	}
+	return nil
+}
+
+type DeleteMsgsResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ConversationIDs map[string]string `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *MsgIdGetConversationsResp) Reset() {
-	*x = MsgIdGetConversationsResp{}
+func (x *DeleteMsgsResp) Reset() {
+	*x = DeleteMsgsResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[59]
+		mi := &file_msg_msgv3_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgIdGetConversationsResp) String() string {
+func (x *DeleteMsgsResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgIdGetConversationsResp) ProtoMessage() {}
+func (*DeleteMsgsResp) ProtoMessage() {}
 
-func (x *MsgIdGetConversationsResp) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[59]
+func (x *DeleteMsgsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3344,44 +3574,37 @@ func (x *MsgIdGetConversationsResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgIdGetConversationsResp.ProtoReflect.Descriptor instead.
-func (*MsgIdGetConversationsResp) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *MsgIdGetConversationsResp) GetConversationIDs() map[string]string {
-	if x != nil {
-		return x.ConversationIDs
-	}
-	return nil
+// Deprecated: Use DeleteMsgsResp.ProtoReflect.Descriptor instead.
+func (*DeleteMsgsResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{62}
 }
 
-type MsgIdGetConversationSeqReq struct {
+type DeleteMsgPhysicalReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MsgId  string `protobuf:"bytes,1,opt,name=msgId,proto3" json:"msgId,omitempty"`
-	IdType int32  `protobuf:"varint,2,opt,name=idType,proto3" json:"idType,omitempty"`
+	ConversationIDs []string `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	Timestamp       int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
-func (x *MsgIdGetConversationSeqReq) Reset() {
-	*x = MsgIdGetConversationSeqReq{}
+func (x *DeleteMsgPhysicalReq) Reset() {
+	*x = DeleteMsgPhysicalReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[60]
+		mi := &file_msg_msgv3_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgIdGetConversationSeqReq) String() string {
+func (x *DeleteMsgPhysicalReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgIdGetConversationSeqReq) ProtoMessage() {}
+func (*DeleteMsgPhysicalReq) ProtoMessage() {}
 
-func (x *MsgIdGetConversationSeqReq) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[60]
+func (x *DeleteMsgPhysicalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3392,51 +3615,48 @@ func (x *MsgIdGetConversationSeqReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgIdGetConversationSeqReq.ProtoReflect.Descriptor instead.
-func (*MsgIdGetConversationSeqReq) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DeleteMsgPhysicalReq.ProtoReflect.Descriptor instead.
+func (*DeleteMsgPhysicalReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *MsgIdGetConversationSeqReq) GetMsgId() string {
+func (x *DeleteMsgPhysicalReq) GetConversationIDs() []string {
 	if x != nil {
-		return x.MsgId
+		return x.ConversationIDs
 	}
-	return ""
+	return nil
 }
 
-func (x *MsgIdGetConversationSeqReq) GetIdType() int32 {
+func (x *DeleteMsgPhysicalReq) GetTimestamp() int64 {
 	if x != nil {
-		return x.IdType
+		return x.Timestamp
 	}
 	return 0
 }
 
-type MsgIdGetConversationSeqResp struct {
+type DeleteMsgPhysicalResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Seq            int64  `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
-	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
 }
 
-func (x *MsgIdGetConversationSeqResp) Reset() {
-	*x = MsgIdGetConversationSeqResp{}
+func (x *DeleteMsgPhysicalResp) Reset() {
+	*x = DeleteMsgPhysicalResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[61]
+		mi := &file_msg_msgv3_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgIdGetConversationSeqResp) String() string {
+func (x *DeleteMsgPhysicalResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgIdGetConversationSeqResp) ProtoMessage() {}
+func (*DeleteMsgPhysicalResp) ProtoMessage() {}
 
-func (x *MsgIdGetConversationSeqResp) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[61]
+func (x *DeleteMsgPhysicalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3447,52 +3667,129 @@ func (x *MsgIdGetConversationSeqResp) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgIdGetConversationSeqResp.ProtoReflect.Descriptor instead.
-func (*MsgIdGetConversationSeqResp) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use DeleteMsgPhysicalResp.ProtoReflect.Descriptor instead.
+func (*DeleteMsgPhysicalResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *MsgIdGetConversationSeqResp) GetSeq() int64 {
-	if x != nil {
-		return x.Seq
-	}
-	return 0
+type DeleteMsgPhysicalBySeqReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
 }
 
-func (x *MsgIdGetConversationSeqResp) GetConversationID() string {
+func (x *DeleteMsgPhysicalBySeqReq) Reset() {
+	*x = DeleteMsgPhysicalBySeqReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteMsgPhysicalBySeqReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMsgPhysicalBySeqReq) ProtoMessage() {}
+
+func (x *DeleteMsgPhysicalBySeqReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMsgPhysicalBySeqReq.ProtoReflect.Descriptor instead.
+func (*DeleteMsgPhysicalBySeqReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteMsgPhysicalBySeqReq) GetConversationID() string {
 	if x != nil {
 		return x.ConversationID
 	}
 	return ""
 }
 
-type ReadSeqReq struct {
+func (x *DeleteMsgPhysicalBySeqReq) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type DeleteMsgPhysicalBySeqResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+}
 
-	Seq            int64  `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
-	ConversationID string `protobuf:"bytes,2,opt,name=ConversationID,proto3" json:"ConversationID,omitempty"`
-	UserID         string `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+func (x *DeleteMsgPhysicalBySeqResp) Reset() {
+	*x = DeleteMsgPhysicalBySeqResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *ReadSeqReq) Reset() {
-	*x = ReadSeqReq{}
+func (x *DeleteMsgPhysicalBySeqResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMsgPhysicalBySeqResp) ProtoMessage() {}
+
+func (x *DeleteMsgPhysicalBySeqResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMsgPhysicalBySeqResp.ProtoReflect.Descriptor instead.
+func (*DeleteMsgPhysicalBySeqResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{66}
+}
+
+type GetMaxSeqsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+}
+
+func (x *GetMaxSeqsReq) Reset() {
+	*x = GetMaxSeqsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[62]
+		mi := &file_msg_msgv3_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ReadSeqReq) String() string {
+func (x *GetMaxSeqsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadSeqReq) ProtoMessage() {}
+func (*GetMaxSeqsReq) ProtoMessage() {}
 
-func (x *ReadSeqReq) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[62]
+func (x *GetMaxSeqsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3503,57 +3800,146 @@ func (x *ReadSeqReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadSeqReq.ProtoReflect.Descriptor instead.
-func (*ReadSeqReq) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use GetMaxSeqsReq.ProtoReflect.Descriptor instead.
+func (*GetMaxSeqsReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ReadSeqReq) GetSeq() int64 {
+func (x *GetMaxSeqsReq) GetConversationIDs() []string {
 	if x != nil {
-		return x.Seq
+		return x.ConversationIDs
 	}
-	return 0
+	return nil
 }
 
-func (x *ReadSeqReq) GetConversationID() string {
+type GetMinSeqsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+}
+
+func (x *GetMinSeqsReq) Reset() {
+	*x = GetMinSeqsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMinSeqsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMinSeqsReq) ProtoMessage() {}
+
+func (x *GetMinSeqsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMinSeqsReq.ProtoReflect.Descriptor instead.
+func (*GetMinSeqsReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetMinSeqsReq) GetConversationIDs() []string {
 	if x != nil {
-		return x.ConversationID
+		return x.ConversationIDs
 	}
-	return ""
+	return nil
 }
 
-func (x *ReadSeqReq) GetUserID() string {
+type GetHasReadSeqsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ConversationIDs []string `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+}
+
+func (x *GetHasReadSeqsReq) Reset() {
+	*x = GetHasReadSeqsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHasReadSeqsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHasReadSeqsReq) ProtoMessage() {}
+
+func (x *GetHasReadSeqsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHasReadSeqsReq.ProtoReflect.Descriptor instead.
+func (*GetHasReadSeqsReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetHasReadSeqsReq) GetUserID() string {
 	if x != nil {
 		return x.UserID
 	}
 	return ""
 }
 
-type MarkReadReq struct {
+func (x *GetHasReadSeqsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type SeqsInfoResp struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MarkReadReq []*ReadSeqReq `protobuf:"bytes,1,rep,name=markReadReq,proto3" json:"markReadReq,omitempty"`
+	MaxSeqs map[string]int64 `protobuf:"bytes,1,rep,name=maxSeqs,proto3" json:"maxSeqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	MinSeqs map[string]int64 `protobuf:"bytes,2,rep,name=minSeqs,proto3" json:"minSeqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-func (x *MarkReadReq) Reset() {
-	*x = MarkReadReq{}
+func (x *SeqsInfoResp) Reset() {
+	*x = SeqsInfoResp{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_msg_msgv3_proto_msgTypes[63]
+		mi := &file_msg_msgv3_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MarkReadReq) String() string {
+func (x *SeqsInfoResp) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MarkReadReq) ProtoMessage() {}
+func (*SeqsInfoResp) ProtoMessage() {}
 
-func (x *MarkReadReq) ProtoReflect() protoreflect.Message {
-	mi := &file_msg_msgv3_proto_msgTypes[63]
+func (x *SeqsInfoResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3564,826 +3950,3508 @@ func (x *MarkReadReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MarkReadReq.ProtoReflect.Descriptor instead.
-func (*MarkReadReq) Descriptor() ([]byte, []int) {
-	return file_msg_msgv3_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use SeqsInfoResp.ProtoReflect.Descriptor instead.
+func (*SeqsInfoResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *MarkReadReq) GetMarkReadReq() []*ReadSeqReq {
+func (x *SeqsInfoResp) GetMaxSeqs() map[string]int64 {
 	if x != nil {
-		return x.MarkReadReq
+		return x.MaxSeqs
 	}
 	return nil
 }
 
-var File_msg_msgv3_proto protoreflect.FileDescriptor
+func (x *SeqsInfoResp) GetMinSeqs() map[string]int64 {
+	if x != nil {
+		return x.MinSeqs
+	}
+	return nil
+}
 
-var file_msg_msgv3_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x2f, 0x6d, 0x73, 0x67, 0x76, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x54, 0x6f, 0x4d, 0x51, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x6d,
-	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
-	0x74, 0x61, 0x22, 0x44, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44,
-	0x42, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x70, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68,
-	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x51, 0x12, 0x35, 0x0a, 0x07, 0x6d,
-	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x4d,
-	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x42, 0x79, 0x4d,
-	0x51, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x78, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71,
-	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
-	0x61, 0x78, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x16, 0x0a, 0x06, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x53, 0x65,
-	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x22,
-	0x43, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
-	0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67,
-	0x44, 0x61, 0x74, 0x61, 0x22, 0x6d, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d,
-	0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73,
-	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x71, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x76, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x42, 0x79, 0x4d, 0x51, 0x12, 0x37, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x0c, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
-	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x22, 0x0d, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x4d, 0x73,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x70, 0x0a, 0x0c, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10,
-	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x6f,
-	0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x67, 0x0a, 0x11, 0x4d, 0x61, 0x72,
-	0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x22, 0x14, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8f, 0x01, 0x0a, 0x19, 0x4d, 0x61, 0x72,
-	0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x04,
-	0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x4d, 0x61,
-	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7e, 0x0a, 0x1c, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x52,
-	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61,
-	0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0x1f, 0x0a, 0x1d, 0x53, 0x65, 0x74, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x22, 0x51, 0x0a, 0x0d, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73,
-	0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x6c, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x6c, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73,
-	0x53, 0x79, 0x6e, 0x63, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x22, 0xa3, 0x01, 0x0a,
-	0x18, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x0d, 0x64,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63,
-	0x4f, 0x70, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f,
-	0x70, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
-	0x73, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x4d,
-	0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a,
-	0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79,
-	0x6e, 0x63, 0x4f, 0x70, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e,
-	0x63, 0x4f, 0x70, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61,
-	0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0xaa, 0x01, 0x0a, 0x0d,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
-	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f,
-	0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5e, 0x0a, 0x14, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x22, 0x57, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67,
-	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71,
-	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x22, 0x1c, 0x0a, 0x1a,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
-	0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x22, 0x39, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53,
-	0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
-	0x22, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65,
-	0x71, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x94, 0x02, 0x0a, 0x0c, 0x53, 0x65, 0x71, 0x73,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53,
-	0x65, 0x71, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71,
-	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x12,
-	0x45, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x2e, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d,
-	0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd7,
-	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a,
-	0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x53, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x65,
-	0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x1a, 0x3a, 0x0a, 0x0c,
-	0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74,
-	0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x44,
-	0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
-	0x73, 0x1a, 0x58, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x42, 0x0a, 0x18, 0x47,
-	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61,
-	0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22,
-	0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16, 0x0a, 0x06,
-	0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61,
-	0x78, 0x53, 0x65, 0x71, 0x22, 0xae, 0x01, 0x0a, 0x23, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x45,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x04, 0x53, 0x65, 0x71, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d,
-	0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64,
-	0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65,
-	0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0xcd, 0x01, 0x0a, 0x24, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54,
-	0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71,
-	0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04,
-	0x73, 0x65, 0x71, 0x73, 0x1a, 0x4f, 0x0a, 0x09, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65,
-	0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x03, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61,
-	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x54, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b,
-	0x77, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a,
-	0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65,
-	0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73,
-	0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x2e,
-	0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x05, 0x75, 0x73, 0x65,
-	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x3c, 0x0a,
-	0x0e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x94, 0x01, 0x0a, 0x11,
-	0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65,
-	0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73,
-	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x12, 0x33, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x98, 0x02, 0x0a,
-	0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x51, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x33, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x44, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65,
-	0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
-	0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6c, 0x0a, 0x11, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x08, 0x63,
-	0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4c,
-	0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x68,
-	0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x22, 0xcf, 0x05, 0x0a, 0x07, 0x43, 0x68,
-	0x61, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d,
-	0x73, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e,
-	0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49,
-	0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f,
-	0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75,
-	0x70, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x76, 0x4e,
-	0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
-	0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63,
-	0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x55, 0x52,
-	0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x65,
-	0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x67,
-	0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x13, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12,
-	0x2a, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70,
-	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73,
-	0x65, 0x71, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1e, 0x0a,
-	0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x22, 0x6c, 0x0a, 0x13, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x16, 0x0a, 0x14, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69,
-	0x6d, 0x65, 0x52, 0x65, 0x71, 0x22, 0x33, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x68, 0x0a, 0x18, 0x4d, 0x73,
-	0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d,
-	0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x1a, 0x42,
-	0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x4a, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71,
-	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0x57,
-	0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x10, 0x0a,
-	0x03, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x53, 0x65, 0x71, 0x12,
-	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x5e, 0x0a, 0x0a, 0x52, 0x65, 0x61, 0x64, 0x53,
-	0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x53, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x0b, 0x4d, 0x61, 0x72, 0x6b, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x65, 0x71, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x52, 0x0b, 0x6d, 0x61, 0x72, 0x6b, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x32, 0x8c, 0x15, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x50,
-	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x20, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b,
-	0x77, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70,
-	0x12, 0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x12, 0x1f,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x1a,
-	0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
-	0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x4d, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x12, 0x1f, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
-	0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x73,
-	0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65,
-	0x71, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42,
-	0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73,
-	0x12, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
-	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52,
-	0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x68, 0x0a, 0x11, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79,
-	0x53, 0x65, 0x71, 0x73, 0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
-	0x6b, 0x77, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42,
-	0x79, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65,
-	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x46, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1c,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
-	0x67, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a, 0x15, 0x43,
-	0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x4d, 0x73, 0x67, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e,
-	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71,
-	0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a,
-	0x0f, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67,
-	0x12, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c,
-	0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a,
-	0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73,
-	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69,
-	0x63, 0x61, 0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53,
-	0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d,
-	0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x52,
-	0x65, 0x73, 0x70, 0x12, 0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67,
-	0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71,
-	0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79,
-	0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x53, 0x65, 0x74,
-	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d,
-	0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x4c, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a,
-	0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12,
-	0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
-	0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73,
-	0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x16, 0x4d, 0x61,
-	0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76,
-	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
-	0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12,
-	0x7c, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x12, 0x2e, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x2f, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
-	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x91, 0x01,
-	0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53,
-	0x65, 0x71, 0x12, 0x35, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64,
-	0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x36, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73,
-	0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73,
-	0x70, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x23, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
-	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x70, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f,
-	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x4f, 0x70,
-	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d,
-	0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64,
-	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x12,
-	0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
-	0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e,
-	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
-	0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x08,
-	0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x73, 0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61,
-	0x64, 0x52, 0x65, 0x73, 0x70, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+type GetMsgByConversationIDsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationIDs []string         `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	MaxSeqs         map[string]int64 `protobuf:"bytes,2,rep,name=maxSeqs,proto3" json:"maxSeqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
-var (
-	file_msg_msgv3_proto_rawDescOnce sync.Once
-	file_msg_msgv3_proto_rawDescData = file_msg_msgv3_proto_rawDesc
-)
+func (x *GetMsgByConversationIDsReq) Reset() {
+	*x = GetMsgByConversationIDsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_msg_msgv3_proto_rawDescGZIP() []byte {
-	file_msg_msgv3_proto_rawDescOnce.Do(func() {
-		file_msg_msgv3_proto_rawDescData = protoimpl.X.CompressGZIP(file_msg_msgv3_proto_rawDescData)
-	})
-	return file_msg_msgv3_proto_rawDescData
+func (x *GetMsgByConversationIDsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_msg_msgv3_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
-var file_msg_msgv3_proto_goTypes = []interface{}{
-	(*MsgDataToMQ)(nil),                          // 0: OpenIMServer.msg.MsgDataToMQ
-	(*MsgDataToDB)(nil),                          // 1: OpenIMServer.msg.MsgDataToDB
-	(*PushMsgDataToMQ)(nil),                      // 2: OpenIMServer.msg.PushMsgDataToMQ
-	(*MsgDataToMongoByMQ)(nil),                   // 3: OpenIMServer.msg.MsgDataToMongoByMQ
-	(*GetMaxAndMinSeqReq)(nil),                   // 4: OpenIMServer.msg.GetMaxAndMinSeqReq
-	(*GetMaxAndMinSeqResp)(nil),                  // 5: OpenIMServer.msg.GetMaxAndMinSeqResp
-	(*SendMsgReq)(nil),                           // 6: OpenIMServer.msg.SendMsgReq
-	(*SendMsgResp)(nil),                          // 7: OpenIMServer.msg.SendMsgResp
-	(*SetSendMsgStatusReq)(nil),                  // 8: OpenIMServer.msg.SetSendMsgStatusReq
-	(*SetSendMsgStatusResp)(nil),                 // 9: OpenIMServer.msg.SetSendMsgStatusResp
-	(*GetSendMsgStatusReq)(nil),                  // 10: OpenIMServer.msg.GetSendMsgStatusReq
-	(*GetSendMsgStatusResp)(nil),                 // 11: OpenIMServer.msg.GetSendMsgStatusResp
-	(*MsgDataToModifyByMQ)(nil),                  // 12: OpenIMServer.msg.MsgDataToModifyByMQ
-	(*DelMsgsReq)(nil),                           // 13: OpenIMServer.msg.DelMsgsReq
-	(*DelMsgsResp)(nil),                          // 14: OpenIMServer.msg.DelMsgsResp
-	(*RevokeMsgReq)(nil),                         // 15: OpenIMServer.msg.RevokeMsgReq
-	(*RevokeMsgResp)(nil),                        // 16: OpenIMServer.msg.RevokeMsgResp
-	(*MarkMsgsAsReadReq)(nil),                    // 17: OpenIMServer.msg.MarkMsgsAsReadReq
-	(*MarkMsgsAsReadResp)(nil),                   // 18: OpenIMServer.msg.MarkMsgsAsReadResp
-	(*MarkConversationAsReadReq)(nil),            // 19: OpenIMServer.msg.MarkConversationAsReadReq
-	(*MarkConversationAsReadResp)(nil),           // 20: OpenIMServer.msg.MarkConversationAsReadResp
-	(*SetConversationHasReadSeqReq)(nil),         // 21: OpenIMServer.msg.SetConversationHasReadSeqReq
-	(*SetConversationHasReadSeqResp)(nil),        // 22: OpenIMServer.msg.SetConversationHasReadSeqResp
-	(*DeleteSyncOpt)(nil),                        // 23: OpenIMServer.msg.DeleteSyncOpt
-	(*ClearConversationsMsgReq)(nil),             // 24: OpenIMServer.msg.ClearConversationsMsgReq
-	(*ClearConversationsMsgResp)(nil),            // 25: OpenIMServer.msg.ClearConversationsMsgResp
-	(*UserClearAllMsgReq)(nil),                   // 26: OpenIMServer.msg.UserClearAllMsgReq
-	(*UserClearAllMsgResp)(nil),                  // 27: OpenIMServer.msg.UserClearAllMsgResp
-	(*DeleteMsgsReq)(nil),                        // 28: OpenIMServer.msg.DeleteMsgsReq
-	(*DeleteMsgsResp)(nil),                       // 29: OpenIMServer.msg.DeleteMsgsResp
-	(*DeleteMsgPhysicalReq)(nil),                 // 30: OpenIMServer.msg.DeleteMsgPhysicalReq
-	(*DeleteMsgPhysicalResp)(nil),                // 31: OpenIMServer.msg.DeleteMsgPhysicalResp
-	(*DeleteMsgPhysicalBySeqReq)(nil),            // 32: OpenIMServer.msg.DeleteMsgPhysicalBySeqReq
-	(*DeleteMsgPhysicalBySeqResp)(nil),           // 33: OpenIMServer.msg.DeleteMsgPhysicalBySeqResp
-	(*GetMaxSeqsReq)(nil),                        // 34: OpenIMServer.msg.GetMaxSeqsReq
-	(*GetMinSeqsReq)(nil),                        // 35: OpenIMServer.msg.GetMinSeqsReq
-	(*GetHasReadSeqsReq)(nil),                    // 36: OpenIMServer.msg.GetHasReadSeqsReq
-	(*SeqsInfoResp)(nil),                         // 37: OpenIMServer.msg.SeqsInfoResp
-	(*GetMsgByConversationIDsReq)(nil),           // 38: OpenIMServer.msg.GetMsgByConversationIDsReq
-	(*GetMsgByConversationIDsResp)(nil),          // 39: OpenIMServer.msg.GetMsgByConversationIDsResp
-	(*GetConversationMaxSeqReq)(nil),             // 40: OpenIMServer.msg.GetConversationMaxSeqReq
-	(*GetConversationMaxSeqResp)(nil),            // 41: OpenIMServer.msg.GetConversationMaxSeqResp
-	(*GetConversationsHasReadAndMaxSeqReq)(nil),  // 42: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqReq
-	(*Seqs)(nil),                                 // 43: OpenIMServer.msg.Seqs
-	(*GetConversationsHasReadAndMaxSeqResp)(nil), // 44: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp
-	(*GetActiveUserReq)(nil),                     // 45: OpenIMServer.msg.GetActiveUserReq
-	(*ActiveUser)(nil),                           // 46: OpenIMServer.msg.ActiveUser
-	(*GetActiveUserResp)(nil),                    // 47: OpenIMServer.msg.GetActiveUserResp
-	(*GetActiveGroupReq)(nil),                    // 48: OpenIMServer.msg.GetActiveGroupReq
-	(*ActiveGroup)(nil),                          // 49: OpenIMServer.msg.ActiveGroup
-	(*GetActiveGroupResp)(nil),                   // 50: OpenIMServer.msg.GetActiveGroupResp
-	(*SearchMessageReq)(nil),                     // 51: OpenIMServer.msg.SearchMessageReq
-	(*SearchMessageResp)(nil),                    // 52: OpenIMServer.msg.SearchMessageResp
-	(*ChatLog)(nil),                              // 53: OpenIMServer.msg.ChatLog
-	(*BatchSendMessageReq)(nil),                  // 54: OpenIMServer.msg.batchSendMessageReq
-	(*BatchSendMessageResp)(nil),                 // 55: OpenIMServer.msg.batchSendMessageResp
-	(*GetServerTimeReq)(nil),                     // 56: OpenIMServer.msg.GetServerTimeReq
-	(*GetServerTimeResp)(nil),                    // 57: OpenIMServer.msg.GetServerTimeResp
-	(*MsgIdGetConversationsReq)(nil),             // 58: OpenIMServer.msg.MsgIdGetConversationsReq
-	(*MsgIdGetConversationsResp)(nil),            // 59: OpenIMServer.msg.MsgIdGetConversationsResp
-	(*MsgIdGetConversationSeqReq)(nil),           // 60: OpenIMServer.msg.MsgIdGetConversationSeqReq
-	(*MsgIdGetConversationSeqResp)(nil),          // 61: OpenIMServer.msg.MsgIdGetConversationSeqResp
-	(*ReadSeqReq)(nil),                           // 62: OpenIMServer.msg.ReadSeqReq
-	(*MarkReadReq)(nil),                          // 63: OpenIMServer.msg.MarkReadReq
-	nil,                                          // 64: OpenIMServer.msg.SeqsInfoResp.MaxSeqsEntry
-	nil,                                          // 65: OpenIMServer.msg.SeqsInfoResp.MinSeqsEntry
-	nil,                                          // 66: OpenIMServer.msg.GetMsgByConversationIDsReq.MaxSeqsEntry
-	nil,                                          // 67: OpenIMServer.msg.GetMsgByConversationIDsResp.MsgDatasEntry
-	nil,                                          // 68: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.SeqsEntry
-	nil,                                          // 69: OpenIMServer.msg.GetActiveUserResp.DateCountEntry
-	nil,                                          // 70: OpenIMServer.msg.GetActiveGroupResp.DateCountEntry
-	nil,                                          // 71: OpenIMServer.msg.MsgIdGetConversationsResp.ConversationIDsEntry
-	(*sdkws.MsgData)(nil),                        // 72: OpenIMServer.sdkws.MsgData
-	(*sdkws.RequestPagination)(nil),              // 73: OpenIMServer.sdkws.RequestPagination
-	(*sdkws.UserInfo)(nil),                       // 74: OpenIMServer.sdkws.UserInfo
-	(*sdkws.GroupInfo)(nil),                      // 75: OpenIMServer.sdkws.GroupInfo
-	(*sdkws.GetMaxSeqReq)(nil),                   // 76: OpenIMServer.sdkws.GetMaxSeqReq
-	(*sdkws.PullMessageBySeqsReq)(nil),           // 77: OpenIMServer.sdkws.PullMessageBySeqsReq
-	(*sdkws.GetMaxSeqResp)(nil),                  // 78: OpenIMServer.sdkws.GetMaxSeqResp
-	(*sdkws.PullMessageBySeqsResp)(nil),          // 79: OpenIMServer.sdkws.PullMessageBySeqsResp
+func (*GetMsgByConversationIDsReq) ProtoMessage() {}
+
+func (x *GetMsgByConversationIDsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_msg_msgv3_proto_depIdxs = []int32{
-	72, // 0: OpenIMServer.msg.MsgDataToMQ.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	72, // 1: OpenIMServer.msg.MsgDataToDB.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	72, // 2: OpenIMServer.msg.PushMsgDataToMQ.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	72, // 3: OpenIMServer.msg.MsgDataToMongoByMQ.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	72, // 4: OpenIMServer.msg.SendMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	72, // 5: OpenIMServer.msg.MsgDataToModifyByMQ.messages:type_name -> OpenIMServer.sdkws.MsgData
-	23, // 6: OpenIMServer.msg.ClearConversationsMsgReq.deleteSyncOpt:type_name -> OpenIMServer.msg.DeleteSyncOpt
-	23, // 7: OpenIMServer.msg.UserClearAllMsgReq.deleteSyncOpt:type_name -> OpenIMServer.msg.DeleteSyncOpt
-	23, // 8: OpenIMServer.msg.DeleteMsgsReq.deleteSyncOpt:type_name -> OpenIMServer.msg.DeleteSyncOpt
-	64, // 9: OpenIMServer.msg.SeqsInfoResp.maxSeqs:type_name -> OpenIMServer.msg.SeqsInfoResp.MaxSeqsEntry
-	65, // 10: OpenIMServer.msg.SeqsInfoResp.minSeqs:type_name -> OpenIMServer.msg.SeqsInfoResp.MinSeqsEntry
-	66, // 11: OpenIMServer.msg.GetMsgByConversationIDsReq.maxSeqs:type_name -> OpenIMServer.msg.GetMsgByConversationIDsReq.MaxSeqsEntry
-	67, // 12: OpenIMServer.msg.GetMsgByConversationIDsResp.msgDatas:type_name -> OpenIMServer.msg.GetMsgByConversationIDsResp.MsgDatasEntry
-	73, // 13: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	68, // 14: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.seqs:type_name -> OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.SeqsEntry
-	73, // 15: OpenIMServer.msg.GetActiveUserReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	74, // 16: OpenIMServer.msg.ActiveUser.user:type_name -> OpenIMServer.sdkws.UserInfo
-	69, // 17: OpenIMServer.msg.GetActiveUserResp.dateCount:type_name -> OpenIMServer.msg.GetActiveUserResp.DateCountEntry
-	46, // 18: OpenIMServer.msg.GetActiveUserResp.users:type_name -> OpenIMServer.msg.ActiveUser
-	73, // 19: OpenIMServer.msg.GetActiveGroupReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	75, // 20: OpenIMServer.msg.ActiveGroup.group:type_name -> OpenIMServer.sdkws.GroupInfo
-	70, // 21: OpenIMServer.msg.GetActiveGroupResp.dateCount:type_name -> OpenIMServer.msg.GetActiveGroupResp.DateCountEntry
-	49, // 22: OpenIMServer.msg.GetActiveGroupResp.groups:type_name -> OpenIMServer.msg.ActiveGroup
-	73, // 23: OpenIMServer.msg.SearchMessageReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
-	53, // 24: OpenIMServer.msg.SearchMessageResp.chatLogs:type_name -> OpenIMServer.msg.ChatLog
-	72, // 25: OpenIMServer.msg.batchSendMessageReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	71, // 26: OpenIMServer.msg.MsgIdGetConversationsResp.conversationIDs:type_name -> OpenIMServer.msg.MsgIdGetConversationsResp.ConversationIDsEntry
-	62, // 27: OpenIMServer.msg.MarkReadReq.markReadReq:type_name -> OpenIMServer.msg.ReadSeqReq
-	72, // 28: OpenIMServer.msg.GetMsgByConversationIDsResp.MsgDatasEntry.value:type_name -> OpenIMServer.sdkws.MsgData
-	43, // 29: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.SeqsEntry.value:type_name -> OpenIMServer.msg.Seqs
-	76, // 30: OpenIMServer.msg.msg.GetMaxSeq:input_type -> OpenIMServer.sdkws.GetMaxSeqReq
-	34, // 31: OpenIMServer.msg.msg.GetMaxSeqs:input_type -> OpenIMServer.msg.GetMaxSeqsReq
-	35, // 32: OpenIMServer.msg.msg.GetMinSeqs:input_type -> OpenIMServer.msg.GetMinSeqsReq
-	36, // 33: OpenIMServer.msg.msg.GetHasReadSeqs:input_type -> OpenIMServer.msg.GetHasReadSeqsReq
-	38, // 34: OpenIMServer.msg.msg.GetMsgByConversationIDs:input_type -> OpenIMServer.msg.GetMsgByConversationIDsReq
-	40, // 35: OpenIMServer.msg.msg.GetConversationMaxSeq:input_type -> OpenIMServer.msg.GetConversationMaxSeqReq
-	77, // 36: OpenIMServer.msg.msg.PullMessageBySeqs:input_type -> OpenIMServer.sdkws.PullMessageBySeqsReq
-	51, // 37: OpenIMServer.msg.msg.SearchMessage:input_type -> OpenIMServer.msg.SearchMessageReq
-	6,  // 38: OpenIMServer.msg.msg.SendMsg:input_type -> OpenIMServer.msg.SendMsgReq
-	24, // 39: OpenIMServer.msg.msg.ClearConversationsMsg:input_type -> OpenIMServer.msg.ClearConversationsMsgReq
-	26, // 40: OpenIMServer.msg.msg.UserClearAllMsg:input_type -> OpenIMServer.msg.UserClearAllMsgReq
-	28, // 41: OpenIMServer.msg.msg.DeleteMsgs:input_type -> OpenIMServer.msg.DeleteMsgsReq
-	32, // 42: OpenIMServer.msg.msg.DeleteMsgPhysicalBySeq:input_type -> OpenIMServer.msg.DeleteMsgPhysicalBySeqReq
-	30, // 43: OpenIMServer.msg.msg.DeleteMsgPhysical:input_type -> OpenIMServer.msg.DeleteMsgPhysicalReq
-	8,  // 44: OpenIMServer.msg.msg.SetSendMsgStatus:input_type -> OpenIMServer.msg.SetSendMsgStatusReq
-	10, // 45: OpenIMServer.msg.msg.GetSendMsgStatus:input_type -> OpenIMServer.msg.GetSendMsgStatusReq
-	15, // 46: OpenIMServer.msg.msg.RevokeMsg:input_type -> OpenIMServer.msg.RevokeMsgReq
-	17, // 47: OpenIMServer.msg.msg.MarkMsgsAsRead:input_type -> OpenIMServer.msg.MarkMsgsAsReadReq
-	19, // 48: OpenIMServer.msg.msg.MarkConversationAsRead:input_type -> OpenIMServer.msg.MarkConversationAsReadReq
-	21, // 49: OpenIMServer.msg.msg.SetConversationHasReadSeq:input_type -> OpenIMServer.msg.SetConversationHasReadSeqReq
-	42, // 50: OpenIMServer.msg.msg.GetConversationsHasReadAndMaxSeq:input_type -> OpenIMServer.msg.GetConversationsHasReadAndMaxSeqReq
-	45, // 51: OpenIMServer.msg.msg.GetActiveUser:input_type -> OpenIMServer.msg.GetActiveUserReq
-	48, // 52: OpenIMServer.msg.msg.GetActiveGroup:input_type -> OpenIMServer.msg.GetActiveGroupReq
-	56, // 53: OpenIMServer.msg.msg.GetServerTime:input_type -> OpenIMServer.msg.GetServerTimeReq
-	58, // 54: OpenIMServer.msg.msg.MsgIdGetConversations:input_type -> OpenIMServer.msg.MsgIdGetConversationsReq
-	60, // 55: OpenIMServer.msg.msg.MsgIdGetConversationSeq:input_type -> OpenIMServer.msg.MsgIdGetConversationSeqReq
-	63, // 56: OpenIMServer.msg.msg.ReadSeqs:input_type -> OpenIMServer.msg.MarkReadReq
-	78, // 57: OpenIMServer.msg.msg.GetMaxSeq:output_type -> OpenIMServer.sdkws.GetMaxSeqResp
-	37, // 58: OpenIMServer.msg.msg.GetMaxSeqs:output_type -> OpenIMServer.msg.SeqsInfoResp
-	37, // 59: OpenIMServer.msg.msg.GetMinSeqs:output_type -> OpenIMServer.msg.SeqsInfoResp
-	37, // 60: OpenIMServer.msg.msg.GetHasReadSeqs:output_type -> OpenIMServer.msg.SeqsInfoResp
-	39, // 61: OpenIMServer.msg.msg.GetMsgByConversationIDs:output_type -> OpenIMServer.msg.GetMsgByConversationIDsResp
-	41, // 62: OpenIMServer.msg.msg.GetConversationMaxSeq:output_type -> OpenIMServer.msg.GetConversationMaxSeqResp
-	79, // 63: OpenIMServer.msg.msg.PullMessageBySeqs:output_type -> OpenIMServer.sdkws.PullMessageBySeqsResp
-	52, // 64: OpenIMServer.msg.msg.SearchMessage:output_type -> OpenIMServer.msg.SearchMessageResp
-	7,  // 65: OpenIMServer.msg.msg.SendMsg:output_type -> OpenIMServer.msg.SendMsgResp
-	25, // 66: OpenIMServer.msg.msg.ClearConversationsMsg:output_type -> OpenIMServer.msg.ClearConversationsMsgResp
-	27, // 67: OpenIMServer.msg.msg.UserClearAllMsg:output_type -> OpenIMServer.msg.UserClearAllMsgResp
-	29, // 68: OpenIMServer.msg.msg.DeleteMsgs:output_type -> OpenIMServer.msg.DeleteMsgsResp
-	33, // 69: OpenIMServer.msg.msg.DeleteMsgPhysicalBySeq:output_type -> OpenIMServer.msg.DeleteMsgPhysicalBySeqResp
-	31, // 70: OpenIMServer.msg.msg.DeleteMsgPhysical:output_type -> OpenIMServer.msg.DeleteMsgPhysicalResp
-	9,  // 71: OpenIMServer.msg.msg.SetSendMsgStatus:output_type -> OpenIMServer.msg.SetSendMsgStatusResp
-	11, // 72: OpenIMServer.msg.msg.GetSendMsgStatus:output_type -> OpenIMServer.msg.GetSendMsgStatusResp
-	16, // 73: OpenIMServer.msg.msg.RevokeMsg:output_type -> OpenIMServer.msg.RevokeMsgResp
-	18, // 74: OpenIMServer.msg.msg.MarkMsgsAsRead:output_type -> OpenIMServer.msg.MarkMsgsAsReadResp
-	20, // 75: OpenIMServer.msg.msg.MarkConversationAsRead:output_type -> OpenIMServer.msg.MarkConversationAsReadResp
-	22, // 76: OpenIMServer.msg.msg.SetConversationHasReadSeq:output_type -> OpenIMServer.msg.SetConversationHasReadSeqResp
-	44, // 77: OpenIMServer.msg.msg.GetConversationsHasReadAndMaxSeq:output_type -> OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp
-	47, // 78: OpenIMServer.msg.msg.GetActiveUser:output_type -> OpenIMServer.msg.GetActiveUserResp
-	50, // 79: OpenIMServer.msg.msg.GetActiveGroup:output_type -> OpenIMServer.msg.GetActiveGroupResp
-	57, // 80: OpenIMServer.msg.msg.GetServerTime:output_type -> OpenIMServer.msg.GetServerTimeResp
-	59, // 81: OpenIMServer.msg.msg.MsgIdGetConversations:output_type -> OpenIMServer.msg.MsgIdGetConversationsResp
-	61, // 82: OpenIMServer.msg.msg.MsgIdGetConversationSeq:output_type -> OpenIMServer.msg.MsgIdGetConversationSeqResp
-	20, // 83: OpenIMServer.msg.msg.ReadSeqs:output_type -> OpenIMServer.msg.MarkConversationAsReadResp
-	57, // [57:84] is the sub-list for method output_type
-	30, // [30:57] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+
+// Deprecated: Use GetMsgByConversationIDsReq.ProtoReflect.Descriptor instead.
+func (*GetMsgByConversationIDsReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{71}
 }
 
-func init() { file_msg_msgv3_proto_init() }
-func file_msg_msgv3_proto_init() {
-	if File_msg_msgv3_proto != nil {
-		return
+func (x *GetMsgByConversationIDsReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_msg_msgv3_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDataToMQ); i {
+	return nil
+}
+
+func (x *GetMsgByConversationIDsReq) GetMaxSeqs() map[string]int64 {
+	if x != nil {
+		return x.MaxSeqs
+	}
+	return nil
+}
+
+type GetMsgByConversationIDsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgDatas map[string]*sdkws.MsgData `protobuf:"bytes,1,rep,name=msgDatas,proto3" json:"msgDatas,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetMsgByConversationIDsResp) Reset() {
+	*x = GetMsgByConversationIDsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgByConversationIDsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgByConversationIDsResp) ProtoMessage() {}
+
+func (x *GetMsgByConversationIDsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgByConversationIDsResp.ProtoReflect.Descriptor instead.
+func (*GetMsgByConversationIDsResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetMsgByConversationIDsResp) GetMsgDatas() map[string]*sdkws.MsgData {
+	if x != nil {
+		return x.MsgDatas
+	}
+	return nil
+}
+
+type GetConversationMaxSeqReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+}
+
+func (x *GetConversationMaxSeqReq) Reset() {
+	*x = GetConversationMaxSeqReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationMaxSeqReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationMaxSeqReq) ProtoMessage() {}
+
+func (x *GetConversationMaxSeqReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationMaxSeqReq.ProtoReflect.Descriptor instead.
+func (*GetConversationMaxSeqReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetConversationMaxSeqReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+type GetConversationMaxSeqResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxSeq int64 `protobuf:"varint,1,opt,name=maxSeq,proto3" json:"maxSeq,omitempty"`
+}
+
+func (x *GetConversationMaxSeqResp) Reset() {
+	*x = GetConversationMaxSeqResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationMaxSeqResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationMaxSeqResp) ProtoMessage() {}
+
+func (x *GetConversationMaxSeqResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationMaxSeqResp.ProtoReflect.Descriptor instead.
+func (*GetConversationMaxSeqResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetConversationMaxSeqResp) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+type GetConversationsHasReadAndMaxSeqReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID          string                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	ConversationIDs []string                 `protobuf:"bytes,2,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty"`
+	Pagination      *sdkws.RequestPagination `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetConversationsHasReadAndMaxSeqReq) Reset() {
+	*x = GetConversationsHasReadAndMaxSeqReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsHasReadAndMaxSeqReq) ProtoMessage() {}
+
+func (x *GetConversationsHasReadAndMaxSeqReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsHasReadAndMaxSeqReq.ProtoReflect.Descriptor instead.
+func (*GetConversationsHasReadAndMaxSeqReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *GetConversationsHasReadAndMaxSeqReq) GetConversationIDs() []string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+func (x *GetConversationsHasReadAndMaxSeqReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type Seqs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxSeq     int64 `protobuf:"varint,1,opt,name=maxSeq,proto3" json:"maxSeq,omitempty"`
+	HasReadSeq int64 `protobuf:"varint,2,opt,name=hasReadSeq,proto3" json:"hasReadSeq,omitempty"`
+}
+
+func (x *Seqs) Reset() {
+	*x = Seqs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Seqs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Seqs) ProtoMessage() {}
+
+func (x *Seqs) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Seqs.ProtoReflect.Descriptor instead.
+func (*Seqs) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *Seqs) GetMaxSeq() int64 {
+	if x != nil {
+		return x.MaxSeq
+	}
+	return 0
+}
+
+func (x *Seqs) GetHasReadSeq() int64 {
+	if x != nil {
+		return x.HasReadSeq
+	}
+	return 0
+}
+
+type GetConversationsHasReadAndMaxSeqResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seqs map[string]*Seqs `protobuf:"bytes,1,rep,name=seqs,proto3" json:"seqs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GetConversationsHasReadAndMaxSeqResp) Reset() {
+	*x = GetConversationsHasReadAndMaxSeqResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConversationsHasReadAndMaxSeqResp) ProtoMessage() {}
+
+func (x *GetConversationsHasReadAndMaxSeqResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConversationsHasReadAndMaxSeqResp.ProtoReflect.Descriptor instead.
+func (*GetConversationsHasReadAndMaxSeqResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetConversationsHasReadAndMaxSeqResp) GetSeqs() map[string]*Seqs {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type GetActiveUserReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start      int64                    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64                    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Ase        bool                     `protobuf:"varint,3,opt,name=ase,proto3" json:"ase,omitempty"`
+	Group      bool                     `protobuf:"varint,4,opt,name=group,proto3" json:"group,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetActiveUserReq) Reset() {
+	*x = GetActiveUserReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveUserReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveUserReq) ProtoMessage() {}
+
+func (x *GetActiveUserReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveUserReq.ProtoReflect.Descriptor instead.
+func (*GetActiveUserReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetActiveUserReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActiveUserReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *GetActiveUserReq) GetAse() bool {
+	if x != nil {
+		return x.Ase
+	}
+	return false
+}
+
+func (x *GetActiveUserReq) GetGroup() bool {
+	if x != nil {
+		return x.Group
+	}
+	return false
+}
+
+func (x *GetActiveUserReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ActiveUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User  *sdkws.UserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Count int64           `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ActiveUser) Reset() {
+	*x = ActiveUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveUser) ProtoMessage() {}
+
+func (x *ActiveUser) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveUser.ProtoReflect.Descriptor instead.
+func (*ActiveUser) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ActiveUser) GetUser() *sdkws.UserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *ActiveUser) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetActiveUserResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgCount  int64            `protobuf:"varint,1,opt,name=msgCount,proto3" json:"msgCount,omitempty"`
+	UserCount int64            `protobuf:"varint,2,opt,name=userCount,proto3" json:"userCount,omitempty"`
+	DateCount map[string]int64 `protobuf:"bytes,3,rep,name=dateCount,proto3" json:"dateCount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Users     []*ActiveUser    `protobuf:"bytes,4,rep,name=users,proto3" json:"users,omitempty"`
+}
+
+func (x *GetActiveUserResp) Reset() {
+	*x = GetActiveUserResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveUserResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveUserResp) ProtoMessage() {}
+
+func (x *GetActiveUserResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveUserResp.ProtoReflect.Descriptor instead.
+func (*GetActiveUserResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetActiveUserResp) GetMsgCount() int64 {
+	if x != nil {
+		return x.MsgCount
+	}
+	return 0
+}
+
+func (x *GetActiveUserResp) GetUserCount() int64 {
+	if x != nil {
+		return x.UserCount
+	}
+	return 0
+}
+
+func (x *GetActiveUserResp) GetDateCount() map[string]int64 {
+	if x != nil {
+		return x.DateCount
+	}
+	return nil
+}
+
+func (x *GetActiveUserResp) GetUsers() []*ActiveUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetActiveGroupReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start      int64                    `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End        int64                    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Ase        bool                     `protobuf:"varint,3,opt,name=ase,proto3" json:"ase,omitempty"`
+	Pagination *sdkws.RequestPagination `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *GetActiveGroupReq) Reset() {
+	*x = GetActiveGroupReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveGroupReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveGroupReq) ProtoMessage() {}
+
+func (x *GetActiveGroupReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveGroupReq.ProtoReflect.Descriptor instead.
+func (*GetActiveGroupReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetActiveGroupReq) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *GetActiveGroupReq) GetEnd() int64 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *GetActiveGroupReq) GetAse() bool {
+	if x != nil {
+		return x.Ase
+	}
+	return false
+}
+
+func (x *GetActiveGroupReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ActiveGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Group *sdkws.GroupInfo `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	Count int64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ActiveGroup) Reset() {
+	*x = ActiveGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ActiveGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActiveGroup) ProtoMessage() {}
+
+func (x *ActiveGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActiveGroup.ProtoReflect.Descriptor instead.
+func (*ActiveGroup) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ActiveGroup) GetGroup() *sdkws.GroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+func (x *ActiveGroup) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetActiveGroupResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgCount   int64            `protobuf:"varint,1,opt,name=msgCount,proto3" json:"msgCount,omitempty"`
+	GroupCount int64            `protobuf:"varint,2,opt,name=groupCount,proto3" json:"groupCount,omitempty"`
+	DateCount  map[string]int64 `protobuf:"bytes,3,rep,name=dateCount,proto3" json:"dateCount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Groups     []*ActiveGroup   `protobuf:"bytes,4,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *GetActiveGroupResp) Reset() {
+	*x = GetActiveGroupResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetActiveGroupResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetActiveGroupResp) ProtoMessage() {}
+
+func (x *GetActiveGroupResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetActiveGroupResp.ProtoReflect.Descriptor instead.
+func (*GetActiveGroupResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetActiveGroupResp) GetMsgCount() int64 {
+	if x != nil {
+		return x.MsgCount
+	}
+	return 0
+}
+
+func (x *GetActiveGroupResp) GetGroupCount() int64 {
+	if x != nil {
+		return x.GroupCount
+	}
+	return 0
+}
+
+func (x *GetActiveGroupResp) GetDateCount() map[string]int64 {
+	if x != nil {
+		return x.DateCount
+	}
+	return nil
+}
+
+func (x *GetActiveGroupResp) GetGroups() []*ActiveGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+type SearchMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SendID      string                   `protobuf:"bytes,1,opt,name=sendID,proto3" json:"sendID,omitempty"` //发送者ID
+	RecvID      string                   `protobuf:"bytes,2,opt,name=recvID,proto3" json:"recvID,omitempty"` //接收者ID
+	MsgType     int32                    `protobuf:"varint,3,opt,name=msgType,proto3" json:"msgType,omitempty"`
+	SendTime    string                   `protobuf:"bytes,4,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	SessionType int32                    `protobuf:"varint,5,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
+	Pagination  *sdkws.RequestPagination `protobuf:"bytes,6,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *SearchMessageReq) Reset() {
+	*x = SearchMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessageReq) ProtoMessage() {}
+
+func (x *SearchMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessageReq.ProtoReflect.Descriptor instead.
+func (*SearchMessageReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SearchMessageReq) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *SearchMessageReq) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *SearchMessageReq) GetMsgType() int32 {
+	if x != nil {
+		return x.MsgType
+	}
+	return 0
+}
+
+func (x *SearchMessageReq) GetSendTime() string {
+	if x != nil {
+		return x.SendTime
+	}
+	return ""
+}
+
+func (x *SearchMessageReq) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *SearchMessageReq) GetPagination() *sdkws.RequestPagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type SearchMessageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChatLogs    []*ChatLog `protobuf:"bytes,1,rep,name=chatLogs,proto3" json:"chatLogs,omitempty"`
+	ChatLogsNum int32      `protobuf:"varint,2,opt,name=chatLogsNum,proto3" json:"chatLogsNum,omitempty"`
+}
+
+func (x *SearchMessageResp) Reset() {
+	*x = SearchMessageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchMessageResp) ProtoMessage() {}
+
+func (x *SearchMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchMessageResp.ProtoReflect.Descriptor instead.
+func (*SearchMessageResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SearchMessageResp) GetChatLogs() []*ChatLog {
+	if x != nil {
+		return x.ChatLogs
+	}
+	return nil
+}
+
+func (x *SearchMessageResp) GetChatLogsNum() int32 {
+	if x != nil {
+		return x.ChatLogsNum
+	}
+	return 0
+}
+
+type ChatLog struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerMsgID      string `protobuf:"bytes,1,opt,name=serverMsgID,proto3" json:"serverMsgID,omitempty"`
+	ClientMsgID      string `protobuf:"bytes,2,opt,name=clientMsgID,proto3" json:"clientMsgID,omitempty"`
+	SendID           string `protobuf:"bytes,3,opt,name=sendID,proto3" json:"sendID,omitempty"`
+	RecvID           string `protobuf:"bytes,4,opt,name=recvID,proto3" json:"recvID,omitempty"`
+	GroupID          string `protobuf:"bytes,5,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	RecvNickname     string `protobuf:"bytes,6,opt,name=recvNickname,proto3" json:"recvNickname,omitempty"`
+	SenderPlatformID int32  `protobuf:"varint,7,opt,name=senderPlatformID,proto3" json:"senderPlatformID,omitempty"`
+	SenderNickname   string `protobuf:"bytes,8,opt,name=senderNickname,proto3" json:"senderNickname,omitempty"`
+	SenderFaceURL    string `protobuf:"bytes,9,opt,name=senderFaceURL,proto3" json:"senderFaceURL,omitempty"`
+	GroupName        string `protobuf:"bytes,10,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	SessionType      int32  `protobuf:"varint,11,opt,name=sessionType,proto3" json:"sessionType,omitempty"`
+	MsgFrom          int32  `protobuf:"varint,12,opt,name=msgFrom,proto3" json:"msgFrom,omitempty"`
+	ContentType      int32  `protobuf:"varint,13,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Content          string `protobuf:"bytes,14,opt,name=content,proto3" json:"content,omitempty"`
+	Status           int32  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`
+	SendTime         int64  `protobuf:"varint,16,opt,name=sendTime,proto3" json:"sendTime,omitempty"`
+	CreateTime       int64  `protobuf:"varint,17,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	Ex               string `protobuf:"bytes,18,opt,name=ex,proto3" json:"ex,omitempty"`
+	GroupFaceURL     string `protobuf:"bytes,19,opt,name=groupFaceURL,proto3" json:"groupFaceURL,omitempty"`
+	GroupMemberCount uint32 `protobuf:"varint,20,opt,name=groupMemberCount,proto3" json:"groupMemberCount,omitempty"`
+	Seq              int64  `protobuf:"varint,21,opt,name=seq,proto3" json:"seq,omitempty"`
+	GroupOwner       string `protobuf:"bytes,22,opt,name=groupOwner,proto3" json:"groupOwner,omitempty"`
+	GroupType        int32  `protobuf:"varint,23,opt,name=groupType,proto3" json:"groupType,omitempty"`
+}
+
+func (x *ChatLog) Reset() {
+	*x = ChatLog{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatLog) ProtoMessage() {}
+
+func (x *ChatLog) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatLog.ProtoReflect.Descriptor instead.
+func (*ChatLog) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ChatLog) GetServerMsgID() string {
+	if x != nil {
+		return x.ServerMsgID
+	}
+	return ""
+}
+
+func (x *ChatLog) GetClientMsgID() string {
+	if x != nil {
+		return x.ClientMsgID
+	}
+	return ""
+}
+
+func (x *ChatLog) GetSendID() string {
+	if x != nil {
+		return x.SendID
+	}
+	return ""
+}
+
+func (x *ChatLog) GetRecvID() string {
+	if x != nil {
+		return x.RecvID
+	}
+	return ""
+}
+
+func (x *ChatLog) GetGroupID() string {
+	if x != nil {
+		return x.GroupID
+	}
+	return ""
+}
+
+func (x *ChatLog) GetRecvNickname() string {
+	if x != nil {
+		return x.RecvNickname
+	}
+	return ""
+}
+
+func (x *ChatLog) GetSenderPlatformID() int32 {
+	if x != nil {
+		return x.SenderPlatformID
+	}
+	return 0
+}
+
+func (x *ChatLog) GetSenderNickname() string {
+	if x != nil {
+		return x.SenderNickname
+	}
+	return ""
+}
+
+func (x *ChatLog) GetSenderFaceURL() string {
+	if x != nil {
+		return x.SenderFaceURL
+	}
+	return ""
+}
+
+func (x *ChatLog) GetGroupName() string {
+	if x != nil {
+		return x.GroupName
+	}
+	return ""
+}
+
+func (x *ChatLog) GetSessionType() int32 {
+	if x != nil {
+		return x.SessionType
+	}
+	return 0
+}
+
+func (x *ChatLog) GetMsgFrom() int32 {
+	if x != nil {
+		return x.MsgFrom
+	}
+	return 0
+}
+
+func (x *ChatLog) GetContentType() int32 {
+	if x != nil {
+		return x.ContentType
+	}
+	return 0
+}
+
+func (x *ChatLog) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ChatLog) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ChatLog) GetSendTime() int64 {
+	if x != nil {
+		return x.SendTime
+	}
+	return 0
+}
+
+func (x *ChatLog) GetCreateTime() int64 {
+	if x != nil {
+		return x.CreateTime
+	}
+	return 0
+}
+
+func (x *ChatLog) GetEx() string {
+	if x != nil {
+		return x.Ex
+	}
+	return ""
+}
+
+func (x *ChatLog) GetGroupFaceURL() string {
+	if x != nil {
+		return x.GroupFaceURL
+	}
+	return ""
+}
+
+func (x *ChatLog) GetGroupMemberCount() uint32 {
+	if x != nil {
+		return x.GroupMemberCount
+	}
+	return 0
+}
+
+func (x *ChatLog) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ChatLog) GetGroupOwner() string {
+	if x != nil {
+		return x.GroupOwner
+	}
+	return ""
+}
+
+func (x *ChatLog) GetGroupType() int32 {
+	if x != nil {
+		return x.GroupType
+	}
+	return 0
+}
+
+type BatchSendMessageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecvIDList []string       `protobuf:"bytes,1,rep,name=recvIDList,proto3" json:"recvIDList,omitempty"`
+	MsgData    *sdkws.MsgData `protobuf:"bytes,2,opt,name=msgData,proto3" json:"msgData,omitempty"`
+}
+
+func (x *BatchSendMessageReq) Reset() {
+	*x = BatchSendMessageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSendMessageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendMessageReq) ProtoMessage() {}
+
+func (x *BatchSendMessageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendMessageReq.ProtoReflect.Descriptor instead.
+func (*BatchSendMessageReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *BatchSendMessageReq) GetRecvIDList() []string {
+	if x != nil {
+		return x.RecvIDList
+	}
+	return nil
+}
+
+func (x *BatchSendMessageReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+type BatchSendMessageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BatchSendMessageResp) Reset() {
+	*x = BatchSendMessageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchSendMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchSendMessageResp) ProtoMessage() {}
+
+func (x *BatchSendMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchSendMessageResp.ProtoReflect.Descriptor instead.
+func (*BatchSendMessageResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{88}
+}
+
+type GetServerTimeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetServerTimeReq) Reset() {
+	*x = GetServerTimeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerTimeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerTimeReq) ProtoMessage() {}
+
+func (x *GetServerTimeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerTimeReq.ProtoReflect.Descriptor instead.
+func (*GetServerTimeReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{89}
+}
+
+type GetServerTimeResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ServerTime int64 `protobuf:"varint,1,opt,name=serverTime,proto3" json:"serverTime,omitempty"`
+}
+
+func (x *GetServerTimeResp) Reset() {
+	*x = GetServerTimeResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServerTimeResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerTimeResp) ProtoMessage() {}
+
+func (x *GetServerTimeResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerTimeResp.ProtoReflect.Descriptor instead.
+func (*GetServerTimeResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GetServerTimeResp) GetServerTime() int64 {
+	if x != nil {
+		return x.ServerTime
+	}
+	return 0
+}
+
+type MsgIdGetConversationsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromUserID string   `protobuf:"bytes,1,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	MsgId      []string `protobuf:"bytes,2,rep,name=msgId,proto3" json:"msgId,omitempty"`
+	IdType     int32    `protobuf:"varint,3,opt,name=idType,proto3" json:"idType,omitempty"`
+}
+
+func (x *MsgIdGetConversationsReq) Reset() {
+	*x = MsgIdGetConversationsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgIdGetConversationsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgIdGetConversationsReq) ProtoMessage() {}
+
+func (x *MsgIdGetConversationsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgIdGetConversationsReq.ProtoReflect.Descriptor instead.
+func (*MsgIdGetConversationsReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *MsgIdGetConversationsReq) GetFromUserID() string {
+	if x != nil {
+		return x.FromUserID
+	}
+	return ""
+}
+
+func (x *MsgIdGetConversationsReq) GetMsgId() []string {
+	if x != nil {
+		return x.MsgId
+	}
+	return nil
+}
+
+func (x *MsgIdGetConversationsReq) GetIdType() int32 {
+	if x != nil {
+		return x.IdType
+	}
+	return 0
+}
+
+type MsgIdGetConversationsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationIDs map[string]string `protobuf:"bytes,1,rep,name=conversationIDs,proto3" json:"conversationIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *MsgIdGetConversationsResp) Reset() {
+	*x = MsgIdGetConversationsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgIdGetConversationsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgIdGetConversationsResp) ProtoMessage() {}
+
+func (x *MsgIdGetConversationsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgIdGetConversationsResp.ProtoReflect.Descriptor instead.
+func (*MsgIdGetConversationsResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *MsgIdGetConversationsResp) GetConversationIDs() map[string]string {
+	if x != nil {
+		return x.ConversationIDs
+	}
+	return nil
+}
+
+type MsgIdGetConversationSeqReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgId  string `protobuf:"bytes,1,opt,name=msgId,proto3" json:"msgId,omitempty"`
+	IdType int32  `protobuf:"varint,2,opt,name=idType,proto3" json:"idType,omitempty"`
+}
+
+func (x *MsgIdGetConversationSeqReq) Reset() {
+	*x = MsgIdGetConversationSeqReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgIdGetConversationSeqReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgIdGetConversationSeqReq) ProtoMessage() {}
+
+func (x *MsgIdGetConversationSeqReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgIdGetConversationSeqReq.ProtoReflect.Descriptor instead.
+func (*MsgIdGetConversationSeqReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *MsgIdGetConversationSeqReq) GetMsgId() string {
+	if x != nil {
+		return x.MsgId
+	}
+	return ""
+}
+
+func (x *MsgIdGetConversationSeqReq) GetIdType() int32 {
+	if x != nil {
+		return x.IdType
+	}
+	return 0
+}
+
+type MsgIdGetConversationSeqResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq            int64  `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	ConversationID string `protobuf:"bytes,2,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+}
+
+func (x *MsgIdGetConversationSeqResp) Reset() {
+	*x = MsgIdGetConversationSeqResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgIdGetConversationSeqResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgIdGetConversationSeqResp) ProtoMessage() {}
+
+func (x *MsgIdGetConversationSeqResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgIdGetConversationSeqResp.ProtoReflect.Descriptor instead.
+func (*MsgIdGetConversationSeqResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *MsgIdGetConversationSeqResp) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgIdGetConversationSeqResp) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+type ReadSeqReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq            int64  `protobuf:"varint,1,opt,name=Seq,proto3" json:"Seq,omitempty"`
+	ConversationID string `protobuf:"bytes,2,opt,name=ConversationID,proto3" json:"ConversationID,omitempty"`
+	UserID         string `protobuf:"bytes,3,opt,name=UserID,proto3" json:"UserID,omitempty"`
+}
+
+func (x *ReadSeqReq) Reset() {
+	*x = ReadSeqReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadSeqReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadSeqReq) ProtoMessage() {}
+
+func (x *ReadSeqReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadSeqReq.ProtoReflect.Descriptor instead.
+func (*ReadSeqReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ReadSeqReq) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *ReadSeqReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *ReadSeqReq) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+type MarkReadReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MarkReadReq []*ReadSeqReq `protobuf:"bytes,1,rep,name=markReadReq,proto3" json:"markReadReq,omitempty"`
+}
+
+func (x *MarkReadReq) Reset() {
+	*x = MarkReadReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MarkReadReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkReadReq) ProtoMessage() {}
+
+func (x *MarkReadReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkReadReq.ProtoReflect.Descriptor instead.
+func (*MarkReadReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *MarkReadReq) GetMarkReadReq() []*ReadSeqReq {
+	if x != nil {
+		return x.MarkReadReq
+	}
+	return nil
+}
+
+type GetMsgDeliveryStatesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ConversationID string  `protobuf:"bytes,1,opt,name=conversationID,proto3" json:"conversationID,omitempty"`
+	Seqs           []int64 `protobuf:"varint,2,rep,packed,name=seqs,proto3" json:"seqs,omitempty"`
+}
+
+func (x *GetMsgDeliveryStatesReq) Reset() {
+	*x = GetMsgDeliveryStatesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgDeliveryStatesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgDeliveryStatesReq) ProtoMessage() {}
+
+func (x *GetMsgDeliveryStatesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgDeliveryStatesReq.ProtoReflect.Descriptor instead.
+func (*GetMsgDeliveryStatesReq) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *GetMsgDeliveryStatesReq) GetConversationID() string {
+	if x != nil {
+		return x.ConversationID
+	}
+	return ""
+}
+
+func (x *GetMsgDeliveryStatesReq) GetSeqs() []int64 {
+	if x != nil {
+		return x.Seqs
+	}
+	return nil
+}
+
+type RecipientDeliveryState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID string `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// 1 pushed, waiting for the ack 2 acked by the client 3 not acked in time, pushed offline
+	State      int32 `protobuf:"varint,2,opt,name=state,proto3" json:"state,omitempty"`
+	UpdateTime int64 `protobuf:"varint,3,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+}
+
+func (x *RecipientDeliveryState) Reset() {
+	*x = RecipientDeliveryState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecipientDeliveryState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipientDeliveryState) ProtoMessage() {}
+
+func (x *RecipientDeliveryState) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipientDeliveryState.ProtoReflect.Descriptor instead.
+func (*RecipientDeliveryState) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *RecipientDeliveryState) GetUserID() string {
+	if x != nil {
+		return x.UserID
+	}
+	return ""
+}
+
+func (x *RecipientDeliveryState) GetState() int32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *RecipientDeliveryState) GetUpdateTime() int64 {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return 0
+}
+
+type MsgDeliveryState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq        int64                     `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Recipients []*RecipientDeliveryState `protobuf:"bytes,2,rep,name=recipients,proto3" json:"recipients,omitempty"`
+}
+
+func (x *MsgDeliveryState) Reset() {
+	*x = MsgDeliveryState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgDeliveryState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgDeliveryState) ProtoMessage() {}
+
+func (x *MsgDeliveryState) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgDeliveryState.ProtoReflect.Descriptor instead.
+func (*MsgDeliveryState) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *MsgDeliveryState) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *MsgDeliveryState) GetRecipients() []*RecipientDeliveryState {
+	if x != nil {
+		return x.Recipients
+	}
+	return nil
+}
+
+type GetMsgDeliveryStatesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	States []*MsgDeliveryState `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
+}
+
+func (x *GetMsgDeliveryStatesResp) Reset() {
+	*x = GetMsgDeliveryStatesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_msg_msgv3_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMsgDeliveryStatesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMsgDeliveryStatesResp) ProtoMessage() {}
+
+func (x *GetMsgDeliveryStatesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_msg_msgv3_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMsgDeliveryStatesResp.ProtoReflect.Descriptor instead.
+func (*GetMsgDeliveryStatesResp) Descriptor() ([]byte, []int) {
+	return file_msg_msgv3_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetMsgDeliveryStatesResp) GetStates() []*MsgDeliveryState {
+	if x != nil {
+		return x.States
+	}
+	return nil
+}
+
+var File_msg_msgv3_proto protoreflect.FileDescriptor
+
+var file_msg_msgv3_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x6d, 0x73, 0x67, 0x2f, 0x6d, 0x73, 0x67, 0x76, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x1a, 0x11, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2f, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5a, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x6f, 0x4d, 0x51, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x6d,
+	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0x44, 0x0a, 0x0b, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x44,
+	0x42, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x70, 0x0a, 0x0f, 0x50, 0x75, 0x73, 0x68,
+	0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x51, 0x12, 0x35, 0x0a, 0x07, 0x6d,
+	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x8d, 0x01, 0x0a, 0x12, 0x4d,
+	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x54, 0x6f, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x42, 0x79, 0x4d,
+	0x51, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x78, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x45, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x78, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x69, 0x6e, 0x53, 0x65,
+	0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x22,
+	0x67, 0x0a, 0x0a, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a,
+	0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64,
+	0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8d, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x22, 0x2d, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x16, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e,
+	0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x54, 0x6f, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x42, 0x79, 0x4d, 0x51, 0x12, 0x37, 0x0a,
+	0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73,
+	0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x0c,
+	0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x22, 0x0d, 0x0a, 0x0b,
+	0x44, 0x65, 0x6c, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22, 0x70, 0x0a, 0x0c, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78, 0x22, 0x0f, 0x0a,
+	0x0d, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7a,
+	0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x49, 0x0a, 0x0d, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x7e, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
+	0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x53, 0x0a, 0x12, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xc6, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03,
+	0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x62, 0x0a, 0x0c, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x9d, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x3d, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x22, 0xa6, 0x01,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f,
+	0x74, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x62, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x65, 0x67, 0x69, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x53, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x75, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x6e, 0x75, 0x6d, 0x22, 0x91, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x54, 0x68,
+	0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x07,
+	0x72, 0x6f, 0x6f, 0x74, 0x4d, 0x73, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x72, 0x6f, 0x6f, 0x74,
+	0x4d, 0x73, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
+	0x6d, 0x73, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8f, 0x01, 0x0a, 0x13, 0x4d,
+	0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f,
+	0x6f, 0x74, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x6f, 0x6f,
+	0x74, 0x53, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0x16, 0x0a, 0x14,
+	0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0xd7, 0x01, 0x0a, 0x0c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8c,
+	0x01, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x32, 0x0a, 0x04, 0x6d,
+	0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x22,
+	0x4f, 0x0a, 0x15, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x44,
+	0x22, 0x18, 0x0a, 0x16, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x6e, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x5d, 0x0a, 0x09, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x0c,
+	0x0a, 0x0a, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x5f, 0x0a, 0x0b,
+	0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x0e, 0x0a,
+	0x0c, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0x52, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x22, 0x52, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x0a, 0x70, 0x69, 0x6e, 0x6e, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x22, 0xa8, 0x02, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6b, 0x65, 0x79, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x6c, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x12,
+	0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x59,
+	0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x31, 0x0a, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x22, 0x63, 0x0a, 0x0d, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x63, 0x76, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x22, 0xcf,
+	0x01, 0x0a, 0x0e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52,
+	0x04, 0x73, 0x65, 0x71, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x22, 0xad, 0x01, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x37, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x6d,
+	0x73, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x52, 0x04, 0x6d, 0x73, 0x67, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x65, 0x72, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x4d,
+	0x73, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x4d, 0x73, 0x67,
+	0x22, 0x4c, 0x0a, 0x0f, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x67,
+	0x0a, 0x11, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x14, 0x0a, 0x12, 0x4d, 0x61, 0x72, 0x6b, 0x4d,
+	0x73, 0x67, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x8f, 0x01,
+	0x0a, 0x19, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x68,
+	0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x65, 0x71, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x22,
+	0x1c, 0x0a, 0x1a, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x22, 0x7e, 0x0a,
+	0x1c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a,
+	0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0x1f, 0x0a,
+	0x1d, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x22, 0x51,
+	0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x6c, 0x66, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x65, 0x6c, 0x66, 0x12,
+	0x20, 0x0a, 0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x74, 0x68, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x74, 0x68, 0x65,
+	0x72, 0x22, 0xa3, 0x01, 0x0a, 0x18, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x28,
+	0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x45, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x22, 0x73, 0x0a, 0x12, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61,
+	0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63,
+	0x4f, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0xaa, 0x01, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65,
+	0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x45, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x52, 0x0d,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x22, 0x10, 0x0a,
+	0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x5e, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x57, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53,
+	0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65, 0x71,
+	0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x22,
+	0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x39, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x22, 0x94, 0x02, 0x0a,
+	0x0c, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d,
+	0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x53, 0x65, 0x71, 0x73, 0x12, 0x45, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d,
+	0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x69, 0x6e, 0x53, 0x65,
+	0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0xd7, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x53, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x2e, 0x4d, 0x61, 0x78, 0x53,
+	0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71,
+	0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xd0, 0x01,
+	0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a,
+	0x08, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x3b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d,
+	0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x73, 0x1a, 0x58, 0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x42, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x22, 0xae, 0x01, 0x0a, 0x23, 0x47, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x63, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3e, 0x0a, 0x04, 0x53, 0x65,
+	0x71, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x68, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x22, 0xcd, 0x01, 0x0a, 0x24, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48,
+	0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x40, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d,
+	0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x04, 0x73, 0x65, 0x71, 0x73, 0x1a, 0x4f, 0x0a, 0x09, 0x53, 0x65, 0x71,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa9, 0x01, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x73, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x6f,
+	0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x54, 0x0a, 0x0a, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x91, 0x02, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x09,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32,
+	0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73, 0x65,
+	0x72, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x94, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x61, 0x73, 0x65,
+	0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x58, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x33, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x98, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x73, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x51, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x44, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x1a, 0x3c,
+	0x0a, 0x0e, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe1, 0x01, 0x0a,
+	0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63,
+	0x76, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x45, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b,
+	0x77, 0x73, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x6c, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x08, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x4e, 0x75, 0x6d, 0x22, 0xcf,
+	0x05, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x74, 0x4c, 0x6f, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x63, 0x76, 0x49, 0x44, 0x12, 0x18,
+	0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x76,
+	0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x76, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46, 0x61, 0x63, 0x65, 0x55, 0x52,
+	0x4c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x46,
+	0x61, 0x63, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f,
+	0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x46, 0x72, 0x6f, 0x6d,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x65, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x65, 0x78,
+	0x12, 0x22, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63, 0x65, 0x55, 0x52, 0x4c,
+	0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x46, 0x61, 0x63,
+	0x65, 0x55, 0x52, 0x4c, 0x12, 0x2a, 0x0a, 0x10, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73,
+	0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0x6c, 0x0a, 0x13, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x76, 0x49,
+	0x44, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63,
+	0x76, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x22, 0x16,
+	0x0a, 0x14, 0x62, 0x61, 0x74, 0x63, 0x68, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x22, 0x12, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x22, 0x33, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x1e, 0x0a, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0x68, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1e, 0x0a, 0x0a, 0x66,
+	0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x6d,
+	0x73, 0x67, 0x49, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x4d, 0x73,
+	0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6a, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x40, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x73, 0x1a, 0x42, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x49, 0x64,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x57, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x53, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x5e, 0x0a, 0x0a,
+	0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x65,
+	0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x53, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x4d, 0x0a, 0x0b,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x12, 0x3e, 0x0a, 0x0b, 0x6d,
+	0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x52, 0x0b,
+	0x6d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x22, 0x55, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x65, 0x71, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x52, 0x04, 0x73, 0x65,
+	0x71, 0x73, 0x22, 0x66, 0x0a, 0x16, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x6e, 0x0a, 0x10, 0x4d, 0x73,
+	0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71,
+	0x12, 0x48, 0x0a, 0x0a, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0a,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x73, 0x32, 0xc3, 0x1f, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x12, 0x50, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a,
+	0x47, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53,
+	0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4d, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
+	0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x73, 0x12, 0x23, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65,
+	0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x71, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x12, 0x2c, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x73, 0x67, 0x42, 0x79, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53,
+	0x65, 0x71, 0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x2b,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x68, 0x0a, 0x11, 0x50,
+	0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x53, 0x65, 0x71, 0x73,
+	0x12, 0x28, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x42, 0x79, 0x53, 0x65, 0x71, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x29, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e,
+	0x50, 0x75, 0x6c, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x79, 0x53, 0x65, 0x71,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x46, 0x0a, 0x07, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x1c, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a, 0x15, 0x43, 0x6c, 0x65, 0x61, 0x72,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67,
+	0x12, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5e, 0x0a, 0x0f, 0x55, 0x73, 0x65,
+	0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x12, 0x24, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41, 0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x41,
+	0x6c, 0x6c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73, 0x0a, 0x16, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42,
+	0x79, 0x53, 0x65, 0x71, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73,
+	0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x52, 0x65,
+	0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x42, 0x79, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x64, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73,
+	0x69, 0x63, 0x61, 0x6c, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73,
+	0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x73, 0x67, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64,
+	0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x74,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53,
+	0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x25, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x73,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4c, 0x0a, 0x09, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x4d, 0x73, 0x67, 0x12, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x68, 0x72, 0x65, 0x61,
+	0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10, 0x4d, 0x61, 0x72,
+	0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x25, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x54, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x61, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73,
+	0x12, 0x25, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x67, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x4d, 0x73, 0x67, 0x12, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x28,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x52, 0x65, 0x73,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x52, 0x65, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x43, 0x0a, 0x06, 0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x12, 0x1b, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x50, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1c, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x50, 0x69, 0x6e,
+	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x49, 0x0a, 0x08, 0x55, 0x6e, 0x70, 0x69, 0x6e,
+	0x4d, 0x73, 0x67, 0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x55, 0x6e, 0x70, 0x69, 0x6e, 0x4d, 0x73, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x4d,
+	0x73, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x69,
+	0x6e, 0x6e, 0x65, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4f, 0x0a, 0x0a,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x20, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x52, 0x0a,
+	0x0b, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x12, 0x20, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x21,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73,
+	0x67, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x4d, 0x73, 0x67, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x5b, 0x0a, 0x0e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41, 0x73, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x4d, 0x73, 0x67, 0x73, 0x41,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x4d, 0x73, 0x67, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x73,
+	0x0a, 0x16, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x12, 0x2b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
+	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b,
+	0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x7c, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71,
+	0x12, 0x2e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71,
+	0x1a, 0x2f, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x6d, 0x73, 0x67, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64,
+	0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x12, 0x35, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65, 0x71, 0x52, 0x65, 0x71, 0x1a, 0x36, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x48, 0x61, 0x73, 0x52, 0x65, 0x61, 0x64, 0x41, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x53, 0x65,
+	0x71, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x12, 0x58, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x22, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65,
+	0x71, 0x1a, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x54, 0x69,
+	0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x70, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d,
+	0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
+	0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2b, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d,
+	0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x76, 0x0a, 0x17, 0x4d, 0x73, 0x67, 0x49,
+	0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x65, 0x71, 0x12, 0x2c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65,
+	0x71, 0x1a, 0x2d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x71, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x57, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x53, 0x65, 0x71, 0x73, 0x12, 0x1d, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x4d, 0x61, 0x72, 0x6b, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x1a, 0x2c, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x4d,
+	0x61, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74,
+	0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6d, 0x73, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6d, 0x73, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x6d, 0x73, 0x67, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_msg_msgv3_proto_rawDescOnce sync.Once
+	file_msg_msgv3_proto_rawDescData = file_msg_msgv3_proto_rawDesc
+)
+
+func file_msg_msgv3_proto_rawDescGZIP() []byte {
+	file_msg_msgv3_proto_rawDescOnce.Do(func() {
+		file_msg_msgv3_proto_rawDescData = protoimpl.X.CompressGZIP(file_msg_msgv3_proto_rawDescData)
+	})
+	return file_msg_msgv3_proto_rawDescData
+}
+
+var file_msg_msgv3_proto_msgTypes = make([]protoimpl.MessageInfo, 109)
+var file_msg_msgv3_proto_goTypes = []interface{}{
+	(*MsgDataToMQ)(nil),                          // 0: OpenIMServer.msg.MsgDataToMQ
+	(*MsgDataToDB)(nil),                          // 1: OpenIMServer.msg.MsgDataToDB
+	(*PushMsgDataToMQ)(nil),                      // 2: OpenIMServer.msg.PushMsgDataToMQ
+	(*MsgDataToMongoByMQ)(nil),                   // 3: OpenIMServer.msg.MsgDataToMongoByMQ
+	(*GetMaxAndMinSeqReq)(nil),                   // 4: OpenIMServer.msg.GetMaxAndMinSeqReq
+	(*GetMaxAndMinSeqResp)(nil),                  // 5: OpenIMServer.msg.GetMaxAndMinSeqResp
+	(*SendMsgReq)(nil),                           // 6: OpenIMServer.msg.SendMsgReq
+	(*SendMsgResp)(nil),                          // 7: OpenIMServer.msg.SendMsgResp
+	(*SetSendMsgStatusReq)(nil),                  // 8: OpenIMServer.msg.SetSendMsgStatusReq
+	(*SetSendMsgStatusResp)(nil),                 // 9: OpenIMServer.msg.SetSendMsgStatusResp
+	(*GetSendMsgStatusReq)(nil),                  // 10: OpenIMServer.msg.GetSendMsgStatusReq
+	(*GetSendMsgStatusResp)(nil),                 // 11: OpenIMServer.msg.GetSendMsgStatusResp
+	(*MsgDataToModifyByMQ)(nil),                  // 12: OpenIMServer.msg.MsgDataToModifyByMQ
+	(*DelMsgsReq)(nil),                           // 13: OpenIMServer.msg.DelMsgsReq
+	(*DelMsgsResp)(nil),                          // 14: OpenIMServer.msg.DelMsgsResp
+	(*RevokeMsgReq)(nil),                         // 15: OpenIMServer.msg.RevokeMsgReq
+	(*RevokeMsgResp)(nil),                        // 16: OpenIMServer.msg.RevokeMsgResp
+	(*ModifyMsgReq)(nil),                         // 17: OpenIMServer.msg.ModifyMsgReq
+	(*ModifyMsgResp)(nil),                        // 18: OpenIMServer.msg.ModifyMsgResp
+	(*AddReactionReq)(nil),                       // 19: OpenIMServer.msg.AddReactionReq
+	(*AddReactionResp)(nil),                      // 20: OpenIMServer.msg.AddReactionResp
+	(*RemoveReactionReq)(nil),                    // 21: OpenIMServer.msg.RemoveReactionReq
+	(*RemoveReactionResp)(nil),                   // 22: OpenIMServer.msg.RemoveReactionResp
+	(*GetReactionsReq)(nil),                      // 23: OpenIMServer.msg.GetReactionsReq
+	(*ReactionUser)(nil),                         // 24: OpenIMServer.msg.ReactionUser
+	(*GetReactionsResp)(nil),                     // 25: OpenIMServer.msg.GetReactionsResp
+	(*GetThreadMsgsReq)(nil),                     // 26: OpenIMServer.msg.GetThreadMsgsReq
+	(*GetThreadMsgsResp)(nil),                    // 27: OpenIMServer.msg.GetThreadMsgsResp
+	(*MarkThreadAsReadReq)(nil),                  // 28: OpenIMServer.msg.MarkThreadAsReadReq
+	(*MarkThreadAsReadResp)(nil),                 // 29: OpenIMServer.msg.MarkThreadAsReadResp
+	(*ScheduledMsg)(nil),                         // 30: OpenIMServer.msg.ScheduledMsg
+	(*GetScheduledMsgsReq)(nil),                  // 31: OpenIMServer.msg.GetScheduledMsgsReq
+	(*GetScheduledMsgsResp)(nil),                 // 32: OpenIMServer.msg.GetScheduledMsgsResp
+	(*CancelScheduledMsgReq)(nil),                // 33: OpenIMServer.msg.CancelScheduledMsgReq
+	(*CancelScheduledMsgResp)(nil),               // 34: OpenIMServer.msg.CancelScheduledMsgResp
+	(*RescheduleMsgReq)(nil),                     // 35: OpenIMServer.msg.RescheduleMsgReq
+	(*RescheduleMsgResp)(nil),                    // 36: OpenIMServer.msg.RescheduleMsgResp
+	(*PinMsgReq)(nil),                            // 37: OpenIMServer.msg.PinMsgReq
+	(*PinMsgResp)(nil),                           // 38: OpenIMServer.msg.PinMsgResp
+	(*UnpinMsgReq)(nil),                          // 39: OpenIMServer.msg.UnpinMsgReq
+	(*UnpinMsgResp)(nil),                         // 40: OpenIMServer.msg.UnpinMsgResp
+	(*GetPinnedMsgsReq)(nil),                     // 41: OpenIMServer.msg.GetPinnedMsgsReq
+	(*GetPinnedMsgsResp)(nil),                    // 42: OpenIMServer.msg.GetPinnedMsgsResp
+	(*SearchMsgsReq)(nil),                        // 43: OpenIMServer.msg.SearchMsgsReq
+	(*SearchedMsg)(nil),                          // 44: OpenIMServer.msg.SearchedMsg
+	(*SearchMsgsResp)(nil),                       // 45: OpenIMServer.msg.SearchMsgsResp
+	(*ForwardTarget)(nil),                        // 46: OpenIMServer.msg.ForwardTarget
+	(*ForwardMsgsReq)(nil),                       // 47: OpenIMServer.msg.ForwardMsgsReq
+	(*ForwardResult)(nil),                        // 48: OpenIMServer.msg.ForwardResult
+	(*ForwardMsgsResp)(nil),                      // 49: OpenIMServer.msg.ForwardMsgsResp
+	(*MarkMsgsAsReadReq)(nil),                    // 50: OpenIMServer.msg.MarkMsgsAsReadReq
+	(*MarkMsgsAsReadResp)(nil),                   // 51: OpenIMServer.msg.MarkMsgsAsReadResp
+	(*MarkConversationAsReadReq)(nil),            // 52: OpenIMServer.msg.MarkConversationAsReadReq
+	(*MarkConversationAsReadResp)(nil),           // 53: OpenIMServer.msg.MarkConversationAsReadResp
+	(*SetConversationHasReadSeqReq)(nil),         // 54: OpenIMServer.msg.SetConversationHasReadSeqReq
+	(*SetConversationHasReadSeqResp)(nil),        // 55: OpenIMServer.msg.SetConversationHasReadSeqResp
+	(*DeleteSyncOpt)(nil),                        // 56: OpenIMServer.msg.DeleteSyncOpt
+	(*ClearConversationsMsgReq)(nil),             // 57: OpenIMServer.msg.ClearConversationsMsgReq
+	(*ClearConversationsMsgResp)(nil),            // 58: OpenIMServer.msg.ClearConversationsMsgResp
+	(*UserClearAllMsgReq)(nil),                   // 59: OpenIMServer.msg.UserClearAllMsgReq
+	(*UserClearAllMsgResp)(nil),                  // 60: OpenIMServer.msg.UserClearAllMsgResp
+	(*DeleteMsgsReq)(nil),                        // 61: OpenIMServer.msg.DeleteMsgsReq
+	(*DeleteMsgsResp)(nil),                       // 62: OpenIMServer.msg.DeleteMsgsResp
+	(*DeleteMsgPhysicalReq)(nil),                 // 63: OpenIMServer.msg.DeleteMsgPhysicalReq
+	(*DeleteMsgPhysicalResp)(nil),                // 64: OpenIMServer.msg.DeleteMsgPhysicalResp
+	(*DeleteMsgPhysicalBySeqReq)(nil),            // 65: OpenIMServer.msg.DeleteMsgPhysicalBySeqReq
+	(*DeleteMsgPhysicalBySeqResp)(nil),           // 66: OpenIMServer.msg.DeleteMsgPhysicalBySeqResp
+	(*GetMaxSeqsReq)(nil),                        // 67: OpenIMServer.msg.GetMaxSeqsReq
+	(*GetMinSeqsReq)(nil),                        // 68: OpenIMServer.msg.GetMinSeqsReq
+	(*GetHasReadSeqsReq)(nil),                    // 69: OpenIMServer.msg.GetHasReadSeqsReq
+	(*SeqsInfoResp)(nil),                         // 70: OpenIMServer.msg.SeqsInfoResp
+	(*GetMsgByConversationIDsReq)(nil),           // 71: OpenIMServer.msg.GetMsgByConversationIDsReq
+	(*GetMsgByConversationIDsResp)(nil),          // 72: OpenIMServer.msg.GetMsgByConversationIDsResp
+	(*GetConversationMaxSeqReq)(nil),             // 73: OpenIMServer.msg.GetConversationMaxSeqReq
+	(*GetConversationMaxSeqResp)(nil),            // 74: OpenIMServer.msg.GetConversationMaxSeqResp
+	(*GetConversationsHasReadAndMaxSeqReq)(nil),  // 75: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqReq
+	(*Seqs)(nil),                                 // 76: OpenIMServer.msg.Seqs
+	(*GetConversationsHasReadAndMaxSeqResp)(nil), // 77: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp
+	(*GetActiveUserReq)(nil),                     // 78: OpenIMServer.msg.GetActiveUserReq
+	(*ActiveUser)(nil),                           // 79: OpenIMServer.msg.ActiveUser
+	(*GetActiveUserResp)(nil),                    // 80: OpenIMServer.msg.GetActiveUserResp
+	(*GetActiveGroupReq)(nil),                    // 81: OpenIMServer.msg.GetActiveGroupReq
+	(*ActiveGroup)(nil),                          // 82: OpenIMServer.msg.ActiveGroup
+	(*GetActiveGroupResp)(nil),                   // 83: OpenIMServer.msg.GetActiveGroupResp
+	(*SearchMessageReq)(nil),                     // 84: OpenIMServer.msg.SearchMessageReq
+	(*SearchMessageResp)(nil),                    // 85: OpenIMServer.msg.SearchMessageResp
+	(*ChatLog)(nil),                              // 86: OpenIMServer.msg.ChatLog
+	(*BatchSendMessageReq)(nil),                  // 87: OpenIMServer.msg.batchSendMessageReq
+	(*BatchSendMessageResp)(nil),                 // 88: OpenIMServer.msg.batchSendMessageResp
+	(*GetServerTimeReq)(nil),                     // 89: OpenIMServer.msg.GetServerTimeReq
+	(*GetServerTimeResp)(nil),                    // 90: OpenIMServer.msg.GetServerTimeResp
+	(*MsgIdGetConversationsReq)(nil),             // 91: OpenIMServer.msg.MsgIdGetConversationsReq
+	(*MsgIdGetConversationsResp)(nil),            // 92: OpenIMServer.msg.MsgIdGetConversationsResp
+	(*MsgIdGetConversationSeqReq)(nil),           // 93: OpenIMServer.msg.MsgIdGetConversationSeqReq
+	(*MsgIdGetConversationSeqResp)(nil),          // 94: OpenIMServer.msg.MsgIdGetConversationSeqResp
+	(*ReadSeqReq)(nil),                           // 95: OpenIMServer.msg.ReadSeqReq
+	(*MarkReadReq)(nil),                          // 96: OpenIMServer.msg.MarkReadReq
+	(*GetMsgDeliveryStatesReq)(nil),              // 97: OpenIMServer.msg.GetMsgDeliveryStatesReq
+	(*RecipientDeliveryState)(nil),               // 98: OpenIMServer.msg.RecipientDeliveryState
+	(*MsgDeliveryState)(nil),                     // 99: OpenIMServer.msg.MsgDeliveryState
+	(*GetMsgDeliveryStatesResp)(nil),             // 100: OpenIMServer.msg.GetMsgDeliveryStatesResp
+	nil,                                          // 101: OpenIMServer.msg.SeqsInfoResp.MaxSeqsEntry
+	nil,                                          // 102: OpenIMServer.msg.SeqsInfoResp.MinSeqsEntry
+	nil,                                          // 103: OpenIMServer.msg.GetMsgByConversationIDsReq.MaxSeqsEntry
+	nil,                                          // 104: OpenIMServer.msg.GetMsgByConversationIDsResp.MsgDatasEntry
+	nil,                                          // 105: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.SeqsEntry
+	nil,                                          // 106: OpenIMServer.msg.GetActiveUserResp.DateCountEntry
+	nil,                                          // 107: OpenIMServer.msg.GetActiveGroupResp.DateCountEntry
+	nil,                                          // 108: OpenIMServer.msg.MsgIdGetConversationsResp.ConversationIDsEntry
+	(*sdkws.MsgData)(nil),                        // 109: OpenIMServer.sdkws.MsgData
+	(*sdkws.MsgReaction)(nil),                    // 110: OpenIMServer.sdkws.MsgReaction
+	(*sdkws.RequestPagination)(nil),              // 111: OpenIMServer.sdkws.RequestPagination
+	(*sdkws.PinnedMsg)(nil),                      // 112: OpenIMServer.sdkws.PinnedMsg
+	(*sdkws.UserInfo)(nil),                       // 113: OpenIMServer.sdkws.UserInfo
+	(*sdkws.GroupInfo)(nil),                      // 114: OpenIMServer.sdkws.GroupInfo
+	(*sdkws.GetMaxSeqReq)(nil),                   // 115: OpenIMServer.sdkws.GetMaxSeqReq
+	(*sdkws.PullMessageBySeqsReq)(nil),           // 116: OpenIMServer.sdkws.PullMessageBySeqsReq
+	(*sdkws.GetMaxSeqResp)(nil),                  // 117: OpenIMServer.sdkws.GetMaxSeqResp
+	(*sdkws.PullMessageBySeqsResp)(nil),          // 118: OpenIMServer.sdkws.PullMessageBySeqsResp
+}
+var file_msg_msgv3_proto_depIdxs = []int32{
+	109, // 0: OpenIMServer.msg.MsgDataToMQ.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 1: OpenIMServer.msg.MsgDataToDB.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 2: OpenIMServer.msg.PushMsgDataToMQ.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 3: OpenIMServer.msg.MsgDataToMongoByMQ.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 4: OpenIMServer.msg.SendMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 5: OpenIMServer.msg.MsgDataToModifyByMQ.messages:type_name -> OpenIMServer.sdkws.MsgData
+	110, // 6: OpenIMServer.msg.AddReactionResp.reactions:type_name -> OpenIMServer.sdkws.MsgReaction
+	110, // 7: OpenIMServer.msg.RemoveReactionResp.reactions:type_name -> OpenIMServer.sdkws.MsgReaction
+	111, // 8: OpenIMServer.msg.GetReactionsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	110, // 9: OpenIMServer.msg.GetReactionsResp.reactions:type_name -> OpenIMServer.sdkws.MsgReaction
+	24,  // 10: OpenIMServer.msg.GetReactionsResp.users:type_name -> OpenIMServer.msg.ReactionUser
+	109, // 11: OpenIMServer.msg.GetThreadMsgsResp.rootMsg:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 12: OpenIMServer.msg.GetThreadMsgsResp.msgs:type_name -> OpenIMServer.sdkws.MsgData
+	109, // 13: OpenIMServer.msg.ScheduledMsg.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	111, // 14: OpenIMServer.msg.GetScheduledMsgsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	30,  // 15: OpenIMServer.msg.GetScheduledMsgsResp.msgs:type_name -> OpenIMServer.msg.ScheduledMsg
+	112, // 16: OpenIMServer.msg.GetPinnedMsgsResp.pinnedMsgs:type_name -> OpenIMServer.sdkws.PinnedMsg
+	111, // 17: OpenIMServer.msg.SearchMsgsReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	109, // 18: OpenIMServer.msg.SearchedMsg.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	44,  // 19: OpenIMServer.msg.SearchMsgsResp.msgs:type_name -> OpenIMServer.msg.SearchedMsg
+	46,  // 20: OpenIMServer.msg.ForwardMsgsReq.targets:type_name -> OpenIMServer.msg.ForwardTarget
+	46,  // 21: OpenIMServer.msg.ForwardResult.target:type_name -> OpenIMServer.msg.ForwardTarget
+	7,   // 22: OpenIMServer.msg.ForwardResult.msgs:type_name -> OpenIMServer.msg.SendMsgResp
+	48,  // 23: OpenIMServer.msg.ForwardMsgsResp.results:type_name -> OpenIMServer.msg.ForwardResult
+	56,  // 24: OpenIMServer.msg.ClearConversationsMsgReq.deleteSyncOpt:type_name -> OpenIMServer.msg.DeleteSyncOpt
+	56,  // 25: OpenIMServer.msg.UserClearAllMsgReq.deleteSyncOpt:type_name -> OpenIMServer.msg.DeleteSyncOpt
+	56,  // 26: OpenIMServer.msg.DeleteMsgsReq.deleteSyncOpt:type_name -> OpenIMServer.msg.DeleteSyncOpt
+	101, // 27: OpenIMServer.msg.SeqsInfoResp.maxSeqs:type_name -> OpenIMServer.msg.SeqsInfoResp.MaxSeqsEntry
+	102, // 28: OpenIMServer.msg.SeqsInfoResp.minSeqs:type_name -> OpenIMServer.msg.SeqsInfoResp.MinSeqsEntry
+	103, // 29: OpenIMServer.msg.GetMsgByConversationIDsReq.maxSeqs:type_name -> OpenIMServer.msg.GetMsgByConversationIDsReq.MaxSeqsEntry
+	104, // 30: OpenIMServer.msg.GetMsgByConversationIDsResp.msgDatas:type_name -> OpenIMServer.msg.GetMsgByConversationIDsResp.MsgDatasEntry
+	111, // 31: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	105, // 32: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.seqs:type_name -> OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.SeqsEntry
+	111, // 33: OpenIMServer.msg.GetActiveUserReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	113, // 34: OpenIMServer.msg.ActiveUser.user:type_name -> OpenIMServer.sdkws.UserInfo
+	106, // 35: OpenIMServer.msg.GetActiveUserResp.dateCount:type_name -> OpenIMServer.msg.GetActiveUserResp.DateCountEntry
+	79,  // 36: OpenIMServer.msg.GetActiveUserResp.users:type_name -> OpenIMServer.msg.ActiveUser
+	111, // 37: OpenIMServer.msg.GetActiveGroupReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	114, // 38: OpenIMServer.msg.ActiveGroup.group:type_name -> OpenIMServer.sdkws.GroupInfo
+	107, // 39: OpenIMServer.msg.GetActiveGroupResp.dateCount:type_name -> OpenIMServer.msg.GetActiveGroupResp.DateCountEntry
+	82,  // 40: OpenIMServer.msg.GetActiveGroupResp.groups:type_name -> OpenIMServer.msg.ActiveGroup
+	111, // 41: OpenIMServer.msg.SearchMessageReq.pagination:type_name -> OpenIMServer.sdkws.RequestPagination
+	86,  // 42: OpenIMServer.msg.SearchMessageResp.chatLogs:type_name -> OpenIMServer.msg.ChatLog
+	109, // 43: OpenIMServer.msg.batchSendMessageReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	108, // 44: OpenIMServer.msg.MsgIdGetConversationsResp.conversationIDs:type_name -> OpenIMServer.msg.MsgIdGetConversationsResp.ConversationIDsEntry
+	95,  // 45: OpenIMServer.msg.MarkReadReq.markReadReq:type_name -> OpenIMServer.msg.ReadSeqReq
+	98,  // 46: OpenIMServer.msg.MsgDeliveryState.recipients:type_name -> OpenIMServer.msg.RecipientDeliveryState
+	99,  // 47: OpenIMServer.msg.GetMsgDeliveryStatesResp.states:type_name -> OpenIMServer.msg.MsgDeliveryState
+	109, // 48: OpenIMServer.msg.GetMsgByConversationIDsResp.MsgDatasEntry.value:type_name -> OpenIMServer.sdkws.MsgData
+	76,  // 49: OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp.SeqsEntry.value:type_name -> OpenIMServer.msg.Seqs
+	115, // 50: OpenIMServer.msg.msg.GetMaxSeq:input_type -> OpenIMServer.sdkws.GetMaxSeqReq
+	67,  // 51: OpenIMServer.msg.msg.GetMaxSeqs:input_type -> OpenIMServer.msg.GetMaxSeqsReq
+	68,  // 52: OpenIMServer.msg.msg.GetMinSeqs:input_type -> OpenIMServer.msg.GetMinSeqsReq
+	69,  // 53: OpenIMServer.msg.msg.GetHasReadSeqs:input_type -> OpenIMServer.msg.GetHasReadSeqsReq
+	71,  // 54: OpenIMServer.msg.msg.GetMsgByConversationIDs:input_type -> OpenIMServer.msg.GetMsgByConversationIDsReq
+	73,  // 55: OpenIMServer.msg.msg.GetConversationMaxSeq:input_type -> OpenIMServer.msg.GetConversationMaxSeqReq
+	116, // 56: OpenIMServer.msg.msg.PullMessageBySeqs:input_type -> OpenIMServer.sdkws.PullMessageBySeqsReq
+	84,  // 57: OpenIMServer.msg.msg.SearchMessage:input_type -> OpenIMServer.msg.SearchMessageReq
+	6,   // 58: OpenIMServer.msg.msg.SendMsg:input_type -> OpenIMServer.msg.SendMsgReq
+	57,  // 59: OpenIMServer.msg.msg.ClearConversationsMsg:input_type -> OpenIMServer.msg.ClearConversationsMsgReq
+	59,  // 60: OpenIMServer.msg.msg.UserClearAllMsg:input_type -> OpenIMServer.msg.UserClearAllMsgReq
+	61,  // 61: OpenIMServer.msg.msg.DeleteMsgs:input_type -> OpenIMServer.msg.DeleteMsgsReq
+	65,  // 62: OpenIMServer.msg.msg.DeleteMsgPhysicalBySeq:input_type -> OpenIMServer.msg.DeleteMsgPhysicalBySeqReq
+	63,  // 63: OpenIMServer.msg.msg.DeleteMsgPhysical:input_type -> OpenIMServer.msg.DeleteMsgPhysicalReq
+	8,   // 64: OpenIMServer.msg.msg.SetSendMsgStatus:input_type -> OpenIMServer.msg.SetSendMsgStatusReq
+	10,  // 65: OpenIMServer.msg.msg.GetSendMsgStatus:input_type -> OpenIMServer.msg.GetSendMsgStatusReq
+	15,  // 66: OpenIMServer.msg.msg.RevokeMsg:input_type -> OpenIMServer.msg.RevokeMsgReq
+	17,  // 67: OpenIMServer.msg.msg.ModifyMsg:input_type -> OpenIMServer.msg.ModifyMsgReq
+	19,  // 68: OpenIMServer.msg.msg.AddReaction:input_type -> OpenIMServer.msg.AddReactionReq
+	21,  // 69: OpenIMServer.msg.msg.RemoveReaction:input_type -> OpenIMServer.msg.RemoveReactionReq
+	23,  // 70: OpenIMServer.msg.msg.GetReactions:input_type -> OpenIMServer.msg.GetReactionsReq
+	26,  // 71: OpenIMServer.msg.msg.GetThreadMsgs:input_type -> OpenIMServer.msg.GetThreadMsgsReq
+	28,  // 72: OpenIMServer.msg.msg.MarkThreadAsRead:input_type -> OpenIMServer.msg.MarkThreadAsReadReq
+	31,  // 73: OpenIMServer.msg.msg.GetScheduledMsgs:input_type -> OpenIMServer.msg.GetScheduledMsgsReq
+	33,  // 74: OpenIMServer.msg.msg.CancelScheduledMsg:input_type -> OpenIMServer.msg.CancelScheduledMsgReq
+	35,  // 75: OpenIMServer.msg.msg.RescheduleMsg:input_type -> OpenIMServer.msg.RescheduleMsgReq
+	37,  // 76: OpenIMServer.msg.msg.PinMsg:input_type -> OpenIMServer.msg.PinMsgReq
+	39,  // 77: OpenIMServer.msg.msg.UnpinMsg:input_type -> OpenIMServer.msg.UnpinMsgReq
+	41,  // 78: OpenIMServer.msg.msg.GetPinnedMsgs:input_type -> OpenIMServer.msg.GetPinnedMsgsReq
+	43,  // 79: OpenIMServer.msg.msg.SearchMsgs:input_type -> OpenIMServer.msg.SearchMsgsReq
+	47,  // 80: OpenIMServer.msg.msg.ForwardMsgs:input_type -> OpenIMServer.msg.ForwardMsgsReq
+	50,  // 81: OpenIMServer.msg.msg.MarkMsgsAsRead:input_type -> OpenIMServer.msg.MarkMsgsAsReadReq
+	52,  // 82: OpenIMServer.msg.msg.MarkConversationAsRead:input_type -> OpenIMServer.msg.MarkConversationAsReadReq
+	54,  // 83: OpenIMServer.msg.msg.SetConversationHasReadSeq:input_type -> OpenIMServer.msg.SetConversationHasReadSeqReq
+	75,  // 84: OpenIMServer.msg.msg.GetConversationsHasReadAndMaxSeq:input_type -> OpenIMServer.msg.GetConversationsHasReadAndMaxSeqReq
+	78,  // 85: OpenIMServer.msg.msg.GetActiveUser:input_type -> OpenIMServer.msg.GetActiveUserReq
+	81,  // 86: OpenIMServer.msg.msg.GetActiveGroup:input_type -> OpenIMServer.msg.GetActiveGroupReq
+	89,  // 87: OpenIMServer.msg.msg.GetServerTime:input_type -> OpenIMServer.msg.GetServerTimeReq
+	91,  // 88: OpenIMServer.msg.msg.MsgIdGetConversations:input_type -> OpenIMServer.msg.MsgIdGetConversationsReq
+	93,  // 89: OpenIMServer.msg.msg.MsgIdGetConversationSeq:input_type -> OpenIMServer.msg.MsgIdGetConversationSeqReq
+	96,  // 90: OpenIMServer.msg.msg.ReadSeqs:input_type -> OpenIMServer.msg.MarkReadReq
+	97,  // 91: OpenIMServer.msg.msg.GetMsgDeliveryStates:input_type -> OpenIMServer.msg.GetMsgDeliveryStatesReq
+	117, // 92: OpenIMServer.msg.msg.GetMaxSeq:output_type -> OpenIMServer.sdkws.GetMaxSeqResp
+	70,  // 93: OpenIMServer.msg.msg.GetMaxSeqs:output_type -> OpenIMServer.msg.SeqsInfoResp
+	70,  // 94: OpenIMServer.msg.msg.GetMinSeqs:output_type -> OpenIMServer.msg.SeqsInfoResp
+	70,  // 95: OpenIMServer.msg.msg.GetHasReadSeqs:output_type -> OpenIMServer.msg.SeqsInfoResp
+	72,  // 96: OpenIMServer.msg.msg.GetMsgByConversationIDs:output_type -> OpenIMServer.msg.GetMsgByConversationIDsResp
+	74,  // 97: OpenIMServer.msg.msg.GetConversationMaxSeq:output_type -> OpenIMServer.msg.GetConversationMaxSeqResp
+	118, // 98: OpenIMServer.msg.msg.PullMessageBySeqs:output_type -> OpenIMServer.sdkws.PullMessageBySeqsResp
+	85,  // 99: OpenIMServer.msg.msg.SearchMessage:output_type -> OpenIMServer.msg.SearchMessageResp
+	7,   // 100: OpenIMServer.msg.msg.SendMsg:output_type -> OpenIMServer.msg.SendMsgResp
+	58,  // 101: OpenIMServer.msg.msg.ClearConversationsMsg:output_type -> OpenIMServer.msg.ClearConversationsMsgResp
+	60,  // 102: OpenIMServer.msg.msg.UserClearAllMsg:output_type -> OpenIMServer.msg.UserClearAllMsgResp
+	62,  // 103: OpenIMServer.msg.msg.DeleteMsgs:output_type -> OpenIMServer.msg.DeleteMsgsResp
+	66,  // 104: OpenIMServer.msg.msg.DeleteMsgPhysicalBySeq:output_type -> OpenIMServer.msg.DeleteMsgPhysicalBySeqResp
+	64,  // 105: OpenIMServer.msg.msg.DeleteMsgPhysical:output_type -> OpenIMServer.msg.DeleteMsgPhysicalResp
+	9,   // 106: OpenIMServer.msg.msg.SetSendMsgStatus:output_type -> OpenIMServer.msg.SetSendMsgStatusResp
+	11,  // 107: OpenIMServer.msg.msg.GetSendMsgStatus:output_type -> OpenIMServer.msg.GetSendMsgStatusResp
+	16,  // 108: OpenIMServer.msg.msg.RevokeMsg:output_type -> OpenIMServer.msg.RevokeMsgResp
+	18,  // 109: OpenIMServer.msg.msg.ModifyMsg:output_type -> OpenIMServer.msg.ModifyMsgResp
+	20,  // 110: OpenIMServer.msg.msg.AddReaction:output_type -> OpenIMServer.msg.AddReactionResp
+	22,  // 111: OpenIMServer.msg.msg.RemoveReaction:output_type -> OpenIMServer.msg.RemoveReactionResp
+	25,  // 112: OpenIMServer.msg.msg.GetReactions:output_type -> OpenIMServer.msg.GetReactionsResp
+	27,  // 113: OpenIMServer.msg.msg.GetThreadMsgs:output_type -> OpenIMServer.msg.GetThreadMsgsResp
+	29,  // 114: OpenIMServer.msg.msg.MarkThreadAsRead:output_type -> OpenIMServer.msg.MarkThreadAsReadResp
+	32,  // 115: OpenIMServer.msg.msg.GetScheduledMsgs:output_type -> OpenIMServer.msg.GetScheduledMsgsResp
+	34,  // 116: OpenIMServer.msg.msg.CancelScheduledMsg:output_type -> OpenIMServer.msg.CancelScheduledMsgResp
+	36,  // 117: OpenIMServer.msg.msg.RescheduleMsg:output_type -> OpenIMServer.msg.RescheduleMsgResp
+	38,  // 118: OpenIMServer.msg.msg.PinMsg:output_type -> OpenIMServer.msg.PinMsgResp
+	40,  // 119: OpenIMServer.msg.msg.UnpinMsg:output_type -> OpenIMServer.msg.UnpinMsgResp
+	42,  // 120: OpenIMServer.msg.msg.GetPinnedMsgs:output_type -> OpenIMServer.msg.GetPinnedMsgsResp
+	45,  // 121: OpenIMServer.msg.msg.SearchMsgs:output_type -> OpenIMServer.msg.SearchMsgsResp
+	49,  // 122: OpenIMServer.msg.msg.ForwardMsgs:output_type -> OpenIMServer.msg.ForwardMsgsResp
+	51,  // 123: OpenIMServer.msg.msg.MarkMsgsAsRead:output_type -> OpenIMServer.msg.MarkMsgsAsReadResp
+	53,  // 124: OpenIMServer.msg.msg.MarkConversationAsRead:output_type -> OpenIMServer.msg.MarkConversationAsReadResp
+	55,  // 125: OpenIMServer.msg.msg.SetConversationHasReadSeq:output_type -> OpenIMServer.msg.SetConversationHasReadSeqResp
+	77,  // 126: OpenIMServer.msg.msg.GetConversationsHasReadAndMaxSeq:output_type -> OpenIMServer.msg.GetConversationsHasReadAndMaxSeqResp
+	80,  // 127: OpenIMServer.msg.msg.GetActiveUser:output_type -> OpenIMServer.msg.GetActiveUserResp
+	83,  // 128: OpenIMServer.msg.msg.GetActiveGroup:output_type -> OpenIMServer.msg.GetActiveGroupResp
+	90,  // 129: OpenIMServer.msg.msg.GetServerTime:output_type -> OpenIMServer.msg.GetServerTimeResp
+	92,  // 130: OpenIMServer.msg.msg.MsgIdGetConversations:output_type -> OpenIMServer.msg.MsgIdGetConversationsResp
+	94,  // 131: OpenIMServer.msg.msg.MsgIdGetConversationSeq:output_type -> OpenIMServer.msg.MsgIdGetConversationSeqResp
+	53,  // 132: OpenIMServer.msg.msg.ReadSeqs:output_type -> OpenIMServer.msg.MarkConversationAsReadResp
+	100, // 133: OpenIMServer.msg.msg.GetMsgDeliveryStates:output_type -> OpenIMServer.msg.GetMsgDeliveryStatesResp
+	92,  // [92:134] is the sub-list for method output_type
+	50,  // [50:92] is the sub-list for method input_type
+	50,  // [50:50] is the sub-list for extension type_name
+	50,  // [50:50] is the sub-list for extension extendee
+	0,   // [0:50] is the sub-list for field type_name
+}
+
+func init() { file_msg_msgv3_proto_init() }
+func file_msg_msgv3_proto_init() {
+	if File_msg_msgv3_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_msg_msgv3_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDataToMQ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDataToDB); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushMsgDataToMQ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDataToMongoByMQ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaxAndMinSeqReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMaxAndMinSeqResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSendMsgStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetSendMsgStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSendMsgStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSendMsgStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDataToModifyByMQ); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokeMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyMsgReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyMsgResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveReactionReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveReactionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReactionsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReactionUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetReactionsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetThreadMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MarkThreadAsReadResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduledMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetScheduledMsgsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4394,8 +7462,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDataToDB); i {
+		file_msg_msgv3_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4406,8 +7474,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PushMsgDataToMQ); i {
+		file_msg_msgv3_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelScheduledMsgResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4418,8 +7486,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDataToMongoByMQ); i {
+		file_msg_msgv3_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4430,8 +7498,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMaxAndMinSeqReq); i {
+		file_msg_msgv3_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RescheduleMsgResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4442,8 +7510,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetMaxAndMinSeqResp); i {
+		file_msg_msgv3_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4454,8 +7522,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMsgReq); i {
+		file_msg_msgv3_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinMsgResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4466,8 +7534,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMsgResp); i {
+		file_msg_msgv3_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4478,8 +7546,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSendMsgStatusReq); i {
+		file_msg_msgv3_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnpinMsgResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4490,8 +7558,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetSendMsgStatusResp); i {
+		file_msg_msgv3_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4502,8 +7570,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSendMsgStatusReq); i {
+		file_msg_msgv3_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPinnedMsgsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4514,8 +7582,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSendMsgStatusResp); i {
+		file_msg_msgv3_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4526,8 +7594,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgDataToModifyByMQ); i {
+		file_msg_msgv3_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchedMsg); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4538,8 +7606,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelMsgsReq); i {
+		file_msg_msgv3_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMsgsResp); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4550,8 +7618,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DelMsgsResp); i {
+		file_msg_msgv3_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardTarget); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4562,8 +7630,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeMsgReq); i {
+		file_msg_msgv3_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardMsgsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4574,8 +7642,8 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokeMsgResp); i {
+		file_msg_msgv3_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4586,7 +7654,19 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardMsgsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkMsgsAsReadReq); i {
 			case 0:
 				return &v.state
@@ -4598,7 +7678,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkMsgsAsReadResp); i {
 			case 0:
 				return &v.state
@@ -4610,7 +7690,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkConversationAsReadReq); i {
 			case 0:
 				return &v.state
@@ -4622,7 +7702,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkConversationAsReadResp); i {
 			case 0:
 				return &v.state
@@ -4634,7 +7714,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetConversationHasReadSeqReq); i {
 			case 0:
 				return &v.state
@@ -4646,7 +7726,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetConversationHasReadSeqResp); i {
 			case 0:
 				return &v.state
@@ -4658,7 +7738,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteSyncOpt); i {
 			case 0:
 				return &v.state
@@ -4670,7 +7750,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClearConversationsMsgReq); i {
 			case 0:
 				return &v.state
@@ -4682,7 +7762,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ClearConversationsMsgResp); i {
 			case 0:
 				return &v.state
@@ -4694,7 +7774,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserClearAllMsgReq); i {
 			case 0:
 				return &v.state
@@ -4706,7 +7786,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserClearAllMsgResp); i {
 			case 0:
 				return &v.state
@@ -4718,7 +7798,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMsgsReq); i {
 			case 0:
 				return &v.state
@@ -4730,7 +7810,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMsgsResp); i {
 			case 0:
 				return &v.state
@@ -4742,7 +7822,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMsgPhysicalReq); i {
 			case 0:
 				return &v.state
@@ -4754,7 +7834,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMsgPhysicalResp); i {
 			case 0:
 				return &v.state
@@ -4766,7 +7846,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMsgPhysicalBySeqReq); i {
 			case 0:
 				return &v.state
@@ -4778,7 +7858,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeleteMsgPhysicalBySeqResp); i {
 			case 0:
 				return &v.state
@@ -4790,7 +7870,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMaxSeqsReq); i {
 			case 0:
 				return &v.state
@@ -4802,7 +7882,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMinSeqsReq); i {
 			case 0:
 				return &v.state
@@ -4814,7 +7894,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetHasReadSeqsReq); i {
 			case 0:
 				return &v.state
@@ -4826,7 +7906,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SeqsInfoResp); i {
 			case 0:
 				return &v.state
@@ -4838,7 +7918,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMsgByConversationIDsReq); i {
 			case 0:
 				return &v.state
@@ -4850,7 +7930,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetMsgByConversationIDsResp); i {
 			case 0:
 				return &v.state
@@ -4862,7 +7942,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConversationMaxSeqReq); i {
 			case 0:
 				return &v.state
@@ -4874,7 +7954,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConversationMaxSeqResp); i {
 			case 0:
 				return &v.state
@@ -4886,7 +7966,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConversationsHasReadAndMaxSeqReq); i {
 			case 0:
 				return &v.state
@@ -4898,7 +7978,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Seqs); i {
 			case 0:
 				return &v.state
@@ -4910,7 +7990,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetConversationsHasReadAndMaxSeqResp); i {
 			case 0:
 				return &v.state
@@ -4922,7 +8002,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetActiveUserReq); i {
 			case 0:
 				return &v.state
@@ -4934,7 +8014,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActiveUser); i {
 			case 0:
 				return &v.state
@@ -4946,7 +8026,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetActiveUserResp); i {
 			case 0:
 				return &v.state
@@ -4958,7 +8038,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetActiveGroupReq); i {
 			case 0:
 				return &v.state
@@ -4970,7 +8050,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ActiveGroup); i {
 			case 0:
 				return &v.state
@@ -4982,7 +8062,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetActiveGroupResp); i {
 			case 0:
 				return &v.state
@@ -4994,7 +8074,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchMessageReq); i {
 			case 0:
 				return &v.state
@@ -5006,7 +8086,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SearchMessageResp); i {
 			case 0:
 				return &v.state
@@ -5018,7 +8098,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ChatLog); i {
 			case 0:
 				return &v.state
@@ -5030,7 +8110,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchSendMessageReq); i {
 			case 0:
 				return &v.state
@@ -5042,7 +8122,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BatchSendMessageResp); i {
 			case 0:
 				return &v.state
@@ -5054,7 +8134,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetServerTimeReq); i {
 			case 0:
 				return &v.state
@@ -5066,7 +8146,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetServerTimeResp); i {
 			case 0:
 				return &v.state
@@ -5078,7 +8158,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgIdGetConversationsReq); i {
 			case 0:
 				return &v.state
@@ -5090,7 +8170,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgIdGetConversationsResp); i {
 			case 0:
 				return &v.state
@@ -5102,7 +8182,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgIdGetConversationSeqReq); i {
 			case 0:
 				return &v.state
@@ -5114,7 +8194,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgIdGetConversationSeqResp); i {
 			case 0:
 				return &v.state
@@ -5126,7 +8206,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadSeqReq); i {
 			case 0:
 				return &v.state
@@ -5138,7 +8218,7 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
-		file_msg_msgv3_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+		file_msg_msgv3_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MarkReadReq); i {
 			case 0:
 				return &v.state
@@ -5150,6 +8230,54 @@ func file_msg_msgv3_proto_init() {
 				return nil
 			}
 		}
+		file_msg_msgv3_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgDeliveryStatesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecipientDeliveryState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDeliveryState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_msg_msgv3_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMsgDeliveryStatesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -5157,7 +8285,7 @@ func file_msg_msgv3_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_msg_msgv3_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   72,
+			NumMessages:   109,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -5214,6 +8342,27 @@ type MsgClient interface {
 	//获取消息发送状态
 	GetSendMsgStatus(ctx context.Context, in *GetSendMsgStatusReq, opts ...grpc.CallOption) (*GetSendMsgStatusResp, error)
 	RevokeMsg(ctx context.Context, in *RevokeMsgReq, opts ...grpc.CallOption) (*RevokeMsgResp, error)
+	// 修改消息内容
+	ModifyMsg(ctx context.Context, in *ModifyMsgReq, opts ...grpc.CallOption) (*ModifyMsgResp, error)
+	// 消息回应
+	AddReaction(ctx context.Context, in *AddReactionReq, opts ...grpc.CallOption) (*AddReactionResp, error)
+	RemoveReaction(ctx context.Context, in *RemoveReactionReq, opts ...grpc.CallOption) (*RemoveReactionResp, error)
+	GetReactions(ctx context.Context, in *GetReactionsReq, opts ...grpc.CallOption) (*GetReactionsResp, error)
+	// 话题回复
+	GetThreadMsgs(ctx context.Context, in *GetThreadMsgsReq, opts ...grpc.CallOption) (*GetThreadMsgsResp, error)
+	MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error)
+	// 定时消息
+	GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error)
+	CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error)
+	RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error)
+	// 消息置顶
+	PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error)
+	UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error)
+	GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error)
+	// 消息全文搜索
+	SearchMsgs(ctx context.Context, in *SearchMsgsReq, opts ...grpc.CallOption) (*SearchMsgsResp, error)
+	// 逐条转发或合并转发
+	ForwardMsgs(ctx context.Context, in *ForwardMsgsReq, opts ...grpc.CallOption) (*ForwardMsgsResp, error)
 	// mark as read
 	MarkMsgsAsRead(ctx context.Context, in *MarkMsgsAsReadReq, opts ...grpc.CallOption) (*MarkMsgsAsReadResp, error)
 	MarkConversationAsRead(ctx context.Context, in *MarkConversationAsReadReq, opts ...grpc.CallOption) (*MarkConversationAsReadResp, error)
@@ -5226,6 +8375,8 @@ type MsgClient interface {
 	MsgIdGetConversations(ctx context.Context, in *MsgIdGetConversationsReq, opts ...grpc.CallOption) (*MsgIdGetConversationsResp, error)
 	MsgIdGetConversationSeq(ctx context.Context, in *MsgIdGetConversationSeqReq, opts ...grpc.CallOption) (*MsgIdGetConversationSeqResp, error)
 	ReadSeqs(ctx context.Context, in *MarkReadReq, opts ...grpc.CallOption) (*MarkConversationAsReadResp, error)
+	// 消息投递状态
+	GetMsgDeliveryStates(ctx context.Context, in *GetMsgDeliveryStatesReq, opts ...grpc.CallOption) (*GetMsgDeliveryStatesResp, error)
 }
 
 type msgClient struct {
@@ -5362,27 +8513,153 @@ func (c *msgClient) DeleteMsgPhysical(ctx context.Context, in *DeleteMsgPhysical
 	return out, nil
 }
 
-func (c *msgClient) SetSendMsgStatus(ctx context.Context, in *SetSendMsgStatusReq, opts ...grpc.CallOption) (*SetSendMsgStatusResp, error) {
-	out := new(SetSendMsgStatusResp)
-	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/SetSendMsgStatus", in, out, opts...)
+func (c *msgClient) SetSendMsgStatus(ctx context.Context, in *SetSendMsgStatusReq, opts ...grpc.CallOption) (*SetSendMsgStatusResp, error) {
+	out := new(SetSendMsgStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/SetSendMsgStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetSendMsgStatus(ctx context.Context, in *GetSendMsgStatusReq, opts ...grpc.CallOption) (*GetSendMsgStatusResp, error) {
+	out := new(GetSendMsgStatusResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetSendMsgStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeMsg(ctx context.Context, in *RevokeMsgReq, opts ...grpc.CallOption) (*RevokeMsgResp, error) {
+	out := new(RevokeMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/RevokeMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ModifyMsg(ctx context.Context, in *ModifyMsgReq, opts ...grpc.CallOption) (*ModifyMsgResp, error) {
+	out := new(ModifyMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/ModifyMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddReaction(ctx context.Context, in *AddReactionReq, opts ...grpc.CallOption) (*AddReactionResp, error) {
+	out := new(AddReactionResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/AddReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveReaction(ctx context.Context, in *RemoveReactionReq, opts ...grpc.CallOption) (*RemoveReactionResp, error) {
+	out := new(RemoveReactionResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/RemoveReaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetReactions(ctx context.Context, in *GetReactionsReq, opts ...grpc.CallOption) (*GetReactionsResp, error) {
+	out := new(GetReactionsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetReactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetThreadMsgs(ctx context.Context, in *GetThreadMsgsReq, opts ...grpc.CallOption) (*GetThreadMsgsResp, error) {
+	out := new(GetThreadMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetThreadMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MarkThreadAsRead(ctx context.Context, in *MarkThreadAsReadReq, opts ...grpc.CallOption) (*MarkThreadAsReadResp, error) {
+	out := new(MarkThreadAsReadResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/MarkThreadAsRead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetScheduledMsgs(ctx context.Context, in *GetScheduledMsgsReq, opts ...grpc.CallOption) (*GetScheduledMsgsResp, error) {
+	out := new(GetScheduledMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetScheduledMsgs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelScheduledMsg(ctx context.Context, in *CancelScheduledMsgReq, opts ...grpc.CallOption) (*CancelScheduledMsgResp, error) {
+	out := new(CancelScheduledMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/CancelScheduledMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RescheduleMsg(ctx context.Context, in *RescheduleMsgReq, opts ...grpc.CallOption) (*RescheduleMsgResp, error) {
+	out := new(RescheduleMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/RescheduleMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PinMsg(ctx context.Context, in *PinMsgReq, opts ...grpc.CallOption) (*PinMsgResp, error) {
+	out := new(PinMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/PinMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnpinMsg(ctx context.Context, in *UnpinMsgReq, opts ...grpc.CallOption) (*UnpinMsgResp, error) {
+	out := new(UnpinMsgResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/UnpinMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GetPinnedMsgs(ctx context.Context, in *GetPinnedMsgsReq, opts ...grpc.CallOption) (*GetPinnedMsgsResp, error) {
+	out := new(GetPinnedMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetPinnedMsgs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) GetSendMsgStatus(ctx context.Context, in *GetSendMsgStatusReq, opts ...grpc.CallOption) (*GetSendMsgStatusResp, error) {
-	out := new(GetSendMsgStatusResp)
-	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetSendMsgStatus", in, out, opts...)
+func (c *msgClient) SearchMsgs(ctx context.Context, in *SearchMsgsReq, opts ...grpc.CallOption) (*SearchMsgsResp, error) {
+	out := new(SearchMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/SearchMsgs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) RevokeMsg(ctx context.Context, in *RevokeMsgReq, opts ...grpc.CallOption) (*RevokeMsgResp, error) {
-	out := new(RevokeMsgResp)
-	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/RevokeMsg", in, out, opts...)
+func (c *msgClient) ForwardMsgs(ctx context.Context, in *ForwardMsgsReq, opts ...grpc.CallOption) (*ForwardMsgsResp, error) {
+	out := new(ForwardMsgsResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/ForwardMsgs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5479,6 +8756,15 @@ func (c *msgClient) ReadSeqs(ctx context.Context, in *MarkReadReq, opts ...grpc.
 	return out, nil
 }
 
+func (c *msgClient) GetMsgDeliveryStates(ctx context.Context, in *GetMsgDeliveryStatesReq, opts ...grpc.CallOption) (*GetMsgDeliveryStatesResp, error) {
+	out := new(GetMsgDeliveryStatesResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.msg.msg/GetMsgDeliveryStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	//获取最小最大seq（包括用户的，以及指定群组的）
@@ -5512,6 +8798,27 @@ type MsgServer interface {
 	//获取消息发送状态
 	GetSendMsgStatus(context.Context, *GetSendMsgStatusReq) (*GetSendMsgStatusResp, error)
 	RevokeMsg(context.Context, *RevokeMsgReq) (*RevokeMsgResp, error)
+	// 修改消息内容
+	ModifyMsg(context.Context, *ModifyMsgReq) (*ModifyMsgResp, error)
+	// 消息回应
+	AddReaction(context.Context, *AddReactionReq) (*AddReactionResp, error)
+	RemoveReaction(context.Context, *RemoveReactionReq) (*RemoveReactionResp, error)
+	GetReactions(context.Context, *GetReactionsReq) (*GetReactionsResp, error)
+	// 话题回复
+	GetThreadMsgs(context.Context, *GetThreadMsgsReq) (*GetThreadMsgsResp, error)
+	MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error)
+	// 定时消息
+	GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error)
+	CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error)
+	RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error)
+	// 消息置顶
+	PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error)
+	UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error)
+	GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error)
+	// 消息全文搜索
+	SearchMsgs(context.Context, *SearchMsgsReq) (*SearchMsgsResp, error)
+	// 逐条转发或合并转发
+	ForwardMsgs(context.Context, *ForwardMsgsReq) (*ForwardMsgsResp, error)
 	// mark as read
 	MarkMsgsAsRead(context.Context, *MarkMsgsAsReadReq) (*MarkMsgsAsReadResp, error)
 	MarkConversationAsRead(context.Context, *MarkConversationAsReadReq) (*MarkConversationAsReadResp, error)
@@ -5524,6 +8831,8 @@ type MsgServer interface {
 	MsgIdGetConversations(context.Context, *MsgIdGetConversationsReq) (*MsgIdGetConversationsResp, error)
 	MsgIdGetConversationSeq(context.Context, *MsgIdGetConversationSeqReq) (*MsgIdGetConversationSeqResp, error)
 	ReadSeqs(context.Context, *MarkReadReq) (*MarkConversationAsReadResp, error)
+	// 消息投递状态
+	GetMsgDeliveryStates(context.Context, *GetMsgDeliveryStatesReq) (*GetMsgDeliveryStatesResp, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -5581,6 +8890,48 @@ func (*UnimplementedMsgServer) GetSendMsgStatus(context.Context, *GetSendMsgStat
 func (*UnimplementedMsgServer) RevokeMsg(context.Context, *RevokeMsgReq) (*RevokeMsgResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokeMsg not implemented")
 }
+func (*UnimplementedMsgServer) ModifyMsg(context.Context, *ModifyMsgReq) (*ModifyMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyMsg not implemented")
+}
+func (*UnimplementedMsgServer) AddReaction(context.Context, *AddReactionReq) (*AddReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReaction not implemented")
+}
+func (*UnimplementedMsgServer) RemoveReaction(context.Context, *RemoveReactionReq) (*RemoveReactionResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReaction not implemented")
+}
+func (*UnimplementedMsgServer) GetReactions(context.Context, *GetReactionsReq) (*GetReactionsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReactions not implemented")
+}
+func (*UnimplementedMsgServer) GetThreadMsgs(context.Context, *GetThreadMsgsReq) (*GetThreadMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadMsgs not implemented")
+}
+func (*UnimplementedMsgServer) MarkThreadAsRead(context.Context, *MarkThreadAsReadReq) (*MarkThreadAsReadResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkThreadAsRead not implemented")
+}
+func (*UnimplementedMsgServer) GetScheduledMsgs(context.Context, *GetScheduledMsgsReq) (*GetScheduledMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScheduledMsgs not implemented")
+}
+func (*UnimplementedMsgServer) CancelScheduledMsg(context.Context, *CancelScheduledMsgReq) (*CancelScheduledMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledMsg not implemented")
+}
+func (*UnimplementedMsgServer) RescheduleMsg(context.Context, *RescheduleMsgReq) (*RescheduleMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescheduleMsg not implemented")
+}
+func (*UnimplementedMsgServer) PinMsg(context.Context, *PinMsgReq) (*PinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinMsg not implemented")
+}
+func (*UnimplementedMsgServer) UnpinMsg(context.Context, *UnpinMsgReq) (*UnpinMsgResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinMsg not implemented")
+}
+func (*UnimplementedMsgServer) GetPinnedMsgs(context.Context, *GetPinnedMsgsReq) (*GetPinnedMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPinnedMsgs not implemented")
+}
+func (*UnimplementedMsgServer) SearchMsgs(context.Context, *SearchMsgsReq) (*SearchMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMsgs not implemented")
+}
+func (*UnimplementedMsgServer) ForwardMsgs(context.Context, *ForwardMsgsReq) (*ForwardMsgsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardMsgs not implemented")
+}
 func (*UnimplementedMsgServer) MarkMsgsAsRead(context.Context, *MarkMsgsAsReadReq) (*MarkMsgsAsReadResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkMsgsAsRead not implemented")
 }
@@ -5611,6 +8962,9 @@ func (*UnimplementedMsgServer) MsgIdGetConversationSeq(context.Context, *MsgIdGe
 func (*UnimplementedMsgServer) ReadSeqs(context.Context, *MarkReadReq) (*MarkConversationAsReadResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReadSeqs not implemented")
 }
+func (*UnimplementedMsgServer) GetMsgDeliveryStates(context.Context, *GetMsgDeliveryStatesReq) (*GetMsgDeliveryStatesResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMsgDeliveryStates not implemented")
+}
 
 func RegisterMsgServer(s *grpc.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -5922,6 +9276,258 @@ func _Msg_RevokeMsg_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ModifyMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ModifyMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/ModifyMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ModifyMsg(ctx, req.(*ModifyMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/AddReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddReaction(ctx, req.(*AddReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveReaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveReaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/RemoveReaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveReaction(ctx, req.(*RemoveReactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetReactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReactionsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetReactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/GetReactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetReactions(ctx, req.(*GetReactionsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetThreadMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetThreadMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/GetThreadMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetThreadMsgs(ctx, req.(*GetThreadMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MarkThreadAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkThreadAsReadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarkThreadAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/MarkThreadAsRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarkThreadAsRead(ctx, req.(*MarkThreadAsReadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetScheduledMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScheduledMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetScheduledMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/GetScheduledMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetScheduledMsgs(ctx, req.(*GetScheduledMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelScheduledMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelScheduledMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/CancelScheduledMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelScheduledMsg(ctx, req.(*CancelScheduledMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RescheduleMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescheduleMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RescheduleMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/RescheduleMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RescheduleMsg(ctx, req.(*RescheduleMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/PinMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PinMsg(ctx, req.(*PinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnpinMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinMsgReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnpinMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/UnpinMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnpinMsg(ctx, req.(*UnpinMsgReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GetPinnedMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPinnedMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetPinnedMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/GetPinnedMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetPinnedMsgs(ctx, req.(*GetPinnedMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SearchMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SearchMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/SearchMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SearchMsgs(ctx, req.(*SearchMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ForwardMsgs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForwardMsgsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ForwardMsgs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/ForwardMsgs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ForwardMsgs(ctx, req.(*ForwardMsgsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_MarkMsgsAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MarkMsgsAsReadReq)
 	if err := dec(in); err != nil {
@@ -6102,6 +9708,24 @@ func _Msg_ReadSeqs_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_GetMsgDeliveryStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMsgDeliveryStatesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GetMsgDeliveryStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.msg.msg/GetMsgDeliveryStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GetMsgDeliveryStates(ctx, req.(*GetMsgDeliveryStatesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "OpenIMServer.msg.msg",
 	HandlerType: (*MsgServer)(nil),
@@ -6174,6 +9798,62 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RevokeMsg",
 			Handler:    _Msg_RevokeMsg_Handler,
 		},
+		{
+			MethodName: "ModifyMsg",
+			Handler:    _Msg_ModifyMsg_Handler,
+		},
+		{
+			MethodName: "AddReaction",
+			Handler:    _Msg_AddReaction_Handler,
+		},
+		{
+			MethodName: "RemoveReaction",
+			Handler:    _Msg_RemoveReaction_Handler,
+		},
+		{
+			MethodName: "GetReactions",
+			Handler:    _Msg_GetReactions_Handler,
+		},
+		{
+			MethodName: "GetThreadMsgs",
+			Handler:    _Msg_GetThreadMsgs_Handler,
+		},
+		{
+			MethodName: "MarkThreadAsRead",
+			Handler:    _Msg_MarkThreadAsRead_Handler,
+		},
+		{
+			MethodName: "GetScheduledMsgs",
+			Handler:    _Msg_GetScheduledMsgs_Handler,
+		},
+		{
+			MethodName: "CancelScheduledMsg",
+			Handler:    _Msg_CancelScheduledMsg_Handler,
+		},
+		{
+			MethodName: "RescheduleMsg",
+			Handler:    _Msg_RescheduleMsg_Handler,
+		},
+		{
+			MethodName: "PinMsg",
+			Handler:    _Msg_PinMsg_Handler,
+		},
+		{
+			MethodName: "UnpinMsg",
+			Handler:    _Msg_UnpinMsg_Handler,
+		},
+		{
+			MethodName: "GetPinnedMsgs",
+			Handler:    _Msg_GetPinnedMsgs_Handler,
+		},
+		{
+			MethodName: "SearchMsgs",
+			Handler:    _Msg_SearchMsgs_Handler,
+		},
+		{
+			MethodName: "ForwardMsgs",
+			Handler:    _Msg_ForwardMsgs_Handler,
+		},
 		{
 			MethodName: "MarkMsgsAsRead",
 			Handler:    _Msg_MarkMsgsAsRead_Handler,
@@ -6214,6 +9894,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ReadSeqs",
 			Handler:    _Msg_ReadSeqs_Handler,
 		},
+		{
+			MethodName: "GetMsgDeliveryStates",
+			Handler:    _Msg_GetMsgDeliveryStates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "msg/msgv3.proto",
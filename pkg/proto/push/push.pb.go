@@ -225,6 +225,184 @@ func (*DelUserPushTokenResp) Descriptor() ([]byte, []int) {
 	return file_push_push_proto_rawDescGZIP(), []int{3}
 }
 
+type PushEphemeralSignalReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signal *sdkws.EphemeralSignal `protobuf:"bytes,1,opt,name=signal,proto3" json:"signal,omitempty"`
+}
+
+func (x *PushEphemeralSignalReq) Reset() {
+	*x = PushEphemeralSignalReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_push_push_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushEphemeralSignalReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEphemeralSignalReq) ProtoMessage() {}
+
+func (x *PushEphemeralSignalReq) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEphemeralSignalReq.ProtoReflect.Descriptor instead.
+func (*PushEphemeralSignalReq) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PushEphemeralSignalReq) GetSignal() *sdkws.EphemeralSignal {
+	if x != nil {
+		return x.Signal
+	}
+	return nil
+}
+
+type PushEphemeralSignalResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PushEphemeralSignalResp) Reset() {
+	*x = PushEphemeralSignalResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_push_push_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PushEphemeralSignalResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEphemeralSignalResp) ProtoMessage() {}
+
+func (x *PushEphemeralSignalResp) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEphemeralSignalResp.ProtoReflect.Descriptor instead.
+func (*PushEphemeralSignalResp) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{5}
+}
+
+type OfflinePushFallbackReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MsgData *sdkws.MsgData `protobuf:"bytes,1,opt,name=msgData,proto3" json:"msgData,omitempty"`
+	UserIDs []string       `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+}
+
+func (x *OfflinePushFallbackReq) Reset() {
+	*x = OfflinePushFallbackReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_push_push_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflinePushFallbackReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflinePushFallbackReq) ProtoMessage() {}
+
+func (x *OfflinePushFallbackReq) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflinePushFallbackReq.ProtoReflect.Descriptor instead.
+func (*OfflinePushFallbackReq) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OfflinePushFallbackReq) GetMsgData() *sdkws.MsgData {
+	if x != nil {
+		return x.MsgData
+	}
+	return nil
+}
+
+func (x *OfflinePushFallbackReq) GetUserIDs() []string {
+	if x != nil {
+		return x.UserIDs
+	}
+	return nil
+}
+
+type OfflinePushFallbackResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *OfflinePushFallbackResp) Reset() {
+	*x = OfflinePushFallbackResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_push_push_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfflinePushFallbackResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfflinePushFallbackResp) ProtoMessage() {}
+
+func (x *OfflinePushFallbackResp) ProtoReflect() protoreflect.Message {
+	mi := &file_push_push_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfflinePushFallbackResp.ProtoReflect.Descriptor instead.
+func (*OfflinePushFallbackResp) Descriptor() ([]byte, []int) {
+	return file_push_push_proto_rawDescGZIP(), []int{7}
+}
+
 var File_push_push_proto protoreflect.FileDescriptor
 
 var file_push_push_proto_rawDesc = []byte{
@@ -245,22 +423,51 @@ var file_push_push_proto_rawDesc = []byte{
 	0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x44,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
 	0x49, 0x44, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73,
-	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x32, 0xbf, 0x01, 0x0a, 0x0e, 0x50,
-	0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a,
-	0x07, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73,
-	0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a, 0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68,
-	0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x63, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x55, 0x73,
-	0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x4f, 0x70,
+	0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x22, 0x55, 0x0a, 0x16, 0x50, 0x75,
+	0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x71, 0x12, 0x3b, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x6c, 0x22, 0x19, 0x0a, 0x17, 0x50, 0x75, 0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x22, 0x69, 0x0a, 0x16,
+	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x35, 0x0a, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x73, 0x64, 0x6b, 0x77, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x07, 0x6d, 0x73, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x4f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x32, 0x9b, 0x03, 0x0a, 0x0e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x48, 0x0a, 0x07, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67,
+	0x12, 0x1d, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x71, 0x1a,
+	0x1e, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x63, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70,
 	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
 	0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50, 0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x1a, 0x27, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x75, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x42, 0x24, 0x5a, 0x22,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f, 0x70, 0x65, 0x6e, 0x49,
-	0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x70, 0x75,
-	0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x13, 0x50, 0x75, 0x73, 0x68, 0x45, 0x70, 0x68, 0x65,
+	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x12, 0x29, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e,
+	0x50, 0x75, 0x73, 0x68, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x50, 0x75, 0x73, 0x68, 0x45,
+	0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x6c, 0x0a, 0x13, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73,
+	0x68, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x29, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x49, 0x4d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x4f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x50, 0x75, 0x73, 0x68, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x1a, 0x2a, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x75, 0x73, 0x68, 0x2e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x50, 0x75, 0x73, 0x68, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x4f,
+	0x70, 0x65, 0x6e, 0x49, 0x4d, 0x53, 0x44, 0x4b, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x2f, 0x70, 0x75, 0x73, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -275,25 +482,36 @@ func file_push_push_proto_rawDescGZIP() []byte {
 	return file_push_push_proto_rawDescData
 }
 
-var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_push_push_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_push_push_proto_goTypes = []interface{}{
-	(*PushMsgReq)(nil),           // 0: OpenIMServer.push.PushMsgReq
-	(*PushMsgResp)(nil),          // 1: OpenIMServer.push.PushMsgResp
-	(*DelUserPushTokenReq)(nil),  // 2: OpenIMServer.push.DelUserPushTokenReq
-	(*DelUserPushTokenResp)(nil), // 3: OpenIMServer.push.DelUserPushTokenResp
-	(*sdkws.MsgData)(nil),        // 4: OpenIMServer.sdkws.MsgData
+	(*PushMsgReq)(nil),              // 0: OpenIMServer.push.PushMsgReq
+	(*PushMsgResp)(nil),             // 1: OpenIMServer.push.PushMsgResp
+	(*DelUserPushTokenReq)(nil),     // 2: OpenIMServer.push.DelUserPushTokenReq
+	(*DelUserPushTokenResp)(nil),    // 3: OpenIMServer.push.DelUserPushTokenResp
+	(*PushEphemeralSignalReq)(nil),  // 4: OpenIMServer.push.PushEphemeralSignalReq
+	(*PushEphemeralSignalResp)(nil), // 5: OpenIMServer.push.PushEphemeralSignalResp
+	(*OfflinePushFallbackReq)(nil),  // 6: OpenIMServer.push.OfflinePushFallbackReq
+	(*OfflinePushFallbackResp)(nil), // 7: OpenIMServer.push.OfflinePushFallbackResp
+	(*sdkws.MsgData)(nil),           // 8: OpenIMServer.sdkws.MsgData
+	(*sdkws.EphemeralSignal)(nil),   // 9: OpenIMServer.sdkws.EphemeralSignal
 }
 var file_push_push_proto_depIdxs = []int32{
-	4, // 0: OpenIMServer.push.PushMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
-	0, // 1: OpenIMServer.push.PushMsgService.PushMsg:input_type -> OpenIMServer.push.PushMsgReq
-	2, // 2: OpenIMServer.push.PushMsgService.DelUserPushToken:input_type -> OpenIMServer.push.DelUserPushTokenReq
-	1, // 3: OpenIMServer.push.PushMsgService.PushMsg:output_type -> OpenIMServer.push.PushMsgResp
-	3, // 4: OpenIMServer.push.PushMsgService.DelUserPushToken:output_type -> OpenIMServer.push.DelUserPushTokenResp
-	3, // [3:5] is the sub-list for method output_type
-	1, // [1:3] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	8, // 0: OpenIMServer.push.PushMsgReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	9, // 1: OpenIMServer.push.PushEphemeralSignalReq.signal:type_name -> OpenIMServer.sdkws.EphemeralSignal
+	8, // 2: OpenIMServer.push.OfflinePushFallbackReq.msgData:type_name -> OpenIMServer.sdkws.MsgData
+	0, // 3: OpenIMServer.push.PushMsgService.PushMsg:input_type -> OpenIMServer.push.PushMsgReq
+	2, // 4: OpenIMServer.push.PushMsgService.DelUserPushToken:input_type -> OpenIMServer.push.DelUserPushTokenReq
+	4, // 5: OpenIMServer.push.PushMsgService.PushEphemeralSignal:input_type -> OpenIMServer.push.PushEphemeralSignalReq
+	6, // 6: OpenIMServer.push.PushMsgService.OfflinePushFallback:input_type -> OpenIMServer.push.OfflinePushFallbackReq
+	1, // 7: OpenIMServer.push.PushMsgService.PushMsg:output_type -> OpenIMServer.push.PushMsgResp
+	3, // 8: OpenIMServer.push.PushMsgService.DelUserPushToken:output_type -> OpenIMServer.push.DelUserPushTokenResp
+	5, // 9: OpenIMServer.push.PushMsgService.PushEphemeralSignal:output_type -> OpenIMServer.push.PushEphemeralSignalResp
+	7, // 10: OpenIMServer.push.PushMsgService.OfflinePushFallback:output_type -> OpenIMServer.push.OfflinePushFallbackResp
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_push_push_proto_init() }
@@ -350,6 +568,54 @@ func file_push_push_proto_init() {
 				return nil
 			}
 		}
+		file_push_push_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushEphemeralSignalReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_push_push_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PushEphemeralSignalResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_push_push_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfflinePushFallbackReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_push_push_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OfflinePushFallbackResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -357,7 +623,7 @@ func file_push_push_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_push_push_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -385,6 +651,8 @@ const _ = grpc.SupportPackageIsVersion6
 type PushMsgServiceClient interface {
 	PushMsg(ctx context.Context, in *PushMsgReq, opts ...grpc.CallOption) (*PushMsgResp, error)
 	DelUserPushToken(ctx context.Context, in *DelUserPushTokenReq, opts ...grpc.CallOption) (*DelUserPushTokenResp, error)
+	PushEphemeralSignal(ctx context.Context, in *PushEphemeralSignalReq, opts ...grpc.CallOption) (*PushEphemeralSignalResp, error)
+	OfflinePushFallback(ctx context.Context, in *OfflinePushFallbackReq, opts ...grpc.CallOption) (*OfflinePushFallbackResp, error)
 }
 
 type pushMsgServiceClient struct {
@@ -413,10 +681,30 @@ func (c *pushMsgServiceClient) DelUserPushToken(ctx context.Context, in *DelUser
 	return out, nil
 }
 
+func (c *pushMsgServiceClient) PushEphemeralSignal(ctx context.Context, in *PushEphemeralSignalReq, opts ...grpc.CallOption) (*PushEphemeralSignalResp, error) {
+	out := new(PushEphemeralSignalResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.push.PushMsgService/PushEphemeralSignal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pushMsgServiceClient) OfflinePushFallback(ctx context.Context, in *OfflinePushFallbackReq, opts ...grpc.CallOption) (*OfflinePushFallbackResp, error) {
+	out := new(OfflinePushFallbackResp)
+	err := c.cc.Invoke(ctx, "/OpenIMServer.push.PushMsgService/OfflinePushFallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PushMsgServiceServer is the server API for PushMsgService service.
 type PushMsgServiceServer interface {
 	PushMsg(context.Context, *PushMsgReq) (*PushMsgResp, error)
 	DelUserPushToken(context.Context, *DelUserPushTokenReq) (*DelUserPushTokenResp, error)
+	PushEphemeralSignal(context.Context, *PushEphemeralSignalReq) (*PushEphemeralSignalResp, error)
+	OfflinePushFallback(context.Context, *OfflinePushFallbackReq) (*OfflinePushFallbackResp, error)
 }
 
 // UnimplementedPushMsgServiceServer can be embedded to have forward compatible implementations.
@@ -429,6 +717,12 @@ func (*UnimplementedPushMsgServiceServer) PushMsg(context.Context, *PushMsgReq)
 func (*UnimplementedPushMsgServiceServer) DelUserPushToken(context.Context, *DelUserPushTokenReq) (*DelUserPushTokenResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DelUserPushToken not implemented")
 }
+func (*UnimplementedPushMsgServiceServer) PushEphemeralSignal(context.Context, *PushEphemeralSignalReq) (*PushEphemeralSignalResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushEphemeralSignal not implemented")
+}
+func (*UnimplementedPushMsgServiceServer) OfflinePushFallback(context.Context, *OfflinePushFallbackReq) (*OfflinePushFallbackResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfflinePushFallback not implemented")
+}
 
 func RegisterPushMsgServiceServer(s *grpc.Server, srv PushMsgServiceServer) {
 	s.RegisterService(&_PushMsgService_serviceDesc, srv)
@@ -470,6 +764,42 @@ func _PushMsgService_DelUserPushToken_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PushMsgService_PushEphemeralSignal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushEphemeralSignalReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushMsgServiceServer).PushEphemeralSignal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.push.PushMsgService/PushEphemeralSignal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushMsgServiceServer).PushEphemeralSignal(ctx, req.(*PushEphemeralSignalReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PushMsgService_OfflinePushFallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OfflinePushFallbackReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PushMsgServiceServer).OfflinePushFallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/OpenIMServer.push.PushMsgService/OfflinePushFallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PushMsgServiceServer).OfflinePushFallback(ctx, req.(*OfflinePushFallbackReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PushMsgService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "OpenIMServer.push.PushMsgService",
 	HandlerType: (*PushMsgServiceServer)(nil),
@@ -482,6 +812,14 @@ var _PushMsgService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelUserPushToken",
 			Handler:    _PushMsgService_DelUserPushToken_Handler,
 		},
+		{
+			MethodName: "PushEphemeralSignal",
+			Handler:    _PushMsgService_PushEphemeralSignal_Handler,
+		},
+		{
+			MethodName: "OfflinePushFallback",
+			Handler:    _PushMsgService_OfflinePushFallback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "push/push.proto",
@@ -176,6 +176,21 @@ func (g *GroupRpcClient) GetGroupMemberIDs(ctx context.Context, groupID string)
 	return resp.UserIDs, nil
 }
 
+// GetUserInGroupIDs 返回 groupIDs 中用户所在的群
+func (g *GroupRpcClient) GetUserInGroupIDs(ctx context.Context, userID string, groupIDs []string) ([]string, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	resp, err := g.Client.GetUserInGroupMembers(ctx, &group.GetUserInGroupMembersReq{
+		UserID:   userID,
+		GroupIDs: groupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return utils.Slice(resp.Members, func(e *sdkws.GroupMemberFullInfo) string { return e.GroupID }), nil
+}
+
 func (g *GroupRpcClient) GetGroupInfoCache(ctx context.Context, groupID string) (*sdkws.GroupInfo, error) {
 	resp, err := g.Client.GetGroupInfoCache(ctx, &group.GetGroupInfoCacheReq{
 		GroupID: groupID,
@@ -209,6 +224,15 @@ func (g *GroupRpcClient) DismissGroup(ctx context.Context, groupID string) error
 	return err
 }
 
+func (g *GroupRpcClient) MuteGroupMember(ctx context.Context, groupID, userID string, mutedSeconds uint32) error {
+	_, err := g.Client.MuteGroupMember(ctx, &group.MuteGroupMemberReq{
+		GroupID:      groupID,
+		UserID:       userID,
+		MutedSeconds: mutedSeconds,
+	})
+	return err
+}
+
 func (g *GroupRpcClient) NotificationUserInfoUpdate(ctx context.Context, userID string) error {
 	_, err := g.Client.NotificationUserInfoUpdate(ctx, &group.NotificationUserInfoUpdateReq{
 		UserID: userID,
@@ -20,6 +20,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/OpenIMSDK/protocol/push"
+	"github.com/OpenIMSDK/protocol/sdkws"
 	"github.com/OpenIMSDK/tools/discoveryregistry"
 
 	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
@@ -55,3 +56,13 @@ func (p *PushRpcClient) DelUserPushToken(
 ) (*push.DelUserPushTokenResp, error) {
 	return p.Client.DelUserPushToken(ctx, req)
 }
+
+func (p *PushRpcClient) PushEphemeralSignal(ctx context.Context, signal *sdkws.EphemeralSignal) error {
+	_, err := p.Client.PushEphemeralSignal(ctx, &push.PushEphemeralSignalReq{Signal: signal})
+	return err
+}
+
+func (p *PushRpcClient) OfflinePushFallback(ctx context.Context, msgData *sdkws.MsgData, userIDs []string) error {
+	_, err := p.Client.OfflinePushFallback(ctx, &push.OfflinePushFallbackReq{MsgData: msgData, UserIDs: userIDs})
+	return err
+}
@@ -90,6 +90,8 @@ const (
 	ConnArgsErr          = 1602
 	PushMsgErr           = 1603
 	IOSBackgroundPushErr = 1604
+	ConnRateLimit        = 1605
+	ConnSdkVersionTooLow = 1606
 	// S3错误码.
 	FileUploadedExpiredError = 1701 // 上传过期
 )
@@ -62,6 +62,8 @@ var (
 	ErrConnArgsErr          = NewCodeError(ConnArgsErr, "args err, need token, sendID, platformID")
 	ErrPushMsgErr           = NewCodeError(PushMsgErr, "push msg err")
 	ErrIOSBackgroundPushErr = NewCodeError(IOSBackgroundPushErr, "ios background push err")
+	ErrConnRateLimit        = NewCodeError(ConnRateLimit, "ConnRateLimit")
+	ErrConnSdkVersionTooLow = NewCodeError(ConnSdkVersionTooLow, "ConnSdkVersionTooLow")
 
 	ErrFileUploadedExpired = NewCodeError(FileUploadedExpiredError, "FileUploadedExpiredError")
 )
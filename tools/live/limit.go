@@ -5,14 +5,22 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/OpenIMSDK/tools/log"
-	"github.com/redis/go-redis/v9"
+	"math"
 	"strconv"
 	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/OpenIMSDK/tools/utils"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
 )
 
+// BrushLimit 滑动窗口刷屏限制
 type BrushLimit struct {
-	redis redis.UniversalClient
+	redis  redis.UniversalClient
+	events eventbus.Publisher
+	scopes []string
 }
 type (
 	//BrushConfig 刷屏配置
@@ -27,101 +35,187 @@ type (
 		UserId    string `json:"user_id"`
 		BrushTime string `json:"brush_time"`
 		DataType  string `json:"data_type"`
+		Scope     string `json:"scope"`  //触发范围
+		Target    string `json:"target"` //群ID 或 单聊对象ID
+	}
+
+	// BrushResult 刷屏检查结果
+	BrushResult struct {
+		Allowed     bool
+		Scope       string        //触发的范围
+		MuteSeconds int64         //本次触发的禁言秒数，group 范围由调用方写入群成员 MuteEndTime
+		RetryAfter  time.Duration //user、single 范围剩余禁言时间
 	}
 )
 
+// 刷屏限制范围
+const (
+	BrushScopeUser   = "user"   //用户的所有消息
+	BrushScopeGroup  = "group"  //用户在某个群的消息
+	BrushScopeSingle = "single" //用户发给某个单聊对象的消息
+)
+
 const (
-	RedisConfigKey    = "brush_config"                   //刷屏后台配置 key
-	RedisUserKey      = "im_brush_user:%s"               //记录-用户 单位时间发送条数
-	RedisBlockImKey   = "brush_user_block_im:%s"         //记录im-用户 触发限制次数 key
-	RedisBrushAllKey  = "im_super_brush_all_mute:%s"     //用户被禁言 key
-	RedisImTriggerKey = "im_brush_user_trigger_push_key" //禁言 MQ key
-	RedisImBlockKey   = "im_brush_user_block_push_key"   //禁言拉黑 MQ key
+	RedisConfigKey      = "brush_config"           //刷屏后台配置 key
+	RedisGroupConfigKey = "brush_group_config"     //群单独的刷屏配置 hash key，field 为群ID
+	RedisWindowKey      = "im_brush_window:%s:%s"  //记录-滑动窗口内的发送时间 zset，范围:对象
+	RedisMuteKey        = "im_brush_mute:%s:%s"    //user、single 范围禁言 key，范围:对象
+	RedisBlockImKey     = "brush_user_block_im:%s" //记录im-用户 触发限制次数 key
 
 	SendMessageFastError      = "您说话太快啦，休息%s秒吧！"
+	SendMessageSlowDownError  = "您说话太快啦，请稍后再试！" //未配置禁言时间时只拒绝本条消息
 	DatetimeFormatYYYYMMDDHIS = "2006-01-02 15:04:05"
 )
 
-func NewBrushLimit(redisClient redis.UniversalClient) *BrushLimit {
-	brushLimit := new(BrushLimit)
-	brushLimit.redis = redisClient
+// slidingWindowScript 移除窗口外的记录，未达上限时记录本次发送，返回窗口内含本次的条数
+var slidingWindowScript = redis.NewScript(`
+local now = tonumber(ARGV[1])
+local window = tonumber(ARGV[2])
+local limit = tonumber(ARGV[3])
+redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', now - window)
+local count = redis.call('ZCARD', KEYS[1])
+if count < limit then
+	redis.call('ZADD', KEYS[1], now, ARGV[4])
+	redis.call('PEXPIRE', KEYS[1], window)
+end
+return count + 1
+`)
 
-	return brushLimit
+func NewBrushLimit(redisClient redis.UniversalClient, events eventbus.Publisher, scopes []string) *BrushLimit {
+	return &BrushLimit{redis: redisClient, events: events, scopes: scopes}
 }
 
-func (bl *BrushLimit) Check(userId string) (bool, error) {
-	// 是否被禁言
-	superBrushAllRedisKey := format(RedisBrushAllKey, userId)
-	muteAll := bl.redis.TTL(context.Background(), superBrushAllRedisKey).Val()
-	log.ZDebug(context.Background(), "brushLimit.Check", "muteAll", muteAll, "superBrushAllRedisKey", superBrushAllRedisKey)
-	if muteAll > 0 {
-		return false, errors.New(format(SendMessageFastError, fmt.Sprintf("%.f", muteAll.Seconds())))
+// Check 依次检查开启的范围，groupID 不为空时为群消息，否则 recvID 为单聊对象
+func (bl *BrushLimit) Check(ctx context.Context, userId, groupID, recvID string) (BrushResult, error) {
+	for _, scope := range bl.scopes {
+		target, ok := brushTarget(scope, userId, groupID, recvID)
+		if !ok {
+			continue
+		}
+		result, err := bl.check(ctx, scope, target, userId, groupID)
+		if err != nil || !result.Allowed {
+			return result, err
+		}
 	}
-	// 获取禁用设置
-	brushConfigByte, err := bl.redis.Get(context.Background(), RedisConfigKey).Bytes()
-	if err != nil {
-		tips := "获取禁用设置失败"
-		log.ZWarn(context.Background(), tips, err)
-		return true, errors.New(tips)
+	return BrushResult{Allowed: true}, nil
+}
+
+// brushTarget 范围对应的限制对象，消息不属于该范围时返回 false
+func brushTarget(scope, userId, groupID, recvID string) (string, bool) {
+	switch scope {
+	case BrushScopeUser:
+		return userId, true
+	case BrushScopeGroup:
+		if groupID == "" {
+			return "", false
+		}
+		return groupID + ":" + userId, true
+	case BrushScopeSingle:
+		if groupID != "" || recvID == "" {
+			return "", false
+		}
+		return userId + ":" + recvID, true
+	default:
+		return "", false
 	}
-	var brushConfig BrushConfig
-	err = json.Unmarshal(brushConfigByte, &brushConfig)
+}
+
+func (bl *BrushLimit) check(ctx context.Context, scope, target, userId, groupID string) (BrushResult, error) {
+	result := BrushResult{Allowed: true, Scope: scope}
+	// 是否被禁言，group 范围的禁言为群成员 MuteEndTime
+	muteKey := fmt.Sprintf(RedisMuteKey, scope, target)
+	if scope != BrushScopeGroup {
+		if ttl := bl.redis.TTL(ctx, muteKey).Val(); ttl > 0 {
+			result.Allowed, result.RetryAfter = false, ttl
+			return result, nil
+		}
+	}
+	brushConfig, err := bl.getConfig(ctx, scope, groupID)
 	if err != nil {
-		tips := "BrushConfig Unmarshal failed"
-		log.ZWarn(context.Background(), tips, err)
-		return true, errors.New(tips)
+		log.ZWarn(ctx, "get brush config failed", err, "scope", scope, "groupID", groupID)
+		return result, nil
 	}
-	// 有配置刷屏
 	brushTime, _ := strconv.Atoi(brushConfig.BrushTime)
 	brushLimit, _ := strconv.Atoi(brushConfig.BrushLimit)
 	brushBanLimit, _ := strconv.Atoi(brushConfig.BrushBanLimit)
 	brushSpeechTime, _ := strconv.Atoi(brushConfig.BrushSpeechTime)
+	if brushTime <= 0 || brushLimit <= 0 {
+		return result, nil
+	}
 
-	// 记录单位时间发送条数增加一条
-	redisUserKey := format(RedisUserKey, userId)
-	messagesNum := bl.redis.Incr(context.Background(), redisUserKey).Val()
-	ttl := bl.redis.TTL(context.Background(), redisUserKey).Val()
-	if ttl < 0 {
-		ttl = time.Duration(brushTime) * time.Second
-		bl.redis.Expire(context.Background(), redisUserKey, ttl)
-	}
-	log.ZDebug(context.Background(), "brushLimit.Check", "brushConfig", brushConfig)
-	log.ZDebug(context.Background(), "brushLimit.Check", "redisUserKey", redisUserKey, "messagesNum", messagesNum, "ttl", ttl, "brushLimit", brushLimit)
-
-	// 判断该用户是否达到被拉黑和禁言上限次数
-	if messagesNum > int64(brushLimit) {
-		// 刷屏禁言该用户
-		bl.redis.Set(context.Background(), superBrushAllRedisKey, brushSpeechTime, time.Duration(brushSpeechTime)*time.Minute)
-
-		// 记录改用户触发限制次数
-		redisBlockImKey := format(RedisBlockImKey, userId)
-		blockNumIm := bl.redis.Incr(context.Background(), redisBlockImKey).Val()
-		bl.redis.Persist(context.Background(), redisBlockImKey)
-
-		var triggerUser TriggerUser
-		triggerUser.UserId = userId
-		triggerUser.BrushTime = time.Now().Format(DatetimeFormatYYYYMMDDHIS)
-		triggerUser.DataType = "open_im"
-		triggerUserByte, _ := json.Marshal(triggerUser)
-		if blockNumIm < int64(brushBanLimit) {
-			// 禁言
-			bl.redis.LPush(context.Background(), RedisImTriggerKey, string(triggerUserByte))
-			bl.redis.Del(context.Background(), redisUserKey)
-		} else {
-			// 禁言拉黑
-			bl.redis.LPush(context.Background(), RedisImBlockKey, string(triggerUserByte))
-			bl.redis.Del(context.Background(), redisBlockImKey)
-		}
+	windowKey := fmt.Sprintf(RedisWindowKey, scope, target)
+	now := time.Now().UnixMilli()
+	member := strconv.FormatInt(now, 10) + "-" + utils.OperationIDGenerator()
+	count, err := slidingWindowScript.Run(ctx, bl.redis, []string{windowKey}, now, brushTime*1000, brushLimit, member).Int64()
+	if err != nil {
+		return result, err
+	}
+	log.ZDebug(ctx, "brushLimit.Check", "scope", scope, "target", target, "count", count, "brushConfig", brushConfig)
+	if count <= int64(brushLimit) {
+		return result, nil
 	}
 
-	blockAll := bl.redis.TTL(context.Background(), superBrushAllRedisKey).Val()
-	if blockAll > 0 {
-		return false, errors.New(format(SendMessageFastError, fmt.Sprintf("%.f", blockAll.Seconds())))
+	// 刷屏禁言
+	mute := time.Duration(brushSpeechTime) * time.Minute
+	result.Allowed, result.MuteSeconds, result.RetryAfter = false, int64(mute/time.Second), mute
+	if scope != BrushScopeGroup && mute > 0 {
+		bl.redis.Set(ctx, muteKey, brushSpeechTime, mute)
+	}
+	bl.redis.Del(ctx, windowKey)
+
+	// 记录该用户触发限制次数
+	redisBlockImKey := fmt.Sprintf(RedisBlockImKey, userId)
+	blockNumIm := bl.redis.Incr(ctx, redisBlockImKey).Val()
+	bl.redis.Persist(ctx, redisBlockImKey)
+
+	triggerUser := TriggerUser{
+		UserId:    userId,
+		BrushTime: time.Now().Format(DatetimeFormatYYYYMMDDHIS),
+		DataType:  "open_im",
+		Scope:     scope,
+		Target:    target,
 	}
+	// 禁言
+	eventType := eventbus.EventBrushTrigger
+	if blockNumIm >= int64(brushBanLimit) {
+		// 禁言拉黑
+		eventType = eventbus.EventBrushBlock
+		bl.redis.Del(ctx, redisBlockImKey)
+	}
+	if err := bl.events.Publish(ctx, eventType, userId, triggerUser); err != nil {
+		log.ZWarn(ctx, "publish brush event failed", err, "eventType", eventType, "userID", userId)
+	}
+	return result, nil
+}
 
-	return true, nil
+// getConfig 群范围优先使用群单独的配置
+func (bl *BrushLimit) getConfig(ctx context.Context, scope, groupID string) (*BrushConfig, error) {
+	var brushConfigByte []byte
+	if scope == BrushScopeGroup {
+		data, err := bl.redis.HGet(ctx, RedisGroupConfigKey, groupID).Bytes()
+		if err != nil && err != redis.Nil {
+			return nil, err
+		}
+		brushConfigByte = data
+	}
+	if len(brushConfigByte) == 0 {
+		data, err := bl.redis.Get(ctx, RedisConfigKey).Bytes()
+		if err != nil {
+			return nil, err
+		}
+		brushConfigByte = data
+	}
+	var brushConfig BrushConfig
+	if err := json.Unmarshal(brushConfigByte, &brushConfig); err != nil {
+		return nil, errors.New("BrushConfig Unmarshal failed")
+	}
+	return &brushConfig, nil
 }
 
-func format(Format string, UserId string) string {
-	return fmt.Sprintf(Format, UserId)
+// BrushLimitError 被限制时返回给客户端的错误信息
+func BrushLimitError(retryAfter time.Duration) string {
+	if retryAfter <= 0 {
+		return SendMessageSlowDownError
+	}
+	return fmt.Sprintf(SendMessageFastError, strconv.FormatFloat(math.Ceil(retryAfter.Seconds()), 'f', 0, 64))
 }
@@ -0,0 +1,147 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBrushTarget(t *testing.T) {
+	cases := []struct {
+		scope, groupID, recvID string
+		target                 string
+		ok                     bool
+	}{
+		{BrushScopeUser, "", "u2", "u1", true},
+		{BrushScopeUser, "g1", "", "u1", true},
+		{BrushScopeGroup, "g1", "", "g1:u1", true},
+		{BrushScopeGroup, "", "u2", "", false},
+		{BrushScopeSingle, "", "u2", "u1:u2", true},
+		{BrushScopeSingle, "g1", "", "", false},
+		{BrushScopeSingle, "", "", "", false},
+		{"unknown", "g1", "u2", "", false},
+	}
+	for _, c := range cases {
+		target, ok := brushTarget(c.scope, "u1", c.groupID, c.recvID)
+		assert.Equal(t, c.ok, ok, c)
+		assert.Equal(t, c.target, target, c)
+	}
+}
+
+func TestBrushLimitError(t *testing.T) {
+	assert.Equal(t, SendMessageSlowDownError, BrushLimitError(0))
+	assert.Equal(t, fmt.Sprintf(SendMessageFastError, "60"), BrushLimitError(time.Minute))
+	assert.Equal(t, fmt.Sprintf(SendMessageFastError, "1"), BrushLimitError(200*time.Millisecond))
+}
+
+// newBrushTestRedis connects to a spare db of the local redis, the test is skipped without one.
+func newBrushTestRedis(t *testing.T, brushConfig BrushConfig) redis.UniversalClient {
+	rdb := redis.NewClient(&redis.Options{DB: 15, DialTimeout: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		t.Skip("redis is not available:", err)
+	}
+	data, err := json.Marshal(brushConfig)
+	require.NoError(t, err)
+	require.NoError(t, rdb.Set(ctx, RedisConfigKey, data, 0).Err())
+	t.Cleanup(func() {
+		ctx := context.Background()
+		keys := []string{RedisConfigKey, fmt.Sprintf(RedisBlockImKey, "u1")}
+		for _, scope := range []string{BrushScopeUser, BrushScopeGroup, BrushScopeSingle} {
+			keys = append(keys, fmt.Sprintf(RedisWindowKey, scope, "u1"), fmt.Sprintf(RedisMuteKey, scope, "u1"),
+				fmt.Sprintf(RedisWindowKey, scope, "g1:u1"), fmt.Sprintf(RedisWindowKey, scope, "u1:u2"))
+		}
+		rdb.Del(ctx, keys...)
+		rdb.Close()
+	})
+	return rdb
+}
+
+func TestBrushLimitSlidingWindow(t *testing.T) {
+	rdb := newBrushTestRedis(t, BrushConfig{BrushTime: "1", BrushLimit: "2", BrushSpeechTime: "0", BrushBanLimit: "3"})
+	events := &testPublisher{}
+	bl := NewBrushLimit(rdb, events, []string{BrushScopeUser})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		result, err := bl.Check(ctx, "u1", "", "u2")
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+	result, err := bl.Check(ctx, "u1", "", "u2")
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, BrushScopeUser, result.Scope)
+	assert.Zero(t, result.MuteSeconds)
+	assert.Len(t, events.events, 1)
+
+	// 未配置禁言时间，触发后窗口被清空，可以继续发送
+	result, err = bl.Check(ctx, "u1", "", "u2")
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+
+	// 窗口外的记录被移除
+	time.Sleep(1100 * time.Millisecond)
+	for i := 0; i < 2; i++ {
+		result, err = bl.Check(ctx, "u1", "", "u2")
+		require.NoError(t, err)
+		assert.True(t, result.Allowed)
+	}
+}
+
+func TestBrushLimitMute(t *testing.T) {
+	rdb := newBrushTestRedis(t, BrushConfig{BrushTime: "10", BrushLimit: "1", BrushSpeechTime: "1", BrushBanLimit: "3"})
+	bl := NewBrushLimit(rdb, &testPublisher{}, []string{BrushScopeGroup, BrushScopeSingle})
+	ctx := context.Background()
+
+	// 单聊消息不计入 group 范围
+	result, err := bl.Check(ctx, "u1", "", "u2")
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	result, err = bl.Check(ctx, "u1", "", "u2")
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, BrushScopeSingle, result.Scope)
+	assert.Equal(t, int64(60), result.MuteSeconds)
+	assert.Equal(t, time.Minute, result.RetryAfter)
+
+	// single 范围禁言期间直接拒绝
+	result, err = bl.Check(ctx, "u1", "", "u2")
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Greater(t, result.RetryAfter, time.Duration(0))
+	rdb.Del(ctx, fmt.Sprintf(RedisMuteKey, BrushScopeSingle, "u1:u2"))
+
+	// group 范围的禁言由调用方写入，不设置禁言 key
+	result, err = bl.Check(ctx, "u1", "g1", "")
+	require.NoError(t, err)
+	assert.True(t, result.Allowed)
+	result, err = bl.Check(ctx, "u1", "g1", "")
+	require.NoError(t, err)
+	assert.False(t, result.Allowed)
+	assert.Equal(t, BrushScopeGroup, result.Scope)
+	assert.Equal(t, int64(60), result.MuteSeconds)
+	assert.Zero(t, rdb.Exists(ctx, fmt.Sprintf(RedisMuteKey, BrushScopeGroup, "g1:u1")).Val())
+}
@@ -0,0 +1,86 @@
+package live
+
+type matchNode struct {
+	children map[rune]*matchNode
+	word     string //命中的词库原词
+	end      bool
+}
+
+// sensitiveMatcher 在归一化后的文本上匹配词库，命中位置映射回原文
+type sensitiveMatcher struct {
+	normalizer *Normalizer
+	root       *matchNode
+}
+
+func newSensitiveMatcher(normalizer *Normalizer, words []string) *sensitiveMatcher {
+	m := &sensitiveMatcher{normalizer: normalizer, root: &matchNode{}}
+	for _, word := range words {
+		normalized := []rune(normalizer.NormalizeString(word))
+		if len(normalized) == 0 {
+			continue
+		}
+		node := m.root
+		for _, r := range normalized {
+			if node.children == nil {
+				node.children = make(map[rune]*matchNode)
+			}
+			child, ok := node.children[r]
+			if !ok {
+				child = &matchNode{}
+				node.children[r] = child
+			}
+			node = child
+		}
+		node.end = true
+		node.word = word
+	}
+	return m
+}
+
+// Filter 返回把命中的原文字符（含其间被忽略的分隔符）替换为 * 的文本和命中的词
+func (m *sensitiveMatcher) Filter(text string) (sentence string, keywords []string, found bool) {
+	runes := []rune(text)
+	normalized, index := m.normalizer.Normalize(runes)
+	// 一个原文字符可能归一化为多个字符（拼音），匹配只能在原文字符的边界开始和结束
+	boundary := func(i int) bool {
+		return i == 0 || i == len(normalized) || index[i-1] != index[i]
+	}
+	mask := make([]bool, len(runes))
+	seen := make(map[string]struct{})
+	for i := 0; i < len(normalized); {
+		if !boundary(i) {
+			i++
+			continue
+		}
+		node, end, word := m.root, -1, ""
+		for j := i; j < len(normalized); j++ {
+			if node = node.children[normalized[j]]; node == nil {
+				break
+			}
+			if node.end && boundary(j+1) {
+				end, word = j, node.word
+			}
+		}
+		if end < 0 {
+			i++
+			continue
+		}
+		for k := index[i]; k <= index[end]; k++ {
+			mask[k] = true
+		}
+		if _, ok := seen[word]; !ok {
+			seen[word] = struct{}{}
+			keywords = append(keywords, word)
+		}
+		i = end + 1
+	}
+	if len(keywords) == 0 {
+		return text, nil, false
+	}
+	for i := range runes {
+		if mask[i] {
+			runes[i] = '*'
+		}
+	}
+	return string(runes), keywords, true
+}
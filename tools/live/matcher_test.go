@@ -0,0 +1,54 @@
+package live
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+func TestSensitiveMatcher(t *testing.T) {
+	normalizer, err := NewNormalizer(config.SensitiveNormalize{
+		FoldCase: true, FoldWidth: true, FoldHomoglyph: true, StripSeparators: true, Traditional: true,
+	})
+	require.NoError(t, err)
+	m := newSensitiveMatcher(normalizer, []string{"fuck", "赌博"})
+
+	for text, want := range map[string]string{
+		"f*u c k you":  "******* you",
+		"ＦＵＣＫ":         "****",
+		"fuсk":         "****", // cyrillic с
+		"来賭😀博吧":        "来***吧",
+		"nothing here": "nothing here",
+	} {
+		sentence, _, found := m.Filter(text)
+		assert.Equal(t, want, sentence, text)
+		assert.Equal(t, want != text, found, text)
+	}
+	_, keywords, _ := m.Filter("賭博 and FUCK, fuck")
+	assert.Equal(t, []string{"赌博", "fuck"}, keywords)
+}
+
+func TestSensitiveMatcherPinyin(t *testing.T) {
+	dict := filepath.Join(t.TempDir(), "pinyin.txt")
+	require.NoError(t, os.WriteFile(dict, []byte("# test\n赌 du\n博 bo\n饭 fan\n"), 0o600))
+	normalizer, err := NewNormalizer(config.SensitiveNormalize{FoldCase: true, StripSeparators: true, Pinyin: true, PinyinDict: dict})
+	require.NoError(t, err)
+	m := newSensitiveMatcher(normalizer, []string{"赌博", "an"})
+
+	sentence, keywords, found := m.Filter("DU bo 和 赌博")
+	assert.True(t, found)
+	assert.Equal(t, "***** 和 **", sentence)
+	assert.Equal(t, []string{"赌博"}, keywords)
+
+	// matches do not start inside the pinyin of a character
+	_, _, found = m.Filter("饭")
+	assert.False(t, found)
+
+	_, err = NewNormalizer(config.SensitiveNormalize{Pinyin: true})
+	assert.Error(t, err)
+}
@@ -0,0 +1,165 @@
+package live
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+// Normalizer 归一化文本，并记录每个归一化字符对应的原文字符下标，文本与词库按同样的规则归一化
+type Normalizer struct {
+	opts        config.SensitiveNormalize
+	traditional map[rune]rune
+	pinyin      map[rune]string
+}
+
+func NewNormalizer(opts config.SensitiveNormalize) (*Normalizer, error) {
+	n := &Normalizer{opts: opts}
+	if opts.Traditional {
+		n.traditional = make(map[rune]rune, len(traditionalToSimplified))
+		for k, v := range traditionalToSimplified {
+			n.traditional[k] = v
+		}
+		if opts.TraditionalDict != "" {
+			if err := readRuneDict(opts.TraditionalDict, func(k rune, v string) {
+				if r := []rune(v); len(r) == 1 {
+					n.traditional[k] = r[0]
+				}
+			}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if opts.Pinyin {
+		if opts.PinyinDict == "" {
+			return nil, errors.New("sensitive normalize pinyin requires pinyinDict")
+		}
+		n.pinyin = make(map[rune]string)
+		if err := readRuneDict(opts.PinyinDict, func(k rune, v string) {
+			if _, ok := n.pinyin[k]; !ok {
+				n.pinyin[k] = strings.ToLower(v)
+			}
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// readRuneDict 读取每行 "字 值" 的对照表，# 开头为注释
+func readRuneDict(path string, fn func(k rune, v string)) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		if k := []rune(fields[0]); len(k) == 1 {
+			fn(k[0], fields[1])
+		}
+	}
+	return scanner.Err()
+}
+
+// Normalize 返回归一化后的字符以及每个字符在原文 []rune 中的下标
+func (n *Normalizer) Normalize(text []rune) (normalized []rune, index []int) {
+	normalized = make([]rune, 0, len(text))
+	index = make([]int, 0, len(text))
+	for i, r := range text {
+		if n.opts.FoldWidth {
+			r = foldWidth(r)
+		}
+		if n.opts.StripSeparators && isSeparator(r) {
+			continue
+		}
+		if n.opts.FoldCase {
+			r = unicode.ToLower(r)
+		}
+		if n.opts.FoldHomoglyph {
+			if v, ok := homoglyphs[r]; ok {
+				r = v
+			}
+		}
+		if n.traditional != nil {
+			if v, ok := n.traditional[r]; ok {
+				r = v
+			}
+		}
+		if n.pinyin != nil {
+			if v, ok := n.pinyin[r]; ok {
+				for _, p := range v {
+					normalized = append(normalized, p)
+					index = append(index, i)
+				}
+				continue
+			}
+		}
+		normalized = append(normalized, r)
+		index = append(index, i)
+	}
+	return normalized, index
+}
+
+// NormalizeString 归一化词库中的词
+func (n *Normalizer) NormalizeString(s string) string {
+	normalized, _ := n.Normalize([]rune(s))
+	return string(normalized)
+}
+
+func foldWidth(r rune) rune {
+	switch {
+	case r >= 0xFF01 && r <= 0xFF5E:
+		return r - 0xFEE0
+	case r == 0x3000:
+		return ' '
+	}
+	return r
+}
+
+func isSeparator(r rune) bool {
+	return unicode.IsSpace(r) || unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsMark(r) ||
+		unicode.Is(unicode.Cf, r) || unicode.Is(unicode.Co, r) || r == '_'
+}
+
+// homoglyphs 常见的形近字母
+var homoglyphs = map[rune]rune{
+	'а': 'a', 'в': 'b', 'е': 'e', 'к': 'k', 'м': 'm', 'н': 'h', 'о': 'o', 'р': 'p', 'с': 'c', 'т': 't',
+	'у': 'y', 'х': 'x', 'і': 'i', 'ј': 'j', 'ѕ': 's', 'ԁ': 'd', 'ԛ': 'q', 'ԝ': 'w',
+	'α': 'a', 'β': 'b', 'ε': 'e', 'ι': 'i', 'κ': 'k', 'ν': 'v', 'ο': 'o', 'ρ': 'p', 'τ': 't', 'υ': 'u', 'χ': 'x',
+	'ı': 'i', 'ł': 'l', 'ø': 'o',
+}
+
+// traditionalToSimplified 内置的常用繁简对照，可通过 TraditionalDict 追加
+var traditionalToSimplified = map[rune]rune{
+	'們': '们', '個': '个', '來': '来', '時': '时', '說': '说', '國': '国', '會': '会', '對': '对', '學': '学', '過': '过',
+	'還': '还', '這': '这', '麼': '么', '為': '为', '與': '与', '從': '从', '見': '见', '現': '现', '發': '发', '開': '开',
+	'關': '关', '無': '无', '車': '车', '東': '东', '長': '长', '門': '门', '問': '问', '間': '间', '聽': '听', '覺': '觉',
+	'頭': '头', '點': '点', '體': '体', '愛': '爱', '機': '机', '實': '实', '歲': '岁', '電': '电', '話': '话', '讓': '让',
+	'認': '认', '識': '识', '錢': '钱', '買': '买', '賣': '卖', '書': '书', '寫': '写', '讀': '读', '語': '语', '請': '请',
+	'謝': '谢', '氣': '气', '熱': '热', '飛': '飞', '馬': '马', '鳥': '鸟', '魚': '鱼', '雞': '鸡', '樂': '乐', '歡': '欢',
+	'動': '动', '進': '进', '邊': '边', '裡': '里', '後': '后', '幾': '几', '萬': '万', '兩': '两', '條': '条', '隻': '只',
+	'義': '义', '黨': '党', '軍': '军', '戰': '战', '槍': '枪', '彈': '弹', '殺': '杀', '賭': '赌', '獨': '独', '亂': '乱',
+	'處': '处', '權': '权', '歷': '历', '華': '华', '區': '区', '黃': '黄', '藥': '药', '網': '网', '絡': '络', '聯': '联',
+	'係': '系', '統': '统', '經': '经', '濟': '济', '產': '产', '業': '业', '專': '专', '務': '务', '員': '员', '選': '选',
+	'舉': '举', '報': '报', '導': '导', '領': '领', '結': '结', '級': '级', '紅': '红', '綠': '绿', '藍': '蓝', '顏': '颜',
+	'錯': '错', '陽': '阳', '陰': '阴', '灣': '湾', '臺': '台', '獄': '狱', '貪': '贪', '汙': '污', '審': '审', '訴': '诉',
+	'訟': '讼', '證': '证', '據': '据', '壞': '坏', '穢': '秽', '飯': '饭', '餓': '饿', '館': '馆', '種': '种', '園': '园',
+	'圖': '图', '場': '场', '鄉': '乡', '縣': '县', '鎮': '镇', '廣': '广', '島': '岛', '億': '亿', '號': '号', '碼': '码',
+	'帳': '帐', '賬': '账', '戶': '户', '贏': '赢', '輸': '输', '幣': '币', '銀': '银', '貸': '贷', '驗': '验', '險': '险',
+	'韓': '韩', '蘇': '苏', '龍': '龙', '鬥': '斗', '爭': '争', '衛': '卫', '擊': '击', '騙': '骗', '詐': '诈', '傳': '传',
+	'銷': '销', '覽': '览', '觀': '观', '視': '视', '頻': '频', '聲': '声', '響': '响', '輪': '轮', '煉': '炼', '鎗': '枪',
+	'婦': '妇', '媽': '妈', '孫': '孙', '寶': '宝', '將': '将', '師': '师', '廠': '厂', '溫': '温', '際': '际', '離': '离',
+}
@@ -11,7 +11,6 @@ type SensitiveInterface interface {
 	Filter() (sentence string, keywords []string, found bool) //执行过滤
 	GetSensitiveConfig() (filterSet SensitiveConfig)          //获取敏感词Redis配置
 	GetSensitiveWord() (words []string)                       //获取敏感词库
-	PushToHitMQ(hitMessage HitSensitiveMessage)               //推送命中至队列
 }
 
 // Sensitive 敏感词类
@@ -22,9 +21,8 @@ type Sensitive struct {
 }
 
 const (
-	SensitiveConfigKey = "sensitive_filter_set"  //敏感词配置 RedisKey
-	SensitiveWordKey   = "sensitive_word"        //敏感词库 RedisKey
-	SensitiveHitMqKey  = "sensitive_hit_word_mq" //敏感词命中 RedisKey
+	SensitiveConfigKey = "sensitive_filter_set" //敏感词配置 RedisKey
+	SensitiveWordKey   = "sensitive_word"       //敏感词库 RedisKey
 )
 
 type (
@@ -99,9 +97,3 @@ func (s *Sensitive) GetSensitiveWord() (returnData []string) {
 	}
 	return
 }
-
-// PushToHitMQ 推送命中至队列
-func (s *Sensitive) PushToHitMQ(hitMessage HitSensitiveMessage) {
-	data, _ := json.Marshal(hitMessage)
-	s.redis.LPush(context.Background(), SensitiveHitMqKey, string(data))
-}
@@ -0,0 +1,101 @@
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/authverify"
+	"github.com/openimsdk/open-im-server/v3/pkg/common/eventbus"
+)
+
+// 资料字段命中策略
+const (
+	SensitiveFieldReject  = "reject"  //拒绝修改
+	SensitiveFieldReplace = "replace" //敏感词替换
+)
+
+// HitSensitiveMessage.Type，0 私聊 1 群聊
+const (
+	HitTypeUserInfo     = 2 //用户资料
+	HitTypeGroupInfo    = 3 //群资料
+	HitTypeGroupMember  = 4 //群成员资料
+	HitTypeFriendApply  = 5 //好友申请
+	HitTypeFriendRemark = 6 //好友备注
+)
+
+// 资料字段名，config sensitiveFields 按字段名配置策略
+const (
+	SensitiveFieldUserNickname        = "user.nickname"
+	SensitiveFieldGroupName           = "group.groupName"
+	SensitiveFieldGroupNotification   = "group.notification"
+	SensitiveFieldGroupIntroduction   = "group.introduction"
+	SensitiveFieldGroupMemberNickname = "groupMember.nickname"
+	SensitiveFieldFriendReqMsg        = "friend.reqMsg"
+	SensitiveFieldFriendRemark        = "friend.remark"
+)
+
+// SensitiveFieldFilter 资料字段敏感词过滤，未配置策略的字段不过滤
+type SensitiveFieldFilter struct {
+	trie     *SensitiveTrie
+	events   eventbus.Publisher
+	policies map[string]string
+}
+
+// NewSensitiveFieldFilter 初始化并保持词库更新直到 ctx 结束
+func NewSensitiveFieldFilter(ctx context.Context, redisClient redis.UniversalClient, events eventbus.Publisher,
+	policies map[string]string, normalizer *Normalizer, onReload func(version string, words int)) (*SensitiveFieldFilter, error) {
+	f := &SensitiveFieldFilter{events: events, policies: policies}
+	if len(policies) == 0 {
+		return f, nil
+	}
+	f.trie = NewSensitiveTrie(redisClient, normalizer, onReload)
+	if err := f.trie.Reload(ctx, true); err != nil {
+		return nil, err
+	}
+	go f.trie.Run(ctx)
+	return f, nil
+}
+
+// Filter 过滤 field 字段的 value，命中时推送命中队列，reject 返回错误，replace 返回替换后的值；管理员修改不过滤
+func (f *SensitiveFieldFilter) Filter(ctx context.Context, field, value string, hit HitSensitiveMessage) (string, error) {
+	if authverify.IsAppManagerUid(ctx) {
+		return value, nil
+	}
+	return f.filter(ctx, field, value, hit)
+}
+
+// FilterSubmitted 过滤用户提交、由管理员代为写入的字段（如注册），不因操作者是管理员跳过
+func (f *SensitiveFieldFilter) FilterSubmitted(ctx context.Context, field, value string, hit HitSensitiveMessage) (string, error) {
+	return f.filter(ctx, field, value, hit)
+}
+
+func (f *SensitiveFieldFilter) filter(ctx context.Context, field, value string, hit HitSensitiveMessage) (string, error) {
+	policy := f.policies[field]
+	if f.trie == nil || value == "" || (policy != SensitiveFieldReject && policy != SensitiveFieldReplace) {
+		return value, nil
+	}
+	sentence, keywords, found := f.trie.Filter(value)
+	if !found {
+		return value, nil
+	}
+	type sensitiveWord struct {
+		Field          string   `json:"field"`
+		SensitiveWords []string `json:"sensitiveWords"`
+	}
+	extra, _ := json.Marshal(sensitiveWord{Field: field, SensitiveWords: keywords})
+	hit.DT = time.Now().Unix()
+	hit.Content = value
+	hit.Extra = string(extra)
+	if err := f.events.Publish(ctx, eventbus.EventSensitiveHit, hit.From, hit); err != nil {
+		log.ZWarn(ctx, "push sensitive hit failed", err, "field", field)
+	}
+	if policy == SensitiveFieldReject {
+		return "", errs.ErrMsgSensitiveWordFailed.Wrap(field)
+	}
+	return sentence, nil
+}
@@ -0,0 +1,87 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package live
+
+import (
+	"context"
+	"testing"
+
+	"github.com/OpenIMSDK/tools/errs"
+	"github.com/OpenIMSDK/tools/mcontext"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openimsdk/open-im-server/v3/pkg/common/config"
+)
+
+type testPublisher struct {
+	events []any
+}
+
+func (p *testPublisher) Publish(_ context.Context, _, _ string, payload any) error {
+	p.events = append(p.events, payload)
+	return nil
+}
+
+func (p *testPublisher) Close() error { return nil }
+
+func newTestFieldFilter(policies map[string]string, words ...string) (*SensitiveFieldFilter, *testPublisher) {
+	events := &testPublisher{}
+	trie := NewSensitiveTrie(nil, nil, nil)
+	trie.current.Store(&compiledSensitiveWords{matcher: newSensitiveMatcher(&Normalizer{}, words), words: len(words)})
+	return &SensitiveFieldFilter{trie: trie, events: events, policies: policies}, events
+}
+
+func TestSensitiveFieldFilter(t *testing.T) {
+	f, events := newTestFieldFilter(map[string]string{
+		SensitiveFieldUserNickname:      SensitiveFieldReject,
+		SensitiveFieldGroupName:         SensitiveFieldReplace,
+		SensitiveFieldFriendReqMsg:      "",
+		SensitiveFieldGroupIntroduction: "unknown",
+	}, "bad")
+	ctx := mcontext.SetOpUserID(context.Background(), "user")
+	hit := HitSensitiveMessage{From: "user"}
+
+	_, err := f.Filter(ctx, SensitiveFieldUserNickname, "bad name", hit)
+	assert.True(t, errs.ErrMsgSensitiveWordFailed.Is(err))
+	value, err := f.Filter(ctx, SensitiveFieldGroupName, "bad group", hit)
+	assert.NoError(t, err)
+	assert.Equal(t, "*** group", value)
+	// fields without a known policy are not filtered
+	for _, field := range []string{SensitiveFieldFriendReqMsg, SensitiveFieldGroupIntroduction, SensitiveFieldGroupNotification} {
+		value, err = f.Filter(ctx, field, "bad", hit)
+		assert.NoError(t, err)
+		assert.Equal(t, "bad", value)
+	}
+	value, err = f.Filter(ctx, SensitiveFieldUserNickname, "good name", hit)
+	assert.NoError(t, err)
+	assert.Equal(t, "good name", value)
+	assert.Len(t, events.events, 2)
+}
+
+func TestSensitiveFieldFilterAdmin(t *testing.T) {
+	defer func(userIDs []string) { config.Config.Manager.UserID = userIDs }(config.Config.Manager.UserID)
+	config.Config.Manager.UserID = []string{"admin"}
+	f, _ := newTestFieldFilter(map[string]string{SensitiveFieldUserNickname: SensitiveFieldReject}, "bad")
+	ctx := mcontext.SetOpUserID(context.Background(), "admin")
+	hit := HitSensitiveMessage{From: "admin"}
+
+	// an admin editing a field is trusted
+	value, err := f.Filter(ctx, SensitiveFieldUserNickname, "bad name", hit)
+	assert.NoError(t, err)
+	assert.Equal(t, "bad name", value)
+	// what an admin submits on behalf of a user, e.g. a registration, is filtered
+	_, err = f.FilterSubmitted(ctx, SensitiveFieldUserNickname, "bad name", hit)
+	assert.True(t, errs.ErrMsgSensitiveWordFailed.Is(err))
+}
@@ -0,0 +1,167 @@
+package live
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/OpenIMSDK/tools/log"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	SensitiveWordChannel    = "sensitive_word_changed" //敏感词库变更通知 pub/sub channel
+	SensitiveWordVersionKey = "sensitive_word_version" //敏感词库版本 RedisKey，变更后递增
+
+	sensitiveWordPollInterval = 30 * time.Second //轮询词库和过滤配置的间隔
+)
+
+type compiledSensitiveWords struct {
+	matcher *sensitiveMatcher
+	version string
+	digest  string
+	words   int
+}
+
+// SensitiveTrie 常驻内存的敏感词 trie，只在词库变更时重建，同时缓存过滤配置
+type SensitiveTrie struct {
+	redis      redis.UniversalClient
+	normalizer *Normalizer
+	current    atomic.Pointer[compiledSensitiveWords]
+	flag       atomic.Int32
+	mu         sync.Mutex
+	onReload   func(version string, words int)
+}
+
+// NewSensitiveTrie 初始化，文本和词库经 normalizer 归一化后匹配，onReload 在每次重建后回调
+func NewSensitiveTrie(redisClient redis.UniversalClient, normalizer *Normalizer, onReload func(version string, words int)) *SensitiveTrie {
+	if normalizer == nil {
+		normalizer = &Normalizer{}
+	}
+	return &SensitiveTrie{redis: redisClient, normalizer: normalizer, onReload: onReload}
+}
+
+// NotifySensitiveWordChanged 词库变更后调用，递增版本并通知所有服务立即重建；
+// 直接写 sensitive_word 的后台不调用也会在下次轮询时按内容摘要发现变更
+func NotifySensitiveWordChanged(ctx context.Context, redisClient redis.UniversalClient) error {
+	version, err := redisClient.Incr(ctx, SensitiveWordVersionKey).Result()
+	if err != nil {
+		return err
+	}
+	return redisClient.Publish(ctx, SensitiveWordChannel, version).Err()
+}
+
+// sensitiveWordDigest 词库内容摘要，词库由后台直接写入 redis，按内容判断是否变更
+func sensitiveWordDigest(wordByte []byte) string {
+	sum := sha1.Sum(wordByte)
+	return hex.EncodeToString(sum[:8])
+}
+
+// Reload 刷新过滤配置，词库版本或内容与当前不同时重建 trie，force 忽略版本和内容
+func (t *SensitiveTrie) Reload(ctx context.Context, force bool) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if err := t.reloadFlag(ctx); err != nil {
+		return err
+	}
+	version, err := t.redis.Get(ctx, SensitiveWordVersionKey).Result()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	wordByte, err := t.redis.Get(ctx, SensitiveWordKey).Bytes()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	digest := sensitiveWordDigest(wordByte)
+	if cur := t.current.Load(); cur != nil && !force && cur.version == version && cur.digest == digest {
+		return nil
+	}
+	var words []SensitiveWords
+	if len(wordByte) > 0 {
+		if err := json.Unmarshal(wordByte, &words); err != nil {
+			return err
+		}
+	}
+	list := make([]string, 0, len(words))
+	for _, word := range words {
+		list = append(list, word.Word)
+	}
+	if version == "" {
+		version = digest
+	}
+	compiled := &compiledSensitiveWords{version: version, digest: digest, words: len(list)}
+	if len(list) > 0 {
+		compiled.matcher = newSensitiveMatcher(t.normalizer, list)
+	}
+	t.current.Store(compiled)
+	if t.onReload != nil {
+		t.onReload(version, len(list))
+	}
+	return nil
+}
+
+func (t *SensitiveTrie) reloadFlag(ctx context.Context) error {
+	cfgByte, err := t.redis.Get(ctx, SensitiveConfigKey).Bytes()
+	if err != nil && err != redis.Nil {
+		return err
+	}
+	var cfg SensitiveConfig
+	if len(cfgByte) > 0 {
+		_ = json.Unmarshal(cfgByte, &cfg)
+	}
+	flag, _ := strconv.Atoi(cfg.Flag)
+	t.flag.Store(int32(flag))
+	return nil
+}
+
+// Flag 当前过滤配置 sensitive_filter_set，随词库一起刷新
+func (t *SensitiveTrie) Flag() int {
+	return int(t.flag.Load())
+}
+
+// Run 订阅变更通知立即重建，并定期轮询词库和过滤配置，直到 ctx 结束
+func (t *SensitiveTrie) Run(ctx context.Context) {
+	sub := t.redis.Subscribe(ctx, SensitiveWordChannel)
+	defer sub.Close()
+	ch := sub.Channel()
+	ticker := time.NewTicker(sensitiveWordPollInterval)
+	defer ticker.Stop()
+	for {
+		force := false
+		select {
+		case <-ctx.Done():
+			return
+		case _, ok := <-ch:
+			if !ok {
+				return
+			}
+			force = true
+		case <-ticker.C:
+		}
+		if err := t.Reload(ctx, force); err != nil {
+			log.ZWarn(ctx, "reload sensitive words failed", err)
+		}
+	}
+}
+
+// Version 当前词库版本
+func (t *SensitiveTrie) Version() string {
+	if cur := t.current.Load(); cur != nil {
+		return cur.version
+	}
+	return ""
+}
+
+// Filter 执行过滤
+func (t *SensitiveTrie) Filter(word string) (sentence string, keywords []string, found bool) {
+	cur := t.current.Load()
+	if cur == nil || cur.matcher == nil {
+		return word, nil, false
+	}
+	return cur.matcher.Filter(word)
+}
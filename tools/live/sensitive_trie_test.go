@@ -0,0 +1,93 @@
+// Copyright © 2023 OpenIM. All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package live
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newSensitiveTestRedis connects to a spare db of the local redis, the test is skipped without one.
+func newSensitiveTestRedis(t *testing.T) redis.UniversalClient {
+	rdb := redis.NewClient(&redis.Options{DB: 15, DialTimeout: time.Second})
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if err := rdb.Ping(ctx).Err(); err != nil {
+		rdb.Close()
+		t.Skip("redis is not available:", err)
+	}
+	t.Cleanup(func() {
+		rdb.Del(context.Background(), SensitiveWordKey, SensitiveWordVersionKey, SensitiveConfigKey)
+		rdb.Close()
+	})
+	return rdb
+}
+
+func setSensitiveWords(t *testing.T, rdb redis.UniversalClient, words ...string) {
+	list := make([]SensitiveWords, 0, len(words))
+	for i, word := range words {
+		list = append(list, SensitiveWords{Id: int64(i), Word: word})
+	}
+	data, err := json.Marshal(list)
+	require.NoError(t, err)
+	require.NoError(t, rdb.Set(context.Background(), SensitiveWordKey, data, 0).Err())
+}
+
+func TestSensitiveWordDigest(t *testing.T) {
+	assert.Equal(t, sensitiveWordDigest([]byte(`[{"word":"a"}]`)), sensitiveWordDigest([]byte(`[{"word":"a"}]`)))
+	assert.NotEqual(t, sensitiveWordDigest([]byte(`[{"word":"a"}]`)), sensitiveWordDigest([]byte(`[{"word":"b"}]`)))
+}
+
+func TestSensitiveTrieReload(t *testing.T) {
+	ctx := context.Background()
+	rdb := newSensitiveTestRedis(t)
+	var reloads int
+	trie := NewSensitiveTrie(rdb, nil, func(string, int) { reloads++ })
+
+	setSensitiveWords(t, rdb, "bad")
+	require.NoError(t, rdb.Set(ctx, SensitiveConfigKey, `{"sensitive_filter_set":"3"}`, 0).Err())
+	require.NoError(t, trie.Reload(ctx, false))
+	_, _, found := trie.Filter("so bad")
+	assert.True(t, found)
+	assert.Equal(t, 3, trie.Flag())
+	assert.Equal(t, 1, reloads)
+
+	// unchanged words are not rebuilt, the config is refreshed anyway
+	require.NoError(t, rdb.Set(ctx, SensitiveConfigKey, `{"sensitive_filter_set":"1"}`, 0).Err())
+	require.NoError(t, trie.Reload(ctx, false))
+	assert.Equal(t, 1, reloads)
+	assert.Equal(t, 1, trie.Flag())
+
+	// words written without bumping the version are picked up by their digest
+	setSensitiveWords(t, rdb, "worse")
+	require.NoError(t, trie.Reload(ctx, false))
+	assert.Equal(t, 2, reloads)
+	_, _, found = trie.Filter("so bad")
+	assert.False(t, found)
+	_, _, found = trie.Filter("even worse")
+	assert.True(t, found)
+
+	// a bumped version rebuilds even with the same words
+	require.NoError(t, NotifySensitiveWordChanged(ctx, rdb))
+	require.NoError(t, trie.Reload(ctx, false))
+	assert.Equal(t, 3, reloads)
+	assert.Equal(t, "1", trie.Version())
+}